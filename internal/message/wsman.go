@@ -31,8 +31,18 @@ func (w WSManMessageCreator) CreateXML(header, body string) string {
 }
 
 func (w *WSManMessageCreator) CreateHeader(action, wsmanClass string, selector *Selector, address, timeout string) string {
+	return w.createHeader(action, w.ResourceURIBase+wsmanClass, selector, address, timeout, "")
+}
+
+// CreateHeaderWithResourceURI creates a header for a resource URI that is not below ResourceURIBase, such as the
+// wildcard resource URI of an event subscription. extraHeader is added to the end of the header as is.
+func (w *WSManMessageCreator) CreateHeaderWithResourceURI(action, resourceURI string, selector *Selector, extraHeader string) string {
+	return w.createHeader(action, resourceURI, selector, "", "", extraHeader)
+}
+
+func (w *WSManMessageCreator) createHeader(action, resourceURI string, selector *Selector, address, timeout, extraHeader string) string {
 	header := "<Header>"
-	header += fmt.Sprintf(`<a:Action>%s</a:Action><a:To>/wsman</a:To><w:ResourceURI>%s%s</w:ResourceURI><a:MessageID>%d</a:MessageID><a:ReplyTo>`, action, w.ResourceURIBase, wsmanClass, w.MessageID)
+	header += fmt.Sprintf(`<a:Action>%s</a:Action><a:To>/wsman</a:To><w:ResourceURI>%s</w:ResourceURI><a:MessageID>%d</a:MessageID><a:ReplyTo>`, action, resourceURI, w.MessageID)
 
 	w.MessageID++
 
@@ -54,6 +64,7 @@ func (w *WSManMessageCreator) CreateHeader(action, wsmanClass string, selector *
 		header += w.createSelector(*selector)
 	}
 
+	header += extraHeader
 	header += "</Header>"
 
 	return header
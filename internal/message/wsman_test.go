@@ -74,6 +74,14 @@ func TestCreateHeader(t *testing.T) {
 
 		assert.Equal(t, correctHeader, header)
 	})
+
+	t.Run("applies custom resourceUri and extra header correctly in createHeaderWithResourceURI", func(t *testing.T) {
+		correctHeader := fmt.Sprintf(`<Header><a:Action>http://schemas.xmlsoap.org/ws/2004/08/eventing/Subscribe</a:Action><a:To>/wsman</a:To><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/*</w:ResourceURI><a:MessageID>%d</a:MessageID><a:ReplyTo><a:Address>http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</a:Address></a:ReplyTo><w:OperationTimeout>PT60S</w:OperationTimeout><w:SelectorSet><w:Selector Name="InstanceID">Intel(r) AMT Device 0</w:Selector></w:SelectorSet><extra></extra></Header>`, messageID)
+		header := wsmanMessageCreator.CreateHeaderWithResourceURI("http://schemas.xmlsoap.org/ws/2004/08/eventing/Subscribe", "http://schemas.dmtf.org/wbem/wscim/1/*", &selector, "<extra></extra>")
+		messageID++
+
+		assert.Equal(t, correctHeader, header)
+	})
 }
 
 type TestStruct struct {
@@ -0,0 +1,235 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+package apf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultIdleTimeout is used to reset Session.Timer when Session.IdleTimeout is not set. It is the 3 seconds that
+// Session.Timer was always reset to after channel data.
+const DefaultIdleTimeout = 3 * time.Second
+
+// ErrIdleTimeout is reported when no APF traffic was received within the session's idle timeout.
+var ErrIdleTimeout = errors.New("apf session idle timeout")
+
+// DisconnectError is returned when Intel AMT sends APF_DISCONNECT.
+type DisconnectError struct {
+	ReasonCode uint32
+}
+
+func (e *DisconnectError) Error() string {
+	return "apf disconnect: " + DisconnectReasonString(e.ReasonCode)
+}
+
+// DisconnectReasonString returns a readable description of an APF_DISCONNECT_* reason code.
+func DisconnectReasonString(reasonCode uint32) string {
+	reasons := map[uint32]string{
+		APF_DISCONNECT_HOST_NOT_ALLOWED_TO_CONNECT:    "HostNotAllowedToConnect",
+		APF_DISCONNECT_PROTOCOL_ERROR:                 "ProtocolError",
+		APF_DISCONNECT_KEY_EXCHANGE_FAILED:            "KeyExchangeFailed",
+		APF_DISCONNECT_RESERVED:                       "Reserved",
+		APF_DISCONNECT_MAC_ERROR:                      "MACError",
+		APF_DISCONNECT_COMPRESSION_ERROR:              "CompressionError",
+		APF_DISCONNECT_SERVICE_NOT_AVAILABLE:          "ServiceNotAvailable",
+		APF_DISCONNECT_PROTOCOL_VERSION_NOT_SUPPORTED: "ProtocolVersionNotSupported",
+		APF_DISCONNECT_HOST_KEY_NOT_VERIFIABLE:        "HostKeyNotVerifiable",
+		APF_DISCONNECT_CONNECTION_LOST:                "ConnectionLost",
+		APF_DISCONNECT_BY_APPLICATION:                 "ByApplication",
+		APF_DISCONNECT_TOO_MANY_CONNECTIONS:           "TooManyConnections",
+		APF_DISCONNECT_AUTH_CANCELLED_BY_USER:         "AuthCancelledByUser",
+		APF_DISCONNECT_NO_MORE_AUTH_METHODS_AVAILABLE: "NoMoreAuthMethodsAvailable",
+		APF_DISCONNECT_ILLEGAL_USER_NAME:              "IllegalUserName",
+	}
+
+	if value, exists := reasons[reasonCode]; exists {
+		return value
+	}
+
+	return "Value not found in map: " + fmt.Sprint(reasonCode)
+}
+
+// ProcessDisconnect decodes an APF_DISCONNECT message into a *DisconnectError.
+func ProcessDisconnect(data []byte) error {
+	message := APF_DISCONNECT_MESSAGE{}
+	dataBuffer := bytes.NewBuffer(data)
+
+	err := binary.Read(dataBuffer, binary.BigEndian, &message.MessageType)
+	if err != nil {
+		log.Error(err)
+	}
+
+	err = binary.Read(dataBuffer, binary.BigEndian, &message.ReasonCode)
+	if err != nil {
+		log.Error(err)
+	}
+
+	log.Tracef("%+v", message)
+
+	return &DisconnectError{ReasonCode: message.ReasonCode}
+}
+
+// ProcessKeepAliveRequest decodes an APF_KEEPALIVE_REQUEST and returns the reply echoing its cookie.
+func ProcessKeepAliveRequest(data []byte) APF_KEEPALIVE_REPLY_MESSAGE {
+	message := APF_KEEPALIVE_REQUEST_MESSAGE{}
+	dataBuffer := bytes.NewBuffer(data)
+
+	err := binary.Read(dataBuffer, binary.BigEndian, &message)
+	if err != nil {
+		log.Error(err)
+	}
+
+	log.Tracef("%+v", message)
+
+	return KeepAliveReply(message.Cookie)
+}
+
+// ProcessKeepAliveReply decodes an APF_KEEPALIVE_REPLY.
+func ProcessKeepAliveReply(data []byte) {
+	message := APF_KEEPALIVE_REPLY_MESSAGE{}
+	dataBuffer := bytes.NewBuffer(data)
+
+	err := binary.Read(dataBuffer, binary.BigEndian, &message)
+	if err != nil {
+		log.Error(err)
+	}
+
+	log.Tracef("%+v", message)
+}
+
+// ProcessKeepAliveOptionsRequest accepts the requested keep-alive options and echoes them in the reply.
+func ProcessKeepAliveOptionsRequest(data []byte, session *Session) APF_KEEPALIVE_OPTIONS_REPLY_MESSAGE {
+	message := APF_KEEPALIVE_OPTIONS_REQUEST_MESSAGE{}
+	dataBuffer := bytes.NewBuffer(data)
+
+	err := binary.Read(dataBuffer, binary.BigEndian, &message)
+	if err != nil {
+		log.Error(err)
+	}
+
+	log.Tracef("%+v", message)
+	applyKeepAliveOptions(session, message.KeepaliveInterval, message.ReadTimeout)
+
+	return KeepAliveOptionsReply(message.KeepaliveInterval, message.ReadTimeout)
+}
+
+// ProcessKeepAliveOptionsReply stores the keep-alive options accepted by Intel AMT on the session. As with
+// ProcessKeepAliveOptionsRequest, Session.Timer and Session.IdleTimeout are not changed.
+func ProcessKeepAliveOptionsReply(data []byte, session *Session) {
+	message := APF_KEEPALIVE_OPTIONS_REPLY_MESSAGE{}
+	dataBuffer := bytes.NewBuffer(data)
+
+	err := binary.Read(dataBuffer, binary.BigEndian, &message)
+	if err != nil {
+		log.Error(err)
+	}
+
+	log.Tracef("%+v", message)
+	applyKeepAliveOptions(session, message.KeepaliveInterval, message.ReadTimeout)
+}
+
+func applyKeepAliveOptions(session *Session, interval, timeout uint32) {
+	session.KeepAliveInterval = interval
+	session.KeepAliveTimeout = timeout
+}
+
+// StartIdleTimer arms Session.Timer so that ErrIdleTimeout is reported on the session's ErrorBuffer when no channel
+// data is received within Session.IdleTimeout. Only APF_CHANNEL_DATA resets the timer, as it always did; keep-alive
+// messages do not, so a connection that only exchanges keep-alives is reported as idle.
+func StartIdleTimer(session *Session) {
+	session.Timer = time.AfterFunc(idleTimeout(session), func() {
+		log.Debug("apf session idle timeout")
+
+		reportError(session, ErrIdleTimeout)
+	})
+}
+
+// reportError delivers err on the session's ErrorBuffer without blocking the caller. When the buffer is full, err is
+// sent from a goroutine that waits for the reader, so no error is lost. Nothing is sent when the session has no
+// ErrorBuffer.
+func reportError(session *Session, err error) {
+	if session.ErrorBuffer == nil {
+		return
+	}
+
+	select {
+	case session.ErrorBuffer <- err:
+	default:
+		log.Debugf("apf error buffer full, delivering error when it is read: %v", err)
+
+		go func(errorBuffer chan<- error) {
+			errorBuffer <- err
+		}(session.ErrorBuffer)
+	}
+}
+
+// ResetIdleTimer restarts Session.Timer, if set, with the session's idle timeout.
+func ResetIdleTimer(session *Session) {
+	if session.Timer != nil {
+		session.Timer.Reset(idleTimeout(session))
+	}
+}
+
+func idleTimeout(session *Session) time.Duration {
+	if session.IdleTimeout > 0 {
+		return session.IdleTimeout
+	}
+
+	return DefaultIdleTimeout
+}
+
+func KeepAliveRequest(cookie uint32) APF_KEEPALIVE_REQUEST_MESSAGE {
+	log.Debug("sending APF_KEEPALIVE_REQUEST_MESSAGE")
+
+	return APF_KEEPALIVE_REQUEST_MESSAGE{
+		MessageType: APF_KEEPALIVE_REQUEST,
+		Cookie:      cookie,
+	}
+}
+
+func KeepAliveReply(cookie uint32) APF_KEEPALIVE_REPLY_MESSAGE {
+	log.Debug("sending APF_KEEPALIVE_REPLY_MESSAGE")
+
+	return APF_KEEPALIVE_REPLY_MESSAGE{
+		MessageType: APF_KEEPALIVE_REPLY,
+		Cookie:      cookie,
+	}
+}
+
+// KeepAliveOptionsRequest asks Intel AMT to send keep-alives every interval seconds and to drop the connection after timeout seconds without traffic.
+func KeepAliveOptionsRequest(interval, timeout uint32) APF_KEEPALIVE_OPTIONS_REQUEST_MESSAGE {
+	log.Debug("sending APF_KEEPALIVE_OPTIONS_REQUEST_MESSAGE")
+
+	return APF_KEEPALIVE_OPTIONS_REQUEST_MESSAGE{
+		MessageType:       APF_KEEPALIVE_OPTIONS_REQUEST,
+		KeepaliveInterval: interval,
+		ReadTimeout:       timeout,
+	}
+}
+
+func KeepAliveOptionsReply(interval, timeout uint32) APF_KEEPALIVE_OPTIONS_REPLY_MESSAGE {
+	log.Debug("sending APF_KEEPALIVE_OPTIONS_REPLY_MESSAGE")
+
+	return APF_KEEPALIVE_OPTIONS_REPLY_MESSAGE{
+		MessageType:       APF_KEEPALIVE_OPTIONS_REPLY,
+		KeepaliveInterval: interval,
+		ReadTimeout:       timeout,
+	}
+}
+
+func Disconnect(reasonCode uint32) APF_DISCONNECT_MESSAGE {
+	log.Debug("sending APF_DISCONNECT_MESSAGE")
+
+	return APF_DISCONNECT_MESSAGE{
+		MessageType: APF_DISCONNECT,
+		ReasonCode:  reasonCode,
+	}
+}
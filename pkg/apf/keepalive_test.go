@@ -0,0 +1,260 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+package apf
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessKeepAliveRequest(t *testing.T) {
+	t.Parallel()
+
+	data := []byte{APF_KEEPALIVE_REQUEST, 0x01, 0x02, 0x03, 0x04}
+	session := &Session{}
+
+	result := Process(data, session)
+
+	assert.Equal(t, []byte{APF_KEEPALIVE_REPLY, 0x01, 0x02, 0x03, 0x04}, result.Bytes())
+}
+
+func TestProcessKeepAliveReply(t *testing.T) {
+	t.Parallel()
+
+	data := []byte{APF_KEEPALIVE_REPLY, 0x00, 0x00, 0x00, 0x01}
+	session := &Session{}
+
+	result := Process(data, session)
+
+	assert.Equal(t, 0, result.Len())
+}
+
+func TestProcessKeepAliveOptionsRequest(t *testing.T) {
+	t.Parallel()
+
+	data := []byte{APF_KEEPALIVE_OPTIONS_REQUEST, 0x00, 0x00, 0x00, 0x1E, 0x00, 0x00, 0x00, 0x3C}
+	session := &Session{}
+
+	result := Process(data, session)
+
+	assert.Equal(t, []byte{APF_KEEPALIVE_OPTIONS_REPLY, 0x00, 0x00, 0x00, 0x1E, 0x00, 0x00, 0x00, 0x3C}, result.Bytes())
+	assert.Equal(t, uint32(30), session.KeepAliveInterval)
+	assert.Equal(t, uint32(60), session.KeepAliveTimeout)
+	assert.Equal(t, time.Duration(0), session.IdleTimeout)
+}
+
+func TestProcessKeepAliveOptionsReply(t *testing.T) {
+	t.Parallel()
+
+	data := []byte{APF_KEEPALIVE_OPTIONS_REPLY, 0x00, 0x00, 0x00, 0x0A, 0x00, 0x00, 0x00, 0x00}
+	session := &Session{}
+
+	result := Process(data, session)
+
+	assert.Equal(t, 0, result.Len())
+	assert.Equal(t, uint32(10), session.KeepAliveInterval)
+	assert.Equal(t, uint32(0), session.KeepAliveTimeout)
+	assert.Equal(t, time.Duration(0), session.IdleTimeout)
+}
+
+func TestProcessDisconnect(t *testing.T) {
+	t.Parallel()
+
+	data := []byte{APF_DISCONNECT, 0x00, 0x00, 0x00, 0x0B, 0x00, 0x00}
+	errorChannel := make(chan error, 1)
+	session := &Session{ErrorBuffer: errorChannel}
+
+	Process(data, session)
+
+	err := <-errorChannel
+
+	var disconnectErr *DisconnectError
+
+	assert.ErrorAs(t, err, &disconnectErr)
+	assert.Equal(t, uint32(APF_DISCONNECT_BY_APPLICATION), disconnectErr.ReasonCode)
+	assert.Equal(t, "apf disconnect: ByApplication", err.Error())
+}
+
+func TestProcessDisconnectDoesNotBlock(t *testing.T) {
+	t.Parallel()
+
+	data := []byte{APF_DISCONNECT, 0x00, 0x00, 0x00, 0x0B, 0x00, 0x00}
+	done := make(chan struct{})
+
+	go func() {
+		Process(data, &Session{ErrorBuffer: make(chan error)})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Process blocked on an ErrorBuffer that is not drained")
+	}
+}
+
+func TestReportError(t *testing.T) {
+	t.Parallel()
+
+	errorChannel := make(chan error, 1)
+	session := &Session{ErrorBuffer: errorChannel}
+
+	reportError(session, ErrIdleTimeout)
+	reportError(session, io.EOF)
+	reportError(&Session{}, ErrIdleTimeout)
+
+	assert.ErrorIs(t, <-errorChannel, ErrIdleTimeout)
+
+	select {
+	case err := <-errorChannel:
+		assert.ErrorIs(t, err, io.EOF)
+	case <-time.After(2 * time.Second):
+		t.Fatal("error reported on a full ErrorBuffer was dropped")
+	}
+}
+
+func TestProcessChannelOpenFailureDoesNotBlock(t *testing.T) {
+	t.Parallel()
+
+	data := []byte{APF_CHANNEL_OPEN_FAILURE, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+	errorChannel := make(chan error)
+	session := &Session{ErrorBuffer: errorChannel, Status: make(chan bool, 1)}
+	done := make(chan struct{})
+
+	go func() {
+		Process(data, session)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Process blocked on an ErrorBuffer that is not drained")
+	}
+
+	assert.EqualError(t, <-errorChannel, "error opening APF channel, reason code: 2")
+}
+
+func TestDisconnectReasonString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		reasonCode uint32
+		expected   string
+	}{
+		{APF_DISCONNECT_HOST_NOT_ALLOWED_TO_CONNECT, "HostNotAllowedToConnect"},
+		{APF_DISCONNECT_CONNECTION_LOST, "ConnectionLost"},
+		{APF_DISCONNECT_ILLEGAL_USER_NAME, "IllegalUserName"},
+		{99, "Value not found in map: 99"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, DisconnectReasonString(test.reasonCode))
+	}
+}
+
+func TestMuxProcessDisconnect(t *testing.T) {
+	t.Parallel()
+
+	recorder := newFrameRecorder()
+	mux := NewMux(recorder)
+	channel := openChannel(t, mux, recorder, 100, 4096)
+
+	err := mux.Process(encode(t, Disconnect(APF_DISCONNECT_CONNECTION_LOST)))
+
+	var disconnectErr *DisconnectError
+
+	assert.ErrorAs(t, err, &disconnectErr)
+
+	_, err = channel.Read(make([]byte, 1))
+	assert.ErrorAs(t, err, &disconnectErr)
+	assert.Equal(t, uint32(APF_DISCONNECT_CONNECTION_LOST), disconnectErr.ReasonCode)
+}
+
+func TestStartIdleTimer(t *testing.T) {
+	t.Parallel()
+
+	errorChannel := make(chan error, 1)
+	session := &Session{
+		ErrorBuffer: errorChannel,
+		IdleTimeout: 10 * time.Millisecond,
+	}
+
+	StartIdleTimer(session)
+
+	select {
+	case err := <-errorChannel:
+		assert.ErrorIs(t, err, ErrIdleTimeout)
+	case <-time.After(2 * time.Second):
+		t.Fatal("idle timeout was not reported")
+	}
+}
+
+func TestKeepAliveDoesNotResetIdleTimer(t *testing.T) {
+	t.Parallel()
+
+	errorChannel := make(chan error, 1)
+	session := &Session{
+		ErrorBuffer: errorChannel,
+		IdleTimeout: 50 * time.Millisecond,
+	}
+
+	StartIdleTimer(session)
+
+	Process([]byte{APF_KEEPALIVE_OPTIONS_REQUEST, 0x00, 0x00, 0x00, 0x1E, 0x00, 0x00, 0x00, 0x3C}, session)
+	Process([]byte{APF_KEEPALIVE_REQUEST, 0x00, 0x00, 0x00, 0x01}, session)
+
+	assert.Equal(t, 50*time.Millisecond, session.IdleTimeout)
+
+	select {
+	case err := <-errorChannel:
+		assert.ErrorIs(t, err, ErrIdleTimeout)
+	case <-time.After(2 * time.Second):
+		t.Fatal("keep-alive messages reset the idle timer")
+	}
+}
+
+func TestChannelDataResetsIdleTimer(t *testing.T) {
+	t.Parallel()
+
+	errorChannel := make(chan error, 1)
+	session := &Session{
+		ErrorBuffer: errorChannel,
+		IdleTimeout: time.Hour,
+	}
+
+	StartIdleTimer(session)
+	defer session.Timer.Stop()
+
+	session.IdleTimeout = 10 * time.Millisecond
+	Process([]byte{APF_CHANNEL_DATA, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00}, session)
+
+	select {
+	case err := <-errorChannel:
+		assert.ErrorIs(t, err, ErrIdleTimeout)
+	case <-time.After(2 * time.Second):
+		t.Fatal("channel data did not reset the idle timer")
+	}
+}
+
+func TestKeepAliveOptionsRequest(t *testing.T) {
+	t.Parallel()
+
+	result := KeepAliveOptionsRequest(30, 90)
+	assert.Equal(t, uint8(APF_KEEPALIVE_OPTIONS_REQUEST), result.MessageType)
+	assert.Equal(t, uint32(30), result.KeepaliveInterval)
+	assert.Equal(t, uint32(90), result.ReadTimeout)
+}
+
+func TestKeepAliveRequest(t *testing.T) {
+	t.Parallel()
+
+	result := KeepAliveRequest(7)
+	assert.Equal(t, uint8(APF_KEEPALIVE_REQUEST), result.MessageType)
+	assert.Equal(t, uint32(7), result.Cookie)
+}
@@ -0,0 +1,276 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+package apf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// LME_MAX_MESSAGE_LENGTH is the size of the buffer used to read a single APF message from the MEI device.
+const LME_MAX_MESSAGE_LENGTH = LME_RX_WINDOW_SIZE + 1024
+
+// MEIDevice is the connection to the firmware's LME client, normally the HECI/MEI driver.
+// Each Read must return exactly one APF message and each Write sends exactly one APF message.
+type MEIDevice interface {
+	io.ReadWriteCloser
+}
+
+// LMS is a Local Manageability Service. It speaks APF to the firmware over an MEIDevice and
+// forwards every TCP connection accepted on the local listeners into its own APF channel.
+// Listeners are opened when the firmware requests a tcpip-forward for a port in Addresses.
+type LMS struct {
+	device    MEIDevice
+	mux       *Mux
+	session   *Session
+	lock      sync.Mutex
+	listeners map[uint32]net.Listener
+	closed    bool
+	// Addresses maps the AMT port to the local address it is exposed on.
+	Addresses map[uint32]string
+}
+
+// NewLMS creates an LMS on top of device that exposes localhost:16992 and localhost:16993.
+func NewLMS(device MEIDevice) *LMS {
+	return &LMS{
+		device:    device,
+		mux:       NewMux(device),
+		session:   &Session{},
+		listeners: make(map[uint32]net.Listener),
+		Addresses: map[uint32]string{
+			16992: "localhost:16992",
+			16993: "localhost:16993",
+		},
+	}
+}
+
+// Serve reads APF messages from the MEI device until it is closed or the firmware disconnects.
+// It returns nil after Close, otherwise the error that ended the session.
+func (l *LMS) Serve() error {
+	buffer := make([]byte, LME_MAX_MESSAGE_LENGTH)
+
+	for {
+		n, err := l.device.Read(buffer)
+		if err != nil {
+			if l.isClosed() {
+				return nil
+			}
+
+			l.shutdown()
+
+			return err
+		}
+
+		if n == 0 {
+			continue
+		}
+
+		if err = l.process(append([]byte(nil), buffer[:n]...)); err != nil {
+			l.shutdown()
+
+			return err
+		}
+	}
+}
+
+// Addr returns the address of the local listener forwarding the given AMT port, or nil if it is not listening.
+func (l *LMS) Addr(port uint32) net.Addr {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	listener, ok := l.listeners[port]
+	if !ok {
+		return nil
+	}
+
+	return listener.Addr()
+}
+
+// Close stops the listeners, fails every open channel and closes the MEI device.
+func (l *LMS) Close() error {
+	l.lock.Lock()
+	l.closed = true
+	l.lock.Unlock()
+
+	l.shutdown()
+
+	return l.device.Close()
+}
+
+func (l *LMS) isClosed() bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.closed
+}
+
+func (l *LMS) shutdown() {
+	l.lock.Lock()
+	listeners := l.listeners
+	l.listeners = make(map[uint32]net.Listener)
+	l.lock.Unlock()
+
+	for _, listener := range listeners {
+		listener.Close()
+	}
+
+	l.mux.Close()
+}
+
+func (l *LMS) process(data []byte) error {
+	switch data[0] {
+	case APF_CHANNEL_OPEN_CONFIRMATION, APF_CHANNEL_OPEN_FAILURE, APF_CHANNEL_DATA, APF_CHANNEL_WINDOW_ADJUST, APF_CHANNEL_CLOSE:
+		if err := l.mux.Process(data); err != nil {
+			log.Error(err)
+		}
+
+		return nil
+	case APF_DISCONNECT:
+		return l.mux.Process(data)
+	case APF_CHANNEL_OPEN:
+		// channels opened by the firmware (e.g. WS-Eventing) are not forwarded.
+		return l.rejectChannelOpen(data)
+	case APF_GLOBAL_REQUEST:
+		return l.processGlobalRequest(data)
+	}
+
+	reply := Process(data, l.session)
+	if reply.Len() == 0 {
+		return nil
+	}
+
+	return l.mux.write(reply.Bytes())
+}
+
+func (l *LMS) processGlobalRequest(data []byte) error {
+	header, request := DecodeGlobalRequest(data)
+
+	switch header.String {
+	case APF_GLOBAL_REQUEST_STR_TCP_FORWARD_REQUEST:
+		address, ok := l.Addresses[request.Port]
+		if !ok {
+			return l.mux.writeMessage(uint8(APF_REQUEST_FAILURE))
+		}
+
+		if err := l.listen(request.Port, address); err != nil {
+			log.Error(err)
+
+			return l.mux.writeMessage(uint8(APF_REQUEST_FAILURE))
+		}
+
+		return l.mux.writeMessage(TcpForwardReplySuccess(request.Port))
+	case APF_GLOBAL_REQUEST_STR_TCP_FORWARD_CANCEL_REQUEST:
+		l.lock.Lock()
+		listener, ok := l.listeners[request.Port]
+		delete(l.listeners, request.Port)
+		l.lock.Unlock()
+
+		if ok {
+			listener.Close()
+		}
+
+		return l.mux.writeMessage(uint8(APF_REQUEST_SUCCESS))
+	}
+
+	return l.mux.writeMessage(uint8(APF_REQUEST_FAILURE))
+}
+
+func (l *LMS) rejectChannelOpen(data []byte) error {
+	dataBuffer := bytes.NewBuffer(data[1:])
+
+	var channelTypeLength uint32
+
+	if err := binary.Read(dataBuffer, binary.BigEndian, &channelTypeLength); err != nil {
+		return ErrMessageTooShort
+	}
+
+	dataBuffer.Next(int(channelTypeLength))
+
+	var senderChannel uint32
+
+	if err := binary.Read(dataBuffer, binary.BigEndian, &senderChannel); err != nil {
+		return ErrMessageTooShort
+	}
+
+	return l.mux.writeMessage(ChannelOpenReplyFailure(senderChannel, OPEN_FAILURE_REASON_ADMINISTRATIVELY_PROHIBITED))
+}
+
+func (l *LMS) listen(port uint32, address string) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.closed {
+		return ErrMuxClosed
+	}
+
+	if _, ok := l.listeners[port]; ok {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	l.listeners[port] = listener
+
+	go l.accept(port, listener)
+
+	return nil
+}
+
+func (l *LMS) accept(port uint32, listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				log.Error(err)
+			}
+
+			return
+		}
+
+		go l.forward(port, conn)
+	}
+}
+
+func (l *LMS) forward(port uint32, conn net.Conn) {
+	defer conn.Close()
+
+	channel, err := l.mux.Open(port)
+	if err != nil {
+		log.Error(err)
+
+		return
+	}
+
+	defer channel.Close()
+
+	done := make(chan struct{})
+
+	go func() {
+		_, err := io.Copy(conn, channel)
+		if err != nil {
+			log.Debug(err)
+		}
+
+		conn.Close()
+		close(done)
+	}()
+
+	_, err = io.Copy(channel, conn)
+	if err != nil {
+		log.Debug(err)
+	}
+
+	channel.Close()
+	<-done
+}
@@ -0,0 +1,172 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+package apf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeMEIDevice stands in for the HECI driver. The test plays the firmware side.
+type fakeMEIDevice struct {
+	fromFirmware chan []byte
+	toFirmware   *frameRecorder
+	closed       chan struct{}
+}
+
+func newFakeMEIDevice() *fakeMEIDevice {
+	return &fakeMEIDevice{
+		fromFirmware: make(chan []byte, 100),
+		toFirmware:   newFrameRecorder(),
+		closed:       make(chan struct{}),
+	}
+}
+
+func (d *fakeMEIDevice) Read(p []byte) (int, error) {
+	select {
+	case message := <-d.fromFirmware:
+		return copy(p, message), nil
+	case <-d.closed:
+		return 0, io.EOF
+	}
+}
+
+func (d *fakeMEIDevice) Write(p []byte) (int, error) {
+	return d.toFirmware.Write(p)
+}
+
+func (d *fakeMEIDevice) Close() error {
+	close(d.closed)
+
+	return nil
+}
+
+func tcpForwardRequest(t *testing.T, port uint32) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	buf.WriteByte(APF_GLOBAL_REQUEST)
+	assert.NoError(t, binary.Write(&buf, binary.BigEndian, uint32(len(APF_GLOBAL_REQUEST_STR_TCP_FORWARD_REQUEST))))
+	buf.WriteString(APF_GLOBAL_REQUEST_STR_TCP_FORWARD_REQUEST)
+	buf.WriteByte(1)
+	assert.NoError(t, binary.Write(&buf, binary.BigEndian, uint32(9)))
+	buf.WriteString("127.0.0.1")
+	assert.NoError(t, binary.Write(&buf, binary.BigEndian, port))
+
+	return buf.Bytes()
+}
+
+func startLMS(t *testing.T) (*LMS, *fakeMEIDevice, chan error) {
+	t.Helper()
+
+	device := newFakeMEIDevice()
+	lms := NewLMS(device)
+	lms.Addresses = map[uint32]string{16992: "127.0.0.1:0"}
+	served := make(chan error, 1)
+
+	go func() {
+		served <- lms.Serve()
+	}()
+
+	return lms, device, served
+}
+
+func TestLMSHandshakeAndForward(t *testing.T) {
+	t.Parallel()
+
+	lms, device, served := startLMS(t)
+
+	device.fromFirmware <- encode(t, ProtocolVersion(1, 0, APF_TRIGGER_REASON_LME_REQUEST))
+	assert.Equal(t, byte(APF_PROTOCOLVERSION), device.toFirmware.next(t)[0])
+
+	device.fromFirmware <- []byte{0x05, 0x00, 0x00, 0x00, 0x12, 0x70, 0x66, 0x77, 0x64, 0x40, 0x61, 0x6d, 0x74, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6d}
+	assert.Equal(t, byte(APF_SERVICE_ACCEPT), device.toFirmware.next(t)[0])
+
+	device.fromFirmware <- tcpForwardRequest(t, 16992)
+	assert.Equal(t, encode(t, TcpForwardReplySuccess(16992)), device.toFirmware.next(t))
+
+	device.fromFirmware <- tcpForwardRequest(t, 16994)
+	assert.Equal(t, []byte{APF_REQUEST_FAILURE}, device.toFirmware.next(t))
+
+	addr := lms.Addr(16992)
+	assert.NotNil(t, addr)
+	assert.Nil(t, lms.Addr(16994))
+
+	conn, err := net.Dial("tcp", addr.String())
+	assert.NoError(t, err)
+
+	defer conn.Close()
+
+	open := device.toFirmware.next(t)
+	assert.Equal(t, byte(APF_CHANNEL_OPEN), open[0])
+
+	lmsChannel := binary.BigEndian.Uint32(open[5+len(APF_OPEN_CHANNEL_REQUEST_FORWARDED):])
+	device.fromFirmware <- encode(t, APF_CHANNEL_OPEN_CONFIRMATION_MESSAGE{
+		MessageType:       APF_CHANNEL_OPEN_CONFIRMATION,
+		RecipientChannel:  lmsChannel,
+		SenderChannel:     7,
+		InitialWindowSize: 4096,
+	})
+
+	_, err = conn.Write([]byte("POST /wsman"))
+	assert.NoError(t, err)
+	assert.Equal(t, ChannelDataBytes(7, []byte("POST /wsman")), device.toFirmware.next(t))
+
+	device.fromFirmware <- ChannelDataBytes(lmsChannel, []byte("HTTP/1.1 200 OK"))
+
+	reply := make([]byte, 15)
+
+	assert.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+
+	_, err = io.ReadFull(conn, reply)
+	assert.NoError(t, err)
+	assert.Equal(t, "HTTP/1.1 200 OK", string(reply))
+	assert.Equal(t, encode(t, ChannelWindowAdjust(7, 15)), device.toFirmware.next(t))
+
+	device.fromFirmware <- encode(t, ChannelClose(lmsChannel))
+	assert.Equal(t, encode(t, ChannelClose(7)), device.toFirmware.next(t))
+
+	_, err = conn.Read(reply)
+	assert.ErrorIs(t, err, io.EOF)
+
+	assert.NoError(t, lms.Close())
+	assert.NoError(t, <-served)
+}
+
+func TestLMSRejectsFirmwareChannelOpen(t *testing.T) {
+	t.Parallel()
+
+	lms, device, served := startLMS(t)
+
+	open := ChannelOpenForPort(9, 4096, "::1", 16992)
+	device.fromFirmware <- open.Bytes()
+	assert.Equal(t, encode(t, ChannelOpenReplyFailure(9, OPEN_FAILURE_REASON_ADMINISTRATIVELY_PROHIBITED)), device.toFirmware.next(t))
+
+	assert.NoError(t, lms.Close())
+	assert.NoError(t, <-served)
+}
+
+func TestLMSDisconnect(t *testing.T) {
+	t.Parallel()
+
+	lms, device, served := startLMS(t)
+
+	device.fromFirmware <- tcpForwardRequest(t, 16992)
+	device.toFirmware.next(t)
+
+	device.fromFirmware <- encode(t, Disconnect(APF_DISCONNECT_BY_APPLICATION))
+
+	var disconnectErr *DisconnectError
+
+	assert.ErrorAs(t, <-served, &disconnectErr)
+	assert.Nil(t, lms.Addr(16992))
+}
@@ -0,0 +1,546 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+package apf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// APF_CHANNEL_MAX_DATA_LENGTH is the largest payload sent in a single APF_CHANNEL_DATA message.
+const APF_CHANNEL_MAX_DATA_LENGTH = 4096
+
+var (
+	ErrMuxClosed           = errors.New("apf multiplexer is closed")
+	ErrChannelClosed       = errors.New("apf channel is closed")
+	ErrChannelOpenTimeout  = errors.New("timed out waiting for apf channel open confirmation")
+	ErrUnknownChannel      = errors.New("apf message for unknown channel")
+	ErrRXWindowExceeded    = errors.New("apf peer sent more data than the receive window allows")
+	ErrMessageTooShort     = errors.New("apf message is too short")
+	ErrNoChannelsAvailable = errors.New("no apf channel ids available")
+)
+
+// ChannelOpenError is returned by Mux.Open when AMT rejects the channel with APF_CHANNEL_OPEN_FAILURE.
+type ChannelOpenError struct {
+	ReasonCode uint32
+}
+
+func (e *ChannelOpenError) Error() string {
+	return "error opening APF channel, reason code: " + fmt.Sprint(e.ReasonCode)
+}
+
+// Mux multiplexes several APF channels over a single APF connection (CIRA tunnel or MEI).
+// Outgoing frames are written to the connection passed to NewMux, and every frame read from
+// the connection must be handed to Mux.Process.
+type Mux struct {
+	conn          io.Writer
+	writeLock     sync.Mutex
+	lock          sync.Mutex
+	channels      map[uint32]*Channel
+	nextChannel   uint32
+	closed        bool
+	RXWindowSize  uint32
+	OpenTimeout   time.Duration
+	ConnectedHost string
+}
+
+// Channel is a single APF forwarded channel carried by a Mux. It implements io.ReadWriteCloser.
+// Each channel has its own transmit and receive windows, so a slow reader only stalls its own channel.
+type Channel struct {
+	mux              *Mux
+	SenderChannel    uint32 // channel number assigned by AMT
+	RecipientChannel uint32 // channel number assigned by us
+	Port             uint32
+	lock             sync.Mutex
+	cond             *sync.Cond
+	txWindow         uint32
+	rxWindow         uint32
+	rxConsumed       uint32
+	readBuffer       bytes.Buffer
+	opened           chan error
+	localClosed      bool
+	remoteClosed     bool
+	err              error
+}
+
+// NewMux creates a multiplexer that writes outgoing APF frames to conn.
+func NewMux(conn io.Writer) *Mux {
+	return &Mux{
+		conn:          conn,
+		channels:      make(map[uint32]*Channel),
+		RXWindowSize:  LME_RX_WINDOW_SIZE,
+		OpenTimeout:   10 * time.Second,
+		ConnectedHost: "::1",
+	}
+}
+
+// Open allocates a channel id and opens a forwarded-tcpip channel to the given AMT port (16992, 16993, 16994, 16995, ...).
+// It blocks until AMT confirms or rejects the channel or OpenTimeout expires.
+func (m *Mux) Open(port uint32) (*Channel, error) {
+	channel, err := m.allocate(port)
+	if err != nil {
+		return nil, err
+	}
+
+	message := ChannelOpenForPort(channel.RecipientChannel, m.RXWindowSize, m.ConnectedHost, port)
+
+	if err = m.write(message.Bytes()); err != nil {
+		m.remove(channel.RecipientChannel)
+
+		return nil, err
+	}
+
+	timer := time.NewTimer(m.OpenTimeout)
+	defer timer.Stop()
+
+	select {
+	case err = <-channel.opened:
+		if err != nil {
+			m.remove(channel.RecipientChannel)
+
+			return nil, err
+		}
+	case <-timer.C:
+		m.remove(channel.RecipientChannel)
+
+		return nil, ErrChannelOpenTimeout
+	}
+
+	return channel, nil
+}
+
+// Channels returns the number of channels currently tracked by the multiplexer.
+func (m *Mux) Channels() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return len(m.channels)
+}
+
+// Close fails every open channel with ErrMuxClosed. The underlying connection is not closed.
+func (m *Mux) Close() error {
+	m.fail(ErrMuxClosed)
+
+	return nil
+}
+
+func (m *Mux) fail(err error) {
+	m.lock.Lock()
+	m.closed = true
+	channels := m.channels
+	m.channels = make(map[uint32]*Channel)
+	m.lock.Unlock()
+
+	for _, channel := range channels {
+		channel.fail(err)
+	}
+}
+
+// Process routes a single APF message read from the connection to the channel it addresses.
+// APF_DISCONNECT fails every channel and is returned as a *DisconnectError.
+// Other messages that are not channel related are ignored and should be handled with apf.Process.
+func (m *Mux) Process(data []byte) error {
+	if len(data) == 0 {
+		return ErrMessageTooShort
+	}
+
+	switch data[0] {
+	case APF_DISCONNECT:
+		err := ProcessDisconnect(data)
+		m.fail(err)
+
+		return err
+	case APF_CHANNEL_OPEN_CONFIRMATION:
+		return m.processOpenConfirmation(data)
+	case APF_CHANNEL_OPEN_FAILURE:
+		return m.processOpenFailure(data)
+	case APF_CHANNEL_DATA:
+		return m.processData(data)
+	case APF_CHANNEL_WINDOW_ADJUST:
+		return m.processWindowAdjust(data)
+	case APF_CHANNEL_CLOSE:
+		return m.processClose(data)
+	}
+
+	return nil
+}
+
+func (m *Mux) processOpenConfirmation(data []byte) error {
+	message := APF_CHANNEL_OPEN_CONFIRMATION_MESSAGE{}
+
+	if err := binary.Read(bytes.NewReader(data), binary.BigEndian, &message); err != nil {
+		return ErrMessageTooShort
+	}
+
+	log.Tracef("%+v", message)
+
+	channel := m.lookup(message.RecipientChannel)
+	if channel == nil {
+		return ErrUnknownChannel
+	}
+
+	channel.lock.Lock()
+	channel.SenderChannel = message.SenderChannel
+	channel.txWindow = message.InitialWindowSize
+	channel.lock.Unlock()
+
+	channel.signalOpened(nil)
+
+	return nil
+}
+
+func (m *Mux) processOpenFailure(data []byte) error {
+	message := APF_CHANNEL_OPEN_FAILURE_MESSAGE{}
+
+	if err := binary.Read(bytes.NewReader(data), binary.BigEndian, &message); err != nil {
+		return ErrMessageTooShort
+	}
+
+	log.Tracef("%+v", message)
+
+	channel := m.lookup(message.RecipientChannel)
+	if channel == nil {
+		return ErrUnknownChannel
+	}
+
+	channel.signalOpened(&ChannelOpenError{ReasonCode: message.ReasonCode})
+
+	return nil
+}
+
+func (m *Mux) processData(data []byte) error {
+	const headerLength = 9
+
+	if len(data) < headerLength {
+		return ErrMessageTooShort
+	}
+
+	recipientChannel := binary.BigEndian.Uint32(data[1:5])
+	dataLength := binary.BigEndian.Uint32(data[5:9])
+
+	if uint32(len(data)-headerLength) < dataLength {
+		return ErrMessageTooShort
+	}
+
+	channel := m.lookup(recipientChannel)
+	if channel == nil {
+		return ErrUnknownChannel
+	}
+
+	channel.lock.Lock()
+	defer channel.lock.Unlock()
+
+	if dataLength > channel.rxWindow {
+		return ErrRXWindowExceeded
+	}
+
+	channel.rxWindow -= dataLength
+	channel.readBuffer.Write(data[headerLength : headerLength+dataLength])
+	channel.cond.Broadcast()
+
+	return nil
+}
+
+func (m *Mux) processWindowAdjust(data []byte) error {
+	message := APF_CHANNEL_WINDOW_ADJUST_MESSAGE{}
+
+	if err := binary.Read(bytes.NewReader(data), binary.BigEndian, &message); err != nil {
+		return ErrMessageTooShort
+	}
+
+	channel := m.lookup(message.RecipientChannel)
+	if channel == nil {
+		return ErrUnknownChannel
+	}
+
+	channel.lock.Lock()
+	channel.txWindow += message.BytesToAdd
+	channel.cond.Broadcast()
+	channel.lock.Unlock()
+
+	return nil
+}
+
+func (m *Mux) processClose(data []byte) error {
+	message := APF_CHANNEL_CLOSE_MESSAGE{}
+
+	if err := binary.Read(bytes.NewReader(data), binary.BigEndian, &message); err != nil {
+		return ErrMessageTooShort
+	}
+
+	channel := m.lookup(message.RecipientChannel)
+	if channel == nil {
+		return ErrUnknownChannel
+	}
+
+	channel.lock.Lock()
+	channel.remoteClosed = true
+	replyNeeded := !channel.localClosed
+	channel.localClosed = true
+	channel.cond.Broadcast()
+	channel.lock.Unlock()
+
+	m.remove(channel.RecipientChannel)
+
+	if replyNeeded {
+		return m.writeMessage(ChannelClose(channel.SenderChannel))
+	}
+
+	return nil
+}
+
+func (m *Mux) allocate(port uint32) (*Channel, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.closed {
+		return nil, ErrMuxClosed
+	}
+
+	for i := 0; i <= len(m.channels); i++ {
+		id := m.nextChannel
+		m.nextChannel++
+
+		if _, ok := m.channels[id]; ok {
+			continue
+		}
+
+		channel := &Channel{
+			mux:              m,
+			RecipientChannel: id,
+			Port:             port,
+			rxWindow:         m.RXWindowSize,
+			opened:           make(chan error, 1),
+		}
+		channel.cond = sync.NewCond(&channel.lock)
+		m.channels[id] = channel
+
+		return channel, nil
+	}
+
+	return nil, ErrNoChannelsAvailable
+}
+
+func (m *Mux) lookup(id uint32) *Channel {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.channels[id]
+}
+
+func (m *Mux) remove(id uint32) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.channels, id)
+}
+
+func (m *Mux) writeMessage(message interface{}) error {
+	var bin_buf bytes.Buffer
+
+	if err := binary.Write(&bin_buf, binary.BigEndian, message); err != nil {
+		return err
+	}
+
+	return m.write(bin_buf.Bytes())
+}
+
+func (m *Mux) write(data []byte) error {
+	m.writeLock.Lock()
+	defer m.writeLock.Unlock()
+
+	_, err := m.conn.Write(data)
+
+	return err
+}
+
+// Read reads data received on the channel. It returns io.EOF once AMT has closed the channel
+// and all buffered data has been read. Consumed bytes are returned to AMT with APF_CHANNEL_WINDOW_ADJUST.
+func (c *Channel) Read(p []byte) (int, error) {
+	c.lock.Lock()
+
+	for c.readBuffer.Len() == 0 && !c.remoteClosed && c.err == nil {
+		c.cond.Wait()
+	}
+
+	if c.readBuffer.Len() == 0 {
+		err := c.err
+		if err == nil {
+			err = io.EOF
+		}
+
+		c.lock.Unlock()
+
+		return 0, err
+	}
+
+	n, _ := c.readBuffer.Read(p)
+	c.rxConsumed += uint32(n)
+
+	var bytesToAdd uint32
+
+	if !c.localClosed && (c.rxConsumed >= c.mux.RXWindowSize/2 || c.readBuffer.Len() == 0) {
+		bytesToAdd = c.rxConsumed
+		c.rxWindow += c.rxConsumed
+		c.rxConsumed = 0
+	}
+
+	senderChannel := c.SenderChannel
+	c.lock.Unlock()
+
+	if bytesToAdd > 0 {
+		if err := c.mux.writeMessage(ChannelWindowAdjust(senderChannel, bytesToAdd)); err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+// Write sends p on the channel, splitting it into APF_CHANNEL_DATA messages. It blocks while the
+// transmit window granted by AMT is exhausted.
+func (c *Channel) Write(p []byte) (int, error) {
+	written := 0
+
+	for written < len(p) {
+		c.lock.Lock()
+
+		for c.txWindow == 0 && !c.localClosed && c.err == nil {
+			c.cond.Wait()
+		}
+
+		if c.err != nil || c.localClosed {
+			err := c.err
+			if err == nil {
+				err = ErrChannelClosed
+			}
+
+			c.lock.Unlock()
+
+			return written, err
+		}
+
+		length := uint32(len(p) - written)
+		if length > c.txWindow {
+			length = c.txWindow
+		}
+
+		if length > APF_CHANNEL_MAX_DATA_LENGTH {
+			length = APF_CHANNEL_MAX_DATA_LENGTH
+		}
+
+		c.txWindow -= length
+		senderChannel := c.SenderChannel
+		c.lock.Unlock()
+
+		if err := c.mux.write(ChannelDataBytes(senderChannel, p[written:written+int(length)])); err != nil {
+			return written, err
+		}
+
+		written += int(length)
+	}
+
+	return written, nil
+}
+
+// Close sends APF_CHANNEL_CLOSE to AMT. Pending writers are released with ErrChannelClosed.
+func (c *Channel) Close() error {
+	c.lock.Lock()
+
+	if c.localClosed {
+		c.lock.Unlock()
+
+		return nil
+	}
+
+	c.localClosed = true
+	remoteClosed := c.remoteClosed
+	c.cond.Broadcast()
+	c.lock.Unlock()
+
+	if remoteClosed {
+		return nil
+	}
+
+	return c.mux.writeMessage(ChannelClose(c.SenderChannel))
+}
+
+// TXWindow returns the number of bytes AMT currently allows us to send on the channel.
+func (c *Channel) TXWindow() uint32 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.txWindow
+}
+
+// RXWindow returns the number of bytes AMT may still send before we adjust the window.
+func (c *Channel) RXWindow() uint32 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.rxWindow
+}
+
+func (c *Channel) fail(err error) {
+	c.lock.Lock()
+	c.err = err
+	c.cond.Broadcast()
+	c.lock.Unlock()
+
+	c.signalOpened(err)
+}
+
+func (c *Channel) signalOpened(err error) {
+	select {
+	case c.opened <- err:
+	default:
+	}
+}
+
+// ChannelOpenForPort builds an APF_CHANNEL_OPEN (forwarded-tcpip) message for the given channel, window and port.
+func ChannelOpenForPort(senderChannel, initialWindowSize uint32, connectedAddress string, port uint32) bytes.Buffer {
+	var bin_buf bytes.Buffer
+
+	writeField := func(value interface{}) {
+		if err := binary.Write(&bin_buf, binary.BigEndian, value); err != nil {
+			log.Error(err)
+		}
+	}
+
+	writeField(uint8(APF_CHANNEL_OPEN))
+	writeField(uint32(len(APF_OPEN_CHANNEL_REQUEST_FORWARDED)))
+	bin_buf.WriteString(APF_OPEN_CHANNEL_REQUEST_FORWARDED)
+	writeField(senderChannel)
+	writeField(initialWindowSize)
+	writeField(uint32(0xFFFFFFFF))
+	writeField(uint32(len(connectedAddress)))
+	bin_buf.WriteString(connectedAddress)
+	writeField(port)
+	writeField(uint32(len(connectedAddress)))
+	bin_buf.WriteString(connectedAddress)
+	writeField(uint32(123))
+
+	return bin_buf
+}
+
+// ChannelDataBytes encodes an APF_CHANNEL_DATA message including its payload.
+func ChannelDataBytes(recipientChannel uint32, buffer []byte) []byte {
+	const headerLength = 9
+
+	data := make([]byte, headerLength+len(buffer))
+	data[0] = APF_CHANNEL_DATA
+	binary.BigEndian.PutUint32(data[1:5], recipientChannel)
+	binary.BigEndian.PutUint32(data[5:9], uint32(len(buffer)))
+	copy(data[headerLength:], buffer)
+
+	return data
+}
@@ -0,0 +1,271 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+package apf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type frameRecorder struct {
+	frames chan []byte
+}
+
+func newFrameRecorder() *frameRecorder {
+	return &frameRecorder{frames: make(chan []byte, 100)}
+}
+
+func (f *frameRecorder) Write(p []byte) (int, error) {
+	f.frames <- append([]byte(nil), p...)
+
+	return len(p), nil
+}
+
+func (f *frameRecorder) next(t *testing.T) []byte {
+	t.Helper()
+
+	select {
+	case frame := <-f.frames:
+		return frame
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for apf frame")
+	}
+
+	return nil
+}
+
+func encode(t *testing.T, message interface{}) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	assert.NoError(t, binary.Write(&buf, binary.BigEndian, message))
+
+	return buf.Bytes()
+}
+
+func openChannel(t *testing.T, mux *Mux, recorder *frameRecorder, amtChannel, window uint32) *Channel {
+	t.Helper()
+
+	result := make(chan *Channel)
+
+	go func() {
+		channel, err := mux.Open(16992)
+		assert.NoError(t, err)
+
+		result <- channel
+	}()
+
+	frame := recorder.next(t)
+	assert.Equal(t, byte(APF_CHANNEL_OPEN), frame[0])
+
+	ourChannel := binary.BigEndian.Uint32(frame[5+len(APF_OPEN_CHANNEL_REQUEST_FORWARDED):])
+	confirmation := APF_CHANNEL_OPEN_CONFIRMATION_MESSAGE{
+		MessageType:       APF_CHANNEL_OPEN_CONFIRMATION,
+		RecipientChannel:  ourChannel,
+		SenderChannel:     amtChannel,
+		InitialWindowSize: window,
+	}
+	assert.NoError(t, mux.Process(encode(t, confirmation)))
+
+	return <-result
+}
+
+func TestMuxOpenAllocatesDistinctChannels(t *testing.T) {
+	t.Parallel()
+
+	recorder := newFrameRecorder()
+	mux := NewMux(recorder)
+
+	first := openChannel(t, mux, recorder, 100, 4096)
+	second := openChannel(t, mux, recorder, 200, 4096)
+
+	assert.NotEqual(t, first.RecipientChannel, second.RecipientChannel)
+	assert.Equal(t, uint32(100), first.SenderChannel)
+	assert.Equal(t, uint32(200), second.SenderChannel)
+	assert.Equal(t, 2, mux.Channels())
+}
+
+func TestMuxOpenFailure(t *testing.T) {
+	t.Parallel()
+
+	recorder := newFrameRecorder()
+	mux := NewMux(recorder)
+	errs := make(chan error)
+
+	go func() {
+		_, err := mux.Open(16992)
+		errs <- err
+	}()
+
+	frame := recorder.next(t)
+	ourChannel := binary.BigEndian.Uint32(frame[5+len(APF_OPEN_CHANNEL_REQUEST_FORWARDED):])
+	failure := ChannelOpenReplyFailure(ourChannel, OPEN_FAILURE_REASON_CONNECT_FAILED)
+	assert.NoError(t, mux.Process(encode(t, failure)))
+
+	err := <-errs
+
+	var openErr *ChannelOpenError
+
+	assert.ErrorAs(t, err, &openErr)
+	assert.Equal(t, uint32(OPEN_FAILURE_REASON_CONNECT_FAILED), openErr.ReasonCode)
+	assert.Equal(t, 0, mux.Channels())
+}
+
+func TestMuxOpenTimeout(t *testing.T) {
+	t.Parallel()
+
+	mux := NewMux(newFrameRecorder())
+	mux.OpenTimeout = 10 * time.Millisecond
+
+	_, err := mux.Open(16992)
+	assert.ErrorIs(t, err, ErrChannelOpenTimeout)
+	assert.Equal(t, 0, mux.Channels())
+}
+
+func TestMuxRoutesDataToChannel(t *testing.T) {
+	t.Parallel()
+
+	recorder := newFrameRecorder()
+	mux := NewMux(recorder)
+
+	first := openChannel(t, mux, recorder, 100, 4096)
+	second := openChannel(t, mux, recorder, 200, 4096)
+
+	assert.NoError(t, mux.Process(ChannelDataBytes(second.RecipientChannel, []byte("second"))))
+	assert.NoError(t, mux.Process(ChannelDataBytes(first.RecipientChannel, []byte("first"))))
+
+	buf := make([]byte, 16)
+
+	n, err := second.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "second", string(buf[:n]))
+
+	adjust := recorder.next(t)
+	assert.Equal(t, encode(t, ChannelWindowAdjust(200, 6)), adjust)
+
+	n, err = first.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "first", string(buf[:n]))
+}
+
+func TestMuxSlowConsumerDoesNotStallOtherChannels(t *testing.T) {
+	t.Parallel()
+
+	recorder := newFrameRecorder()
+	mux := NewMux(recorder)
+	mux.RXWindowSize = 8
+
+	slow := openChannel(t, mux, recorder, 100, 4096)
+	fast := openChannel(t, mux, recorder, 200, 4096)
+
+	assert.NoError(t, mux.Process(ChannelDataBytes(slow.RecipientChannel, []byte("12345678"))))
+	assert.ErrorIs(t, mux.Process(ChannelDataBytes(slow.RecipientChannel, []byte("9"))), ErrRXWindowExceeded)
+	assert.Equal(t, uint32(0), slow.RXWindow())
+
+	assert.NoError(t, mux.Process(ChannelDataBytes(fast.RecipientChannel, []byte("abc"))))
+
+	buf := make([]byte, 8)
+
+	n, err := fast.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", string(buf[:n]))
+}
+
+func TestMuxWriteBlocksUntilWindowAdjust(t *testing.T) {
+	t.Parallel()
+
+	recorder := newFrameRecorder()
+	mux := NewMux(recorder)
+	channel := openChannel(t, mux, recorder, 100, 4)
+	done := make(chan int)
+
+	go func() {
+		n, err := channel.Write([]byte("abcdefgh"))
+		assert.NoError(t, err)
+
+		done <- n
+	}()
+
+	assert.Equal(t, ChannelDataBytes(100, []byte("abcd")), recorder.next(t))
+
+	select {
+	case <-done:
+		t.Fatal("write completed without window")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	assert.NoError(t, mux.Process(encode(t, ChannelWindowAdjust(channel.RecipientChannel, 16))))
+
+	assert.Equal(t, ChannelDataBytes(100, []byte("efgh")), recorder.next(t))
+	assert.Equal(t, 8, <-done)
+	assert.Equal(t, uint32(12), channel.TXWindow())
+}
+
+func TestMuxRemoteClose(t *testing.T) {
+	t.Parallel()
+
+	recorder := newFrameRecorder()
+	mux := NewMux(recorder)
+	channel := openChannel(t, mux, recorder, 100, 4096)
+
+	assert.NoError(t, mux.Process(encode(t, ChannelClose(channel.RecipientChannel))))
+	assert.Equal(t, encode(t, ChannelClose(100)), recorder.next(t))
+	assert.Equal(t, 0, mux.Channels())
+
+	_, err := channel.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, io.EOF)
+
+	_, err = channel.Write([]byte("x"))
+	assert.ErrorIs(t, err, ErrChannelClosed)
+	assert.NoError(t, channel.Close())
+}
+
+func TestMuxLocalClose(t *testing.T) {
+	t.Parallel()
+
+	recorder := newFrameRecorder()
+	mux := NewMux(recorder)
+	channel := openChannel(t, mux, recorder, 100, 4096)
+
+	assert.NoError(t, channel.Close())
+	assert.Equal(t, encode(t, ChannelClose(100)), recorder.next(t))
+
+	assert.NoError(t, mux.Process(encode(t, ChannelClose(channel.RecipientChannel))))
+	assert.Equal(t, 0, mux.Channels())
+	assert.Empty(t, recorder.frames)
+}
+
+func TestMuxClose(t *testing.T) {
+	t.Parallel()
+
+	recorder := newFrameRecorder()
+	mux := NewMux(recorder)
+	channel := openChannel(t, mux, recorder, 100, 4096)
+
+	assert.NoError(t, mux.Close())
+
+	_, err := channel.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, ErrMuxClosed)
+
+	_, err = mux.Open(16992)
+	assert.ErrorIs(t, err, ErrMuxClosed)
+}
+
+func TestMuxUnknownChannel(t *testing.T) {
+	t.Parallel()
+
+	mux := NewMux(newFrameRecorder())
+
+	assert.ErrorIs(t, mux.Process(ChannelDataBytes(42, []byte("x"))), ErrUnknownChannel)
+	assert.ErrorIs(t, mux.Process([]byte{APF_CHANNEL_DATA, 0x00}), ErrMessageTooShort)
+	assert.ErrorIs(t, mux.Process(nil), ErrMessageTooShort)
+	assert.NoError(t, mux.Process([]byte{APF_PROTOCOLVERSION}))
+}
@@ -9,7 +9,6 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
-	"time"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -26,8 +25,10 @@ func Process(data []byte, session *Session) bytes.Buffer {
 		dataToSend = ProcessGlobalRequest(data)
 	case APF_CHANNEL_OPEN: // (90) Sent by Intel AMT when a channel needs to be open from Intel AMT. This is not common, but WSMAN events are a good example of channel coming from AMT.
 		log.Debug("received APF_CHANNEL_OPEN")
-	case APF_DISCONNECT: // (1) Intel AMT wants to completely disconnect. Not sure when this happens.
+	case APF_DISCONNECT: // (1) Intel AMT wants to completely disconnect, the reason is reported on the session's ErrorBuffer.
 		log.Debug("received APF_DISCONNECT")
+
+		reportError(session, ProcessDisconnect(data))
 	case APF_SERVICE_REQUEST: // (5)
 		log.Debug("received APF_SERVICE_REQUEST")
 
@@ -54,6 +55,22 @@ func Process(data []byte, session *Session) bytes.Buffer {
 		log.Debug("received APF PROTOCOL VERSION")
 
 		dataToSend = ProcessProtocolVersion(data)
+	case APF_KEEPALIVE_REQUEST: // 208
+		log.Debug("received APF_KEEPALIVE_REQUEST")
+
+		dataToSend = ProcessKeepAliveRequest(data)
+	case APF_KEEPALIVE_REPLY: // 209
+		log.Debug("received APF_KEEPALIVE_REPLY")
+
+		ProcessKeepAliveReply(data)
+	case APF_KEEPALIVE_OPTIONS_REQUEST: // 210
+		log.Debug("received APF_KEEPALIVE_OPTIONS_REQUEST")
+
+		dataToSend = ProcessKeepAliveOptionsRequest(data, session)
+	case APF_KEEPALIVE_OPTIONS_REPLY: // 211
+		log.Debug("received APF_KEEPALIVE_OPTIONS_REPLY")
+
+		ProcessKeepAliveOptionsReply(data, session)
 	case APF_USERAUTH_REQUEST: // 50
 	default:
 	}
@@ -96,7 +113,27 @@ func ProcessChannelClose(data []byte, session *Session) APF_CHANNEL_CLOSE_MESSAG
 }
 
 func ProcessGlobalRequest(data []byte) interface{} {
+	genericHeader, tcpForwardRequest := DecodeGlobalRequest(data)
+
+	var reply interface{}
+
+	if genericHeader.String == APF_GLOBAL_REQUEST_STR_TCP_FORWARD_REQUEST {
+		if tcpForwardRequest.Port == 16992 || tcpForwardRequest.Port == 16993 {
+			reply = TcpForwardReplySuccess(tcpForwardRequest.Port)
+		} else {
+			reply = uint8(APF_REQUEST_FAILURE)
+		}
+	} else if genericHeader.String == APF_GLOBAL_REQUEST_STR_TCP_FORWARD_CANCEL_REQUEST {
+		reply = uint8(APF_REQUEST_SUCCESS)
+	}
+
+	return reply
+}
+
+// DecodeGlobalRequest decodes the request string and the tcpip-forward fields of an APF_GLOBAL_REQUEST message.
+func DecodeGlobalRequest(data []byte) (APF_GENERIC_HEADER, APF_TCP_FORWARD_REQUEST) {
 	genericHeader := APF_GENERIC_HEADER{}
+	tcpForwardRequest := APF_TCP_FORWARD_REQUEST{}
 	dataBuffer := bytes.NewBuffer(data)
 
 	err := binary.Read(dataBuffer, binary.BigEndian, &genericHeader.MessageType)
@@ -109,11 +146,8 @@ func ProcessGlobalRequest(data []byte) interface{} {
 		log.Error(err)
 	}
 
-	var reply interface{}
-
 	if int(genericHeader.StringLength) > 0 {
 		stringBuffer := make([]byte, genericHeader.StringLength)
-		tcpForwardRequest := APF_TCP_FORWARD_REQUEST{}
 
 		err = binary.Read(dataBuffer, binary.BigEndian, &stringBuffer)
 		if err != nil {
@@ -150,19 +184,9 @@ func ProcessGlobalRequest(data []byte) interface{} {
 
 		log.Tracef("%+v", genericHeader)
 		log.Tracef("%+v", tcpForwardRequest)
-
-		if genericHeader.String == APF_GLOBAL_REQUEST_STR_TCP_FORWARD_REQUEST {
-			if tcpForwardRequest.Port == 16992 || tcpForwardRequest.Port == 16993 {
-				reply = TcpForwardReplySuccess(tcpForwardRequest.Port)
-			} else {
-				reply = APF_REQUEST_FAILURE
-			}
-		} else if genericHeader.String == APF_GLOBAL_REQUEST_STR_TCP_FORWARD_CANCEL_REQUEST {
-			reply = APF_REQUEST_SUCCESS
-		}
 	}
 
-	return reply
+	return genericHeader, tcpForwardRequest
 }
 
 func ProcessChannelData(data []byte, session *Session) {
@@ -210,7 +234,7 @@ func ProcessChannelData(data []byte, session *Session) {
 	// // log.Tracef("%+v", session)
 	// return windowAdjust
 	// return windowAdjust
-	session.Timer.Reset(3 * time.Second)
+	ResetIdleTimer(session)
 }
 
 func ProcessServiceRequest(data []byte) APF_SERVICE_ACCEPT_MESSAGE {
@@ -289,7 +313,7 @@ func ProcessChannelOpenFailure(data []byte, session *Session) {
 
 	log.Tracef("%+v", channelOpenFailure)
 	session.Status <- false
-	session.ErrorBuffer <- errors.New("error opening APF channel, reason code: " + fmt.Sprint(channelOpenFailure.ReasonCode))
+	reportError(session, errors.New("error opening APF channel, reason code: "+fmt.Sprint(channelOpenFailure.ReasonCode)))
 }
 
 func ProcessProtocolVersion(data []byte) APF_PROTOCOL_VERSION_MESSAGE {
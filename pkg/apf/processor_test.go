@@ -5,6 +5,7 @@
 package apf
 
 import (
+	"encoding/binary"
 	"sync"
 	"testing"
 	"time"
@@ -84,6 +85,43 @@ func TestProcessGlobalRequest(t *testing.T) {
 	assert.NotNil(t, result)
 }
 
+// globalRequest returns an APF_GLOBAL_REQUEST with the request string, for 127.0.0.1 and the port.
+func globalRequest(request string, port uint32) []byte {
+	data := []byte{APF_GLOBAL_REQUEST}
+	data = binary.BigEndian.AppendUint32(data, uint32(len(request)))
+	data = append(data, request...)
+	data = append(data, 0x01)
+	data = binary.BigEndian.AppendUint32(data, 9)
+	data = append(data, "127.0.0.1"...)
+
+	return binary.BigEndian.AppendUint32(data, port)
+}
+
+func TestProcessGlobalRequestReplies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		data     []byte
+		expected []byte
+	}{
+		{"should fail a tcpip-forward to another port", globalRequest(APF_GLOBAL_REQUEST_STR_TCP_FORWARD_REQUEST, 80), []byte{APF_REQUEST_FAILURE}},
+		{"should accept a cancel-tcpip-forward", globalRequest(APF_GLOBAL_REQUEST_STR_TCP_FORWARD_CANCEL_REQUEST, 16992), []byte{APF_REQUEST_SUCCESS}},
+		{"should accept a tcpip-forward to 16992", globalRequest(APF_GLOBAL_REQUEST_STR_TCP_FORWARD_REQUEST, 16992), []byte{APF_REQUEST_SUCCESS, 0x00, 0x00, 0x42, 0x60}},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := Process(test.data, &Session{})
+			assert.Equal(t, test.expected, result.Bytes())
+		})
+	}
+}
+
 func TestProcessChannelData(t *testing.T) {
 	t.Parallel()
 
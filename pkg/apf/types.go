@@ -32,6 +32,10 @@ const (
 	APF_CHANNEL_DATA              = 94
 	APF_CHANNEL_CLOSE             = 97
 	APF_PROTOCOLVERSION           = 192
+	APF_KEEPALIVE_REQUEST         = 208
+	APF_KEEPALIVE_REPLY           = 209
+	APF_KEEPALIVE_OPTIONS_REQUEST = 210
+	APF_KEEPALIVE_OPTIONS_REPLY   = 211
 )
 
 // disconnect reason codes.
@@ -198,7 +202,7 @@ type APF_CHANNEL_WINDOW_ADJUST_MESSAGE struct {
 type APF_DISCONNECT_MESSAGE struct {
 	MessageType byte
 	ReasonCode  uint32
-	Reserved    uint // short32
+	Reserved    uint16
 }
 
 /**
@@ -239,6 +243,46 @@ type APF_PROTOCOL_VERSION_MESSAGE struct {
 	Reserved      [64]byte
 }
 
+/**
+ * Sent by Intel AMT on an idle connection, and answered with APF_KEEPALIVE_REPLY.
+ * @Cookie - value that must be echoed in the reply.
+ *.*/
+type APF_KEEPALIVE_REQUEST_MESSAGE struct {
+	MessageType byte
+	Cookie      uint32
+}
+
+/**
+ * Reply to APF_KEEPALIVE_REQUEST.
+ * @Cookie - the cookie received in the request.
+ *.*/
+type APF_KEEPALIVE_REPLY_MESSAGE struct {
+	MessageType byte
+	Cookie      uint32
+}
+
+/**
+ * Sets the keep-alive options used by Intel AMT.
+ * @KeepaliveInterval - seconds between keep-alive requests
+ * @ReadTimeout - seconds without traffic after which the connection is considered lost.
+ *.*/
+type APF_KEEPALIVE_OPTIONS_REQUEST_MESSAGE struct {
+	MessageType       byte
+	KeepaliveInterval uint32
+	ReadTimeout       uint32
+}
+
+/**
+ * Reply to APF_KEEPALIVE_OPTIONS_REQUEST holding the values accepted by Intel AMT.
+ * @KeepaliveInterval - seconds between keep-alive requests
+ * @ReadTimeout - seconds without traffic after which the connection is considered lost.
+ *.*/
+type APF_KEEPALIVE_OPTIONS_REPLY_MESSAGE struct {
+	MessageType       byte
+	KeepaliveInterval uint32
+	ReadTimeout       uint32
+}
+
 /**
  * holds the user authentication request success response.
  *.*/
@@ -267,8 +311,16 @@ type Session struct {
 	RXWindow         uint32
 	Tempdata         []byte
 	DataBuffer       chan []byte
-	ErrorBuffer      chan error
-	Status           chan bool
-	Timer            *time.Timer
-	WaitGroup        *sync.WaitGroup
+	// ErrorBuffer receives the disconnect, channel open failure and idle timeout errors of the session. Errors are
+	// never dropped and never block the processing of messages: when the buffer is full, they wait for the reader.
+	ErrorBuffer chan error
+	Status      chan bool
+	Timer       *time.Timer
+	WaitGroup   *sync.WaitGroup
+	// KeepAliveInterval and KeepAliveTimeout hold the values (in seconds) negotiated with APF_KEEPALIVE_OPTIONS.
+	KeepAliveInterval uint32
+	KeepAliveTimeout  uint32
+	// IdleTimeout is the period without channel data after which Timer fires. Defaults to 3 seconds. The negotiated
+	// KeepAliveTimeout is not applied to it.
+	IdleTimeout time.Duration
 }
@@ -0,0 +1,85 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package logexport
+
+import (
+	"io"
+	"sync"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
+)
+
+// Exporter writes the records of a device to a writer, one Write call per record.
+type Exporter struct {
+	writer      io.Writer
+	formatter   Formatter
+	device      Device
+	lock        sync.Mutex
+	wroteHeader bool
+}
+
+// NewExporter creates an exporter that formats records of the device with formatter and writes them to writer.
+// Use a *SyslogWriter to send the records to a syslog server.
+func NewExporter(writer io.Writer, formatter Formatter, device Device) *Exporter {
+	return &Exporter{
+		writer:    writer,
+		formatter: formatter,
+		device:    device,
+	}
+}
+
+// Export writes the records in order.
+func (e *Exporter) Export(records ...Record) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if header, ok := e.formatter.(headerFormatter); ok && !e.wroteHeader {
+		line, err := header.Header()
+		if err != nil {
+			return err
+		}
+
+		if _, err = e.writer.Write(line); err != nil {
+			return err
+		}
+
+		e.wroteHeader = true
+	}
+
+	for i := range records {
+		line, err := e.formatter.Format(e.device, records[i])
+		if err != nil {
+			return err
+		}
+
+		if _, err = e.writer.Write(line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportAuditEvents writes decoded audit log events.
+func (e *Exporter) ExportAuditEvents(events []auditlog.Event) error {
+	records := make([]Record, len(events))
+	for i, event := range events {
+		records[i] = FromAuditEvent(event)
+	}
+
+	return e.Export(records...)
+}
+
+// ExportEventLog writes decoded event log records.
+func (e *Exporter) ExportEventLog(events []messagelog.RefinedEventData) error {
+	records := make([]Record, len(events))
+	for i, event := range events {
+		records[i] = FromEventLog(event)
+	}
+
+	return e.Export(records...)
+}
@@ -0,0 +1,58 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package logexport
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
+)
+
+var errWrite = errors.New("write failed")
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errWrite
+}
+
+func TestExporter(t *testing.T) {
+	t.Run("should write the CSV header once", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		exporter := NewExporter(&buf, CSV{}, testDevice)
+
+		assert.NoError(t, exporter.ExportAuditEvents([]auditlog.Event{testAuditEvent()}))
+		assert.NoError(t, exporter.ExportEventLog([]messagelog.RefinedEventData{testEventLogRecord()}))
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		assert.Len(t, lines, 3)
+		assert.Equal(t, strings.Join(CSVColumns, ","), lines[0])
+		assert.Contains(t, lines[1], ",audit,1605,")
+		assert.Contains(t, lines[2], ",event,6-1,")
+	})
+
+	t.Run("should write one line per record", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		exporter := NewExporter(&buf, JSONLines{}, testDevice)
+
+		assert.NoError(t, exporter.ExportAuditEvents([]auditlog.Event{testAuditEvent(), testAuditEvent()}))
+		assert.Equal(t, 2, strings.Count(buf.String(), "\n"))
+		assert.Contains(t, buf.String(), `"Data":{"AuditAppId":16,`)
+	})
+
+	t.Run("should return write errors", func(t *testing.T) {
+		assert.ErrorIs(t, NewExporter(failingWriter{}, CSV{}, testDevice).Export(testRecord()), errWrite)
+		assert.ErrorIs(t, NewExporter(failingWriter{}, CEF{}, testDevice).Export(testRecord()), errWrite)
+	})
+}
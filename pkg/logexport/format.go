@@ -0,0 +1,259 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package logexport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// Formatter encodes a single record, including its trailing newline.
+type Formatter interface {
+	Format(device Device, record Record) ([]byte, error)
+}
+
+// headerFormatter is implemented by formats that write a header before the first record.
+type headerFormatter interface {
+	Header() ([]byte, error)
+}
+
+// JSONLines writes each record as a JSON object on its own line.
+type JSONLines struct{}
+
+// Format implements Formatter.
+func (JSONLines) Format(device Device, record Record) ([]byte, error) {
+	line, err := json.Marshal(struct {
+		Time          time.Time   `json:"Time"`
+		Device        Device      `json:"Device"`
+		Category      Category    `json:"Category"`
+		Code          string      `json:"Code"`
+		Name          string      `json:"Name"`
+		Severity      string      `json:"Severity"`
+		Message       string      `json:"Message,omitempty"`
+		User          string      `json:"User,omitempty"`
+		SourceAddress string      `json:"SourceAddress,omitempty"`
+		Data          interface{} `json:"Data,omitempty"`
+	}{
+		Time:          record.Time,
+		Device:        device,
+		Category:      record.Category,
+		Code:          record.Code,
+		Name:          record.Name,
+		Severity:      record.Severity.String(),
+		Message:       record.Message,
+		User:          record.User,
+		SourceAddress: record.SourceAddress,
+		Data:          record.Data,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(line, '\n'), nil
+}
+
+// CSVColumns are the columns written by the CSV format.
+var CSVColumns = []string{"Time", "Hostname", "UUID", "Category", "Code", "Name", "Severity", "Message", "User", "SourceAddress"}
+
+// CSV writes each record as a row with the CSVColumns, preceded by a header row.
+type CSV struct{}
+
+// Header implements headerFormatter.
+func (CSV) Header() ([]byte, error) {
+	return csvRow(CSVColumns)
+}
+
+// Format implements Formatter.
+func (CSV) Format(device Device, record Record) ([]byte, error) {
+	return csvRow([]string{
+		record.Time.Format(time.RFC3339),
+		device.Hostname,
+		device.UUID,
+		string(record.Category),
+		record.Code,
+		record.Name,
+		record.Severity.String(),
+		record.Message,
+		record.User,
+		record.SourceAddress,
+	})
+}
+
+func csvRow(row []string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(row); err != nil {
+		return nil, err
+	}
+
+	writer.Flush()
+
+	return buf.Bytes(), writer.Error()
+}
+
+// CEF writes each record as an ArcSight Common Event Format message.
+type CEF struct {
+	Vendor  string // Defaults to Intel.
+	Product string // Defaults to AMT.
+	Version string
+}
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r", `\r`, "\n", `\n`)
+)
+
+// Format implements Formatter.
+func (c CEF) Format(device Device, record Record) ([]byte, error) {
+	vendor, product := c.Vendor, c.Product
+	if vendor == "" {
+		vendor = "Intel"
+	}
+
+	if product == "" {
+		product = "AMT"
+	}
+
+	extensions := []string{
+		"rt=" + fmt.Sprint(record.Time.UnixMilli()),
+		"cat=" + string(record.Category),
+	}
+
+	add := func(key, value string) {
+		if value != "" {
+			extensions = append(extensions, key+"="+cefExtensionEscaper.Replace(value))
+		}
+	}
+
+	add("dvchost", device.Hostname)
+	add("dvc", device.Address)
+	add("deviceExternalId", device.UUID)
+	add("suser", record.User)
+
+	if net.ParseIP(record.SourceAddress) != nil {
+		add("src", record.SourceAddress)
+	} else {
+		add("shost", record.SourceAddress)
+	}
+
+	add("msg", record.Message)
+
+	header := strings.Join([]string{
+		"CEF:0",
+		cefHeaderEscaper.Replace(vendor),
+		cefHeaderEscaper.Replace(product),
+		cefHeaderEscaper.Replace(c.Version),
+		cefHeaderEscaper.Replace(string(record.Category) + ":" + record.Code),
+		cefHeaderEscaper.Replace(record.Name),
+		fmt.Sprint(record.Severity.CEF()),
+	}, "|")
+
+	return []byte(header + "|" + strings.Join(extensions, " ") + "\n"), nil
+}
+
+// Facility is a syslog facility code.
+type Facility int
+
+const (
+	FacilityUser     Facility = 1
+	FacilitySecurity Facility = 4
+	FacilityLogAudit Facility = 13
+	FacilityLocal0   Facility = 16
+)
+
+// structuredDataID is the SD-ID of the structured data element written by the Syslog format, 343 is the private enterprise number of Intel.
+const structuredDataID = "amt@343"
+
+var (
+	sdParamEscaper    = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+	syslogMsgReplacer = strings.NewReplacer("\r\n", "; ", "\n", "; ")
+)
+
+// Syslog writes each record as an RFC 5424 message. Line breaks in the message are replaced with "; " so every
+// record stays on one line.
+type Syslog struct {
+	Facility Facility
+	AppName  string // Defaults to amt.
+}
+
+// NewSyslog returns a Syslog format for the facility and application name.
+func NewSyslog(facility Facility, appName string) Syslog {
+	return Syslog{Facility: facility, AppName: appName}
+}
+
+// Format implements Formatter.
+func (s Syslog) Format(device Device, record Record) ([]byte, error) {
+	appName := s.AppName
+	if appName == "" {
+		appName = "amt"
+	}
+
+	params := []string{
+		sdParam("category", string(record.Category)),
+		sdParam("code", record.Code),
+		sdParam("severity", record.Severity.String()),
+	}
+
+	if device.UUID != "" {
+		params = append(params, sdParam("uuid", device.UUID))
+	}
+
+	if record.User != "" {
+		params = append(params, sdParam("user", record.User))
+	}
+
+	if record.SourceAddress != "" {
+		params = append(params, sdParam("src", record.SourceAddress))
+	}
+
+	message := record.Name
+	if record.Message != "" {
+		message = syslogMsgReplacer.Replace(record.Message)
+	}
+
+	line := fmt.Sprintf("<%d>1 %s %s %s - %s [%s %s] %s\n",
+		int(s.Facility)*8+record.Severity.Syslog(),
+		record.Time.UTC().Format(time.RFC3339),
+		syslogField(device.Hostname, 255),
+		syslogField(appName, 48),
+		syslogField(string(record.Category)+"-"+record.Code, 32),
+		structuredDataID,
+		strings.Join(params, " "),
+		message)
+
+	return []byte(line), nil
+}
+
+func sdParam(name, value string) string {
+	return name + `="` + sdParamEscaper.Replace(value) + `"`
+}
+
+// syslogField returns the value as a header field of printable ASCII characters, or the nil value when it is empty.
+func syslogField(value string, maxLength int) string {
+	field := strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return -1
+		}
+
+		return r
+	}, value)
+
+	if len(field) > maxLength {
+		field = field[:maxLength]
+	}
+
+	if field == "" {
+		return "-"
+	}
+
+	return field
+}
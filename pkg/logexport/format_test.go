@@ -0,0 +1,83 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package logexport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testRecord() Record {
+	return Record{
+		Time:          testTime,
+		Category:      CategoryAudit,
+		Code:          "1605",
+		Name:          "ACL Access with Invalid Credentials",
+		Severity:      SeverityWarning,
+		Message:       "Invalid credentials\nInterface: MEBx | a=b",
+		User:          "admin",
+		SourceAddress: "192.168.1.20",
+	}
+}
+
+func TestJSONLines(t *testing.T) {
+	line, err := JSONLines{}.Format(testDevice, testRecord())
+	assert.NoError(t, err)
+
+	expected := `{"Time":"2024-01-03T00:40:44Z","Device":{"Hostname":"amt.example.com","UUID":"4c4c4544-0046-3010-8031-b4c04f4c4d32","Address":"192.168.1.10"},"Category":"audit","Code":"1605","Name":"ACL Access with Invalid Credentials","Severity":"Warning","Message":"Invalid credentials\nInterface: MEBx | a=b","User":"admin","SourceAddress":"192.168.1.20"}` + "\n"
+	assert.Equal(t, expected, string(line))
+}
+
+func TestCSV(t *testing.T) {
+	header, err := CSV{}.Header()
+	assert.NoError(t, err)
+	assert.Equal(t, "Time,Hostname,UUID,Category,Code,Name,Severity,Message,User,SourceAddress\n", string(header))
+
+	row, err := CSV{}.Format(testDevice, testRecord())
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-01-03T00:40:44Z,amt.example.com,4c4c4544-0046-3010-8031-b4c04f4c4d32,audit,1605,ACL Access with Invalid Credentials,Warning,\"Invalid credentials\nInterface: MEBx | a=b\",admin,192.168.1.20\n", string(row))
+}
+
+func TestCEF(t *testing.T) {
+	t.Run("should use the default vendor and product", func(t *testing.T) {
+		line, err := CEF{Version: "16.1"}.Format(testDevice, testRecord())
+		assert.NoError(t, err)
+		assert.Equal(t, `CEF:0|Intel|AMT|16.1|audit:1605|ACL Access with Invalid Credentials|5|rt=1704242444000 cat=audit dvchost=amt.example.com dvc=192.168.1.10 deviceExternalId=4c4c4544-0046-3010-8031-b4c04f4c4d32 suser=admin src=192.168.1.20 msg=Invalid credentials\nInterface: MEBx | a\=b`+"\n", string(line))
+	})
+
+	t.Run("should escape header fields and use shost for host names", func(t *testing.T) {
+		record := testRecord()
+		record.Name = `a|b\c`
+		record.SourceAddress = "console.example.com"
+		record.User = ""
+		record.Message = ""
+
+		line, err := CEF{Vendor: "OEM", Product: "vPro"}.Format(Device{}, record)
+		assert.NoError(t, err)
+		assert.Equal(t, `CEF:0|OEM|vPro||audit:1605|a\|b\\c|5|rt=1704242444000 cat=audit shost=console.example.com`+"\n", string(line))
+	})
+}
+
+func TestSyslog(t *testing.T) {
+	t.Run("should write an RFC 5424 message", func(t *testing.T) {
+		line, err := NewSyslog(FacilityLogAudit, "").Format(testDevice, testRecord())
+		assert.NoError(t, err)
+		assert.Equal(t, `<108>1 2024-01-03T00:40:44Z amt.example.com amt - audit-1605 [amt@343 category="audit" code="1605" severity="Warning" uuid="4c4c4544-0046-3010-8031-b4c04f4c4d32" user="admin" src="192.168.1.20"] Invalid credentials; Interface: MEBx | a=b`+"\n", string(line))
+	})
+
+	t.Run("should use nil values and escape structured data", func(t *testing.T) {
+		record := testRecord()
+		record.Message = ""
+		record.User = `d"o]e`
+		record.SourceAddress = ""
+		record.Severity = SeverityInformational
+
+		line, err := NewSyslog(FacilityLocal0, "amt agent").Format(Device{}, record)
+		assert.NoError(t, err)
+		assert.Equal(t, `<134>1 2024-01-03T00:40:44Z - amtagent - audit-1605 [amt@343 category="audit" code="1605" severity="Informational" user="d\"o\]e"] ACL Access with Invalid Credentials`+"\n", string(line))
+	})
+}
@@ -0,0 +1,175 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package logexport writes Intel AMT audit log and event log records as JSON Lines, CSV, ArcSight CEF or RFC 5424 syslog messages.
+package logexport
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
+)
+
+// Category is the log a record was read from.
+type Category string
+
+const (
+	CategoryAudit Category = "audit"
+	CategoryEvent Category = "event"
+)
+
+// Severity is the severity of a record, it is mapped to the syslog and CEF severities by the formatters.
+type Severity int
+
+const (
+	SeverityInformational Severity = iota
+	SeverityNotice
+	SeverityWarning
+	SeverityError
+	SeverityCritical
+	SeverityAlert
+)
+
+var severityToString = map[Severity]string{
+	SeverityInformational: "Informational",
+	SeverityNotice:        "Notice",
+	SeverityWarning:       "Warning",
+	SeverityError:         "Error",
+	SeverityCritical:      "Critical",
+	SeverityAlert:         "Alert",
+}
+
+// String returns the name of the severity.
+func (s Severity) String() string {
+	if value, exists := severityToString[s]; exists {
+		return value
+	}
+
+	return "Unknown"
+}
+
+// Syslog returns the RFC 5424 severity code.
+func (s Severity) Syslog() int {
+	switch s {
+	case SeverityNotice:
+		return 5
+	case SeverityWarning:
+		return 4
+	case SeverityError:
+		return 3
+	case SeverityCritical:
+		return 2
+	case SeverityAlert:
+		return 1
+	}
+
+	return 6
+}
+
+// CEF returns the ArcSight CEF severity, from 0 to 10.
+func (s Severity) CEF() int {
+	switch s {
+	case SeverityNotice:
+		return 3
+	case SeverityWarning:
+		return 5
+	case SeverityError:
+		return 7
+	case SeverityCritical:
+		return 9
+	case SeverityAlert:
+		return 10
+	}
+
+	return 1
+}
+
+// Device identifies the Intel AMT device the records were read from.
+type Device struct {
+	Hostname string
+	UUID     string
+	Address  string
+}
+
+// Record is a log record in the form shared by all formats.
+type Record struct {
+	Time          time.Time
+	Category      Category
+	Code          string // Identifies the kind of event, the audit event code or the sensor type and offset of the event log record.
+	Name          string
+	Severity      Severity
+	Message       string
+	User          string      // User or SID that initiated an audit event.
+	SourceAddress string      // Address an audit event was initiated from.
+	Data          interface{} // Decoded record, written by the JSON Lines format.
+}
+
+// auditSeverities lists the audit events that are reported above SeverityNotice.
+var auditSeverities = map[auditlog.EventCode]Severity{
+	auditlog.EventACLAccessWithInvalidCredentials: SeverityWarning,
+	auditlog.EventVNCPasswordFailed3Times:         SeverityWarning,
+	auditlog.EventKVMConsentFailed:                SeverityWarning,
+	auditlog.EventStartOptInBlocked:               SeverityWarning,
+	auditlog.EventSecurityAuditLogCleared:         SeverityWarning,
+	auditlog.EventSecurityAuditLogDisabled:        SeverityWarning,
+	auditlog.EventSecurityAuditLogRecovered:       SeverityWarning,
+	auditlog.EventAMTUnProvisioningStarted:        SeverityWarning,
+	auditlog.EventFirmwareUpdateFailed:            SeverityError,
+}
+
+// eventSeverities maps the messagelog EventSeverity strings to a Severity.
+var eventSeverities = map[string]Severity{
+	"Non-critical condition":    SeverityWarning,
+	"Critical condition":        SeverityCritical,
+	"Non-recoverable condition": SeverityAlert,
+}
+
+// FromAuditEvent converts a decoded audit log event to a record. Audit events are administrative actions and are reported
+// as SeverityNotice, except for failures and changes to the audit log itself.
+func FromAuditEvent(event auditlog.Event) Record {
+	code := event.Code()
+
+	severity, exists := auditSeverities[code]
+	if !exists {
+		severity = SeverityNotice
+	}
+
+	user := event.Initiator.User
+	if event.Initiator.Type == auditlog.InitiatorTypeKerberos {
+		user = event.Initiator.SID
+	}
+
+	return Record{
+		Time:          event.Time,
+		Category:      CategoryAudit,
+		Code:          fmt.Sprint(int(code)),
+		Name:          code.String(),
+		Severity:      severity,
+		Message:       auditlog.GetAuditLogExtendedDataString(int(event.AppID), int(event.EventID), string(event.ExtendedData)),
+		User:          user,
+		SourceAddress: event.NetAddress,
+		Data:          event,
+	}
+}
+
+// FromEventLog converts a decoded event log record to a record.
+func FromEventLog(event messagelog.RefinedEventData) Record {
+	severity, exists := eventSeverities[event.EventSeverity]
+	if !exists {
+		severity = SeverityInformational
+	}
+
+	return Record{
+		Time:     event.TimeStamp.UTC(),
+		Category: CategoryEvent,
+		Code:     fmt.Sprintf("%d-%d", event.EventSensorType, event.EventOffset),
+		Name:     event.Description,
+		Severity: severity,
+		Message:  event.Entity + ": " + event.Description,
+		Data:     event,
+	}
+}
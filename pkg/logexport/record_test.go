@@ -0,0 +1,139 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package logexport
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
+)
+
+var (
+	testTime   = time.Date(2024, time.January, 3, 0, 40, 44, 0, time.UTC)
+	testDevice = Device{Hostname: "amt.example.com", UUID: "4c4c4544-0046-3010-8031-b4c04f4c4d32", Address: "192.168.1.10"}
+)
+
+func testAuditEvent() auditlog.Event {
+	return auditlog.Event{
+		AppID:        auditlog.SecurityAdmin,
+		EventID:      5,
+		Initiator:    auditlog.Initiator{Type: auditlog.InitiatorTypeHTTPDigest, User: "admin"},
+		Time:         testTime,
+		NetAddress:   "192.168.1.20",
+		ExtendedData: []byte{0},
+	}
+}
+
+func testEventLogRecord() messagelog.RefinedEventData {
+	return messagelog.RefinedEventData{
+		TimeStamp:       testTime,
+		Description:     "Authentication failed 3 times. The system may be under attack.",
+		Entity:          "Peripheral",
+		EventSensorType: 6,
+		EventOffset:     1,
+		EventSeverity:   "Critical condition",
+	}
+}
+
+func TestFromAuditEvent(t *testing.T) {
+	record := FromAuditEvent(testAuditEvent())
+
+	assert.Equal(t, testTime, record.Time)
+	assert.Equal(t, CategoryAudit, record.Category)
+	assert.Equal(t, "1605", record.Code)
+	assert.Equal(t, "ACL Access with Invalid Credentials", record.Name)
+	assert.Equal(t, SeverityWarning, record.Severity)
+	assert.Equal(t, "User attempted to access Intel AMT with invalid credentials.", record.Message)
+	assert.Equal(t, "admin", record.User)
+	assert.Equal(t, "192.168.1.20", record.SourceAddress)
+
+	event := testAuditEvent()
+	event.AppID = auditlog.RedirectionManager
+	event.EventID = 8
+	event.Initiator = auditlog.Initiator{Type: auditlog.InitiatorTypeKerberos, SID: "S-1-5-21"}
+
+	record = FromAuditEvent(event)
+	assert.Equal(t, SeverityNotice, record.Severity)
+	assert.Equal(t, "S-1-5-21", record.User)
+}
+
+func TestFromAuditEventMalformedExtendedData(t *testing.T) {
+	tests := []struct {
+		name     string
+		record   []byte
+		expected string
+	}{
+		{
+			name:     "out of range reason",
+			record:   []byte{0, 20, 0, 5, 2, 0, 0, 0, 1, 0, 0, 1, 7},
+			expected: "Internal check of audit log resulted in a recovery action.\nReason: " + auditlog.ValueNotFound,
+		},
+		{
+			name:     "truncated TLS state",
+			record:   []byte{0, 16, 0, 7, 2, 0, 0, 0, 1, 0, 0, 1, 1},
+			expected: "TLS state changed.",
+		},
+		{
+			name:     "truncated realm authentication mode",
+			record:   []byte{0, 16, 0, 17, 2, 0, 0, 0, 1, 0, 0, 2, 1, 0},
+			expected: "Realm authentication mode changed.",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event, err := auditlog.DecodeEvent(base64.StdEncoding.EncodeToString(test.record))
+			assert.NoError(t, err)
+			assert.NotPanics(t, func() {
+				assert.Equal(t, test.expected, FromAuditEvent(event).Message)
+			})
+		})
+	}
+}
+
+func TestFromEventLog(t *testing.T) {
+	record := FromEventLog(testEventLogRecord())
+
+	assert.Equal(t, CategoryEvent, record.Category)
+	assert.Equal(t, "6-1", record.Code)
+	assert.Equal(t, SeverityCritical, record.Severity)
+	assert.Equal(t, "Peripheral: Authentication failed 3 times. The system may be under attack.", record.Message)
+
+	event := testEventLogRecord()
+	event.EventSeverity = "Monitor"
+	assert.Equal(t, SeverityInformational, FromEventLog(event).Severity)
+}
+
+func TestSeverity(t *testing.T) {
+	tests := []struct {
+		severity Severity
+		name     string
+		syslog   int
+		cef      int
+	}{
+		{SeverityInformational, "Informational", 6, 1},
+		{SeverityNotice, "Notice", 5, 3},
+		{SeverityWarning, "Warning", 4, 5},
+		{SeverityError, "Error", 3, 7},
+		{SeverityCritical, "Critical", 2, 9},
+		{SeverityAlert, "Alert", 1, 10},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.name, test.severity.String())
+			assert.Equal(t, test.syslog, test.severity.Syslog())
+			assert.Equal(t, test.cef, test.severity.CEF())
+		})
+	}
+
+	assert.Equal(t, "Unknown", Severity(99).String())
+}
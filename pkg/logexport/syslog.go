@@ -0,0 +1,85 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package logexport
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+)
+
+const (
+	NetworkUDP = "udp"
+	NetworkTCP = "tcp"
+	NetworkTLS = "tls"
+)
+
+// DefaultDialTimeout is the timeout used by DialSyslog to connect to the syslog server.
+const DefaultDialTimeout = 10 * time.Second
+
+var ErrUnsupportedNetwork = errors.New("unsupported syslog network, use udp, tcp or tls")
+
+// SyslogWriter sends syslog messages to a server. Each Write is sent as one message: in a single datagram over UDP
+// (RFC 5426) or with octet counting framing over TCP and TLS (RFC 6587, RFC 5425).
+type SyslogWriter struct {
+	conn   net.Conn
+	framed bool
+}
+
+// DialSyslog connects to the syslog server at address. network is NetworkUDP, NetworkTCP or NetworkTLS, tlsConfig is
+// only used for NetworkTLS.
+func DialSyslog(network, address string, tlsConfig *tls.Config) (*SyslogWriter, error) {
+	dialer := &net.Dialer{Timeout: DefaultDialTimeout}
+
+	var (
+		conn net.Conn
+		err  error
+	)
+
+	switch network {
+	case NetworkUDP, NetworkTCP:
+		conn, err = dialer.Dial(network, address)
+	case NetworkTLS:
+		conn, err = tls.DialWithDialer(dialer, "tcp", address, tlsConfig)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedNetwork, network)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSyslogWriter(conn, network != NetworkUDP), nil
+}
+
+// NewSyslogWriter wraps an established connection. framed selects octet counting framing, required on stream connections.
+func NewSyslogWriter(conn net.Conn, framed bool) *SyslogWriter {
+	return &SyslogWriter{conn: conn, framed: framed}
+}
+
+// Write sends p as one syslog message, without its trailing newline.
+func (w *SyslogWriter) Write(p []byte) (int, error) {
+	message := bytes.TrimRight(p, "\r\n")
+
+	if w.framed {
+		message = append([]byte(strconv.Itoa(len(message))+" "), message...)
+	}
+
+	if _, err := w.conn.Write(message); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Close closes the connection.
+func (w *SyslogWriter) Close() error {
+	return w.conn.Close()
+}
@@ -0,0 +1,198 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package logexport
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// readFramed reads an octet counted syslog message.
+func readFramed(t *testing.T, reader *bufio.Reader) string {
+	t.Helper()
+
+	length, err := reader.ReadString(' ')
+	assert.NoError(t, err)
+
+	size, err := strconv.Atoi(strings.TrimSpace(length))
+	assert.NoError(t, err)
+
+	message := make([]byte, size)
+	_, err = io.ReadFull(reader, message)
+	assert.NoError(t, err)
+
+	return string(message)
+}
+
+// streamListener accepts one connection and sends the framed messages it receives on the returned channel.
+func streamListener(t *testing.T, listener net.Listener, count int) <-chan string {
+	t.Helper()
+
+	messages := make(chan string, count)
+
+	go func() {
+		defer close(messages)
+
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		for i := 0; i < count; i++ {
+			messages <- readFramed(t, reader)
+		}
+	}()
+
+	return messages
+}
+
+func newTLSConfig(t *testing.T) (server, client *tls.Config) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "syslog"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(certificate)
+
+	server = &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+		MinVersion:   tls.VersionTLS12,
+	}
+	client = &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}
+
+	return server, client
+}
+
+func exportToSyslog(t *testing.T, writer *SyslogWriter) {
+	t.Helper()
+
+	exporter := NewExporter(writer, NewSyslog(FacilityLogAudit, "amt"), testDevice)
+	assert.NoError(t, exporter.Export(testRecord()))
+	assert.NoError(t, exporter.Export(FromEventLog(testEventLogRecord())))
+	assert.NoError(t, writer.Close())
+}
+
+func assertSyslogMessages(t *testing.T, messages []string) {
+	t.Helper()
+
+	assert.Len(t, messages, 2)
+	assert.True(t, strings.HasPrefix(messages[0], "<108>1 2024-01-03T00:40:44Z amt.example.com amt - audit-1605 "))
+	assert.True(t, strings.HasPrefix(messages[1], "<106>1 2024-01-03T00:40:44Z amt.example.com amt - event-6-1 "))
+
+	for _, message := range messages {
+		assert.False(t, strings.HasSuffix(message, "\n"))
+	}
+}
+
+func TestDialSyslog(t *testing.T) {
+	t.Run("should send one datagram per message over UDP", func(t *testing.T) {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		assert.NoError(t, err)
+
+		defer conn.Close()
+
+		writer, err := DialSyslog(NetworkUDP, conn.LocalAddr().String(), nil)
+		assert.NoError(t, err)
+
+		exportToSyslog(t, writer)
+
+		messages := []string{}
+		buf := make([]byte, 2048)
+
+		_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+		for i := 0; i < 2; i++ {
+			n, _, err := conn.ReadFrom(buf)
+			assert.NoError(t, err)
+
+			messages = append(messages, string(buf[:n]))
+		}
+
+		assertSyslogMessages(t, messages)
+	})
+
+	t.Run("should frame messages over TCP", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NoError(t, err)
+
+		defer listener.Close()
+
+		received := streamListener(t, listener, 2)
+
+		writer, err := DialSyslog(NetworkTCP, listener.Addr().String(), nil)
+		assert.NoError(t, err)
+
+		exportToSyslog(t, writer)
+
+		messages := []string{}
+		for message := range received {
+			messages = append(messages, message)
+		}
+
+		assertSyslogMessages(t, messages)
+	})
+
+	t.Run("should frame messages over TLS", func(t *testing.T) {
+		serverConfig, clientConfig := newTLSConfig(t)
+
+		listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+		assert.NoError(t, err)
+
+		defer listener.Close()
+
+		received := streamListener(t, listener, 2)
+
+		writer, err := DialSyslog(NetworkTLS, listener.Addr().String(), clientConfig)
+		assert.NoError(t, err)
+
+		exportToSyslog(t, writer)
+
+		messages := []string{}
+		for message := range received {
+			messages = append(messages, message)
+		}
+
+		assertSyslogMessages(t, messages)
+	})
+
+	t.Run("should reject unsupported networks", func(t *testing.T) {
+		_, err := DialSyslog("unix", "/dev/log", nil)
+		assert.ErrorIs(t, err, ErrUnsupportedNetwork)
+	})
+}
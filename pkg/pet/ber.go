@@ -0,0 +1,166 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package pet
+
+import (
+	"strconv"
+	"strings"
+)
+
+// BER tags of the SNMPv1 types used in a trap message.
+const (
+	tagInteger     byte = 0x02
+	tagOctetString byte = 0x04
+	tagNull        byte = 0x05
+	tagOID         byte = 0x06
+	tagSequence    byte = 0x30
+	tagIPAddress   byte = 0x40
+	tagTimeTicks   byte = 0x43
+	tagTrapPDU     byte = 0xA4
+)
+
+// berReader reads the BER encoded elements of a constructed value one at a time.
+type berReader struct {
+	data []byte
+}
+
+// next returns the tag and the contents of the next element.
+func (r *berReader) next() (tag byte, value []byte, err error) {
+	if len(r.data) < 2 {
+		return 0, nil, ErrMalformedPacket
+	}
+
+	tag = r.data[0]
+	length := int(r.data[1])
+	offset := 2
+
+	// Long form lengths give the number of length bytes that follow in the low bits.
+	if length&0x80 != 0 {
+		count := length & 0x7F
+		if count == 0 || count > 4 || len(r.data) < offset+count {
+			return 0, nil, ErrMalformedPacket
+		}
+
+		length = 0
+		for _, b := range r.data[offset : offset+count] {
+			length = length<<8 | int(b)
+		}
+
+		offset += count
+	}
+
+	if length < 0 || len(r.data)-offset < length {
+		return 0, nil, ErrMalformedPacket
+	}
+
+	value = r.data[offset : offset+length]
+	r.data = r.data[offset+length:]
+
+	return tag, value, nil
+}
+
+// expect returns the contents of the next element, which must have the given tag.
+func (r *berReader) expect(tag byte) ([]byte, error) {
+	actual, value, err := r.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if actual != tag {
+		return nil, ErrMalformedPacket
+	}
+
+	return value, nil
+}
+
+// integer reads the next element as a signed INTEGER.
+func (r *berReader) integer() (int64, error) {
+	value, err := r.expect(tagInteger)
+	if err != nil {
+		return 0, err
+	}
+
+	return parseInteger(value)
+}
+
+// unsigned reads the next element as an application type holding an unsigned 32 bit value, such as TimeTicks.
+func (r *berReader) unsigned(tag byte) (uint32, error) {
+	value, err := r.expect(tag)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(value) == 0 || len(value) > 5 || (len(value) == 5 && value[0] != 0) {
+		return 0, ErrMalformedPacket
+	}
+
+	var result uint32
+	for _, b := range value {
+		result = result<<8 | uint32(b)
+	}
+
+	return result, nil
+}
+
+// oid reads the next element as an OBJECT IDENTIFIER in dotted notation.
+func (r *berReader) oid() (string, error) {
+	value, err := r.expect(tagOID)
+	if err != nil {
+		return "", err
+	}
+
+	return parseOID(value)
+}
+
+func parseInteger(value []byte) (int64, error) {
+	if len(value) == 0 || len(value) > 8 {
+		return 0, ErrMalformedPacket
+	}
+
+	result := int64(int8(value[0]))
+	for _, b := range value[1:] {
+		result = result<<8 | int64(b)
+	}
+
+	return result, nil
+}
+
+func parseOID(value []byte) (string, error) {
+	if len(value) == 0 {
+		return "", ErrMalformedPacket
+	}
+
+	arcs := []string{}
+	arc := uint64(0)
+
+	for i, b := range value {
+		arc = arc<<7 | uint64(b&0x7F)
+
+		if b&0x80 != 0 {
+			if i == len(value)-1 || arc > 1<<56 {
+				return "", ErrMalformedPacket
+			}
+
+			continue
+		}
+
+		// The first arc encodes the first two components as 40 * X + Y.
+		if len(arcs) == 0 {
+			first := arc / 40
+			if first > 2 {
+				first = 2
+			}
+
+			arcs = append(arcs, strconv.FormatUint(first, 10), strconv.FormatUint(arc-first*40, 10))
+		} else {
+			arcs = append(arcs, strconv.FormatUint(arc, 10))
+		}
+
+		arc = 0
+	}
+
+	return strings.Join(arcs, "."), nil
+}
@@ -0,0 +1,106 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package pet
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+)
+
+// DefaultAddress is the standard SNMP trap port on all interfaces.
+const DefaultAddress = ":162"
+
+// maxPacketSize is the largest UDP payload.
+const maxPacketSize = 65535
+
+// Receiver receives Platform Event Traps on a UDP socket.
+type Receiver struct {
+	conn net.PacketConn
+	// Community, when set, drops the traps of any other SNMP community.
+	Community string
+	// OnError, when set, is called for each packet that is dropped because it is not a Platform Event Trap or is not
+	// of the configured community.
+	OnError func(source net.Addr, err error)
+}
+
+// ErrCommunityMismatch is passed to OnError for traps of another SNMP community.
+var ErrCommunityMismatch = errors.New("pet: SNMP community mismatch")
+
+// Listen creates a receiver listening on the UDP address, such as DefaultAddress.
+func Listen(address string) (*Receiver, error) {
+	conn, err := net.ListenPacket("udp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewReceiver(conn), nil
+}
+
+// NewReceiver creates a receiver reading from conn.
+func NewReceiver(conn net.PacketConn) *Receiver {
+	return &Receiver{conn: conn}
+}
+
+// Addr returns the local address of the receiver.
+func (r *Receiver) Addr() net.Addr {
+	return r.conn.LocalAddr()
+}
+
+// Close closes the socket of the receiver.
+func (r *Receiver) Close() error {
+	return r.conn.Close()
+}
+
+// Run reads traps and sends them on out until ctx is done or reading from the socket fails.
+func (r *Receiver) Run(ctx context.Context, out chan<- Trap) error {
+	stop := make(chan struct{})
+	defer close(stop)
+
+	// Unblock the pending read when ctx is done.
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = r.conn.SetReadDeadline(time.Now())
+		case <-stop:
+		}
+	}()
+
+	buf := make([]byte, maxPacketSize)
+
+	for {
+		n, source, err := r.conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			return err
+		}
+
+		trap, err := Parse(buf[:n])
+		if err == nil && r.Community != "" && trap.Community != r.Community {
+			err = ErrCommunityMismatch
+		}
+
+		if err != nil {
+			if r.OnError != nil {
+				r.OnError(source, err)
+			}
+
+			continue
+		}
+
+		trap.Source = source
+
+		select {
+		case out <- trap:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,66 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package pet
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
+)
+
+func TestReceiver(t *testing.T) {
+	receiver, err := Listen("127.0.0.1:0")
+	assert.NoError(t, err)
+
+	defer receiver.Close()
+
+	receiver.Community = "public"
+	dropped := make(chan error, 2)
+	receiver.OnError = func(_ net.Addr, err error) {
+		dropped <- err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	traps := make(chan Trap)
+	done := make(chan error)
+
+	go func() {
+		done <- receiver.Run(ctx, traps)
+	}()
+
+	sender, err := net.Dial("udp", receiver.Addr().String())
+	assert.NoError(t, err)
+
+	defer sender.Close()
+
+	otherCommunity := validTrap()
+	otherCommunity.community = "private"
+
+	for _, packet := range [][]byte{{0x30, 0x01}, otherCommunity.encode(), validTrap().encode()} {
+		_, err = sender.Write(packet)
+		assert.NoError(t, err)
+	}
+
+	select {
+	case trap := <-traps:
+		assert.Equal(t, sender.LocalAddr().String(), trap.Source.String())
+		assert.Equal(t, messagelog.SensorTypePlatformSecurityViolationAttempt, trap.Event.SensorType)
+		assert.Equal(t, "Critical condition", trap.Event.Severity.String())
+	case <-time.After(5 * time.Second):
+		t.Fatal("no trap received")
+	}
+
+	assert.ErrorIs(t, <-dropped, ErrMalformedPacket)
+	assert.ErrorIs(t, <-dropped, ErrCommunityMismatch)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
@@ -0,0 +1,271 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package pet receives the Platform Event Traps (PET) that Intel® AMT sends as SNMPv1 traps to its alert destinations,
+// and decodes them into the typed event model of the messagelog package.
+//
+// A PET is an enterprise specific trap whose specific trap number carries the sensor type, event type and event offset,
+// with a single variable binding holding the event data:
+//
+//	struct {
+//	UINT8 GUID[16];
+//	UINT16 SequenceNumber;  // big endian
+//	UINT32 LocalTimestamp;  // big endian, seconds since 1998-01-01 00:00
+//	INT16 UTCOffset;        // big endian, minutes, 0xFFFF if unspecified
+//	UINT8 TrapSourceType;
+//	UINT8 EventSourceType;
+//	UINT8 EventSeverity;
+//	UINT8 SensorDevice;
+//	UINT8 SensorNumber;
+//	UINT8 Entity;
+//	UINT8 EntityInstance;
+//	UINT8 EventData[8];
+//	UINT8 LanguageCode;
+//	UINT32 ManufacturerID;  // big endian
+//	UINT16 SystemID;        // big endian
+//	UINT8 OEMCustomFields[];
+//	} PET_DATA;
+package pet
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
+)
+
+const (
+	// EnterpriseOID is the enterprise of Platform Event Traps sent by wired management controllers.
+	EnterpriseOID = "1.3.6.1.4.1.3183.1.1"
+	// PETDataOID is the variable binding that holds the event data of a Platform Event Trap.
+	PETDataOID = "1.3.6.1.4.1.3183.1.1.1"
+
+	snmpVersion1               = 0
+	genericTrapEnterpriseAlert = 6
+	petDataLength              = 46
+	unspecifiedUTCOffset       = -1
+	oemCustomFieldsTerminator  = 0xC1
+)
+
+// petEpoch is the start of the PET local timestamp, 1998-01-01 00:00.
+var petEpoch = time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+var (
+	// ErrMalformedPacket is returned for packets that are not valid BER encoded SNMP messages.
+	ErrMalformedPacket = errors.New("pet: malformed SNMP packet")
+	// ErrUnsupportedVersion is returned for SNMP messages other than SNMPv1 traps.
+	ErrUnsupportedVersion = errors.New("pet: not an SNMPv1 trap")
+	// ErrNotPlatformEventTrap is returned for SNMPv1 traps that do not carry a Platform Event Trap.
+	ErrNotPlatformEventTrap = errors.New("pet: not a Platform Event Trap")
+)
+
+// Trap is a received Platform Event Trap.
+type Trap struct {
+	Source         net.Addr                 // Address the trap was received from, nil for traps decoded by Parse.
+	Community      string                   // SNMP community of the trap.
+	AgentAddress   net.IP                   // Address of the device as reported in the trap.
+	Uptime         time.Duration            // Time since the management controller was initialized.
+	GUID           string                   // System GUID of the device.
+	SequenceNumber uint16                   // Sequence number of the alert, shared by retransmissions of the same alert.
+	Assertion      bool                     // True for an assertion event, false for a deassertion event.
+	TrapSourceType uint8                    // Type of the entity that sent the trap.
+	LanguageCode   uint8                    // Language of the OEM custom fields.
+	ManufacturerID uint32                   // IANA enterprise number of the system manufacturer.
+	SystemID       uint16                   // System identifier assigned by the manufacturer.
+	OEMData        []byte                   // OEM custom fields without the terminator.
+	Event          messagelog.PlatformEvent // Decoded event.
+}
+
+// Parse decodes an SNMPv1 trap message carrying a Platform Event Trap.
+func Parse(packet []byte) (trap Trap, err error) {
+	message := berReader{data: packet}
+
+	content, err := message.expect(tagSequence)
+	if err != nil {
+		return trap, err
+	}
+
+	reader := berReader{data: content}
+
+	version, err := reader.integer()
+	if err != nil {
+		return trap, err
+	}
+
+	if version != snmpVersion1 {
+		return trap, ErrUnsupportedVersion
+	}
+
+	community, err := reader.expect(tagOctetString)
+	if err != nil {
+		return trap, err
+	}
+
+	trap.Community = string(community)
+
+	tag, pdu, err := reader.next()
+	if err != nil {
+		return trap, err
+	}
+
+	if tag != tagTrapPDU {
+		return trap, ErrUnsupportedVersion
+	}
+
+	specificTrap, data, err := parseTrapPDU(&trap, pdu)
+	if err != nil {
+		return trap, err
+	}
+
+	return trap, decodePETData(&trap, specificTrap, data)
+}
+
+// parseTrapPDU reads the fields of the trap PDU into trap and returns the specific trap number and the PET data.
+func parseTrapPDU(trap *Trap, pdu []byte) (specificTrap int64, data []byte, err error) {
+	reader := berReader{data: pdu}
+
+	enterprise, err := reader.oid()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	agentAddress, err := reader.expect(tagIPAddress)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if len(agentAddress) != net.IPv4len {
+		return 0, nil, ErrMalformedPacket
+	}
+
+	trap.AgentAddress = append(net.IP{}, agentAddress...)
+
+	genericTrap, err := reader.integer()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if specificTrap, err = reader.integer(); err != nil {
+		return 0, nil, err
+	}
+
+	ticks, err := reader.unsigned(tagTimeTicks)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// TimeTicks are hundredths of a second.
+	trap.Uptime = time.Duration(ticks) * 10 * time.Millisecond
+
+	bindings, err := reader.expect(tagSequence)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if enterprise != EnterpriseOID || genericTrap != genericTrapEnterpriseAlert {
+		return 0, nil, ErrNotPlatformEventTrap
+	}
+
+	if data, err = findBinding(bindings, PETDataOID); err != nil {
+		return 0, nil, err
+	}
+
+	return specificTrap, data, nil
+}
+
+// findBinding returns the OCTET STRING value of the variable binding with the given OID.
+func findBinding(bindings []byte, oid string) ([]byte, error) {
+	reader := berReader{data: bindings}
+
+	for len(reader.data) > 0 {
+		binding, err := reader.expect(tagSequence)
+		if err != nil {
+			return nil, err
+		}
+
+		bindingReader := berReader{data: binding}
+
+		name, err := bindingReader.oid()
+		if err != nil {
+			return nil, err
+		}
+
+		if name != oid {
+			continue
+		}
+
+		return bindingReader.expect(tagOctetString)
+	}
+
+	return nil, ErrNotPlatformEventTrap
+}
+
+// decodePETData decodes the specific trap number and the PET data into the event of trap.
+func decodePETData(trap *Trap, specificTrap int64, data []byte) error {
+	if len(data) < petDataLength || specificTrap < 0 {
+		return ErrNotPlatformEventTrap
+	}
+
+	guid, _ := uuid.FromBytes(data[0:16])
+	trap.GUID = guid.String()
+	trap.SequenceNumber = binary.BigEndian.Uint16(data[16:18])
+	trap.TrapSourceType = data[24]
+	trap.LanguageCode = data[39]
+	trap.ManufacturerID = binary.BigEndian.Uint32(data[40:44])
+	trap.SystemID = binary.BigEndian.Uint16(data[44:46])
+
+	oemData := data[petDataLength:]
+	for i, b := range oemData {
+		if b == oemCustomFieldsTerminator {
+			oemData = oemData[:i]
+
+			break
+		}
+	}
+
+	if len(oemData) > 0 {
+		trap.OEMData = append([]byte{}, oemData...)
+	}
+
+	// The specific trap number is 0x00SSTTAO: sensor type, event type, assertion (high bit) and event offset (low nibble).
+	trap.Assertion = specificTrap&0x80 == 0
+
+	raw := messagelog.RawEventData{
+		DeviceAddress:   data[27],
+		EventSensorType: uint8(specificTrap >> 16),
+		EventType:       uint8(specificTrap >> 8),
+		EventOffset:     uint8(specificTrap & 0x0F),
+		EventSourceType: data[25],
+		EventSeverity:   data[26],
+		SensorNumber:    data[28],
+		Entity:          data[29],
+		EntityInstance:  data[30],
+		EventData:       append([]uint8{}, data[31:39]...),
+	}
+
+	trap.Event = raw.PlatformEvent()
+	trap.Event.TimeStamp = decodeTimestamp(binary.BigEndian.Uint32(data[18:22]), int16(binary.BigEndian.Uint16(data[22:24])))
+
+	return nil
+}
+
+// decodeTimestamp converts the PET local timestamp to UTC. A zero timestamp is unspecified and returns the zero time.
+func decodeTimestamp(seconds uint32, utcOffset int16) time.Time {
+	if seconds == 0 {
+		return time.Time{}
+	}
+
+	timestamp := petEpoch.Add(time.Duration(seconds) * time.Second)
+
+	if utcOffset != unspecifiedUTCOffset {
+		timestamp = timestamp.Add(-time.Duration(utcOffset) * time.Minute)
+	}
+
+	return timestamp
+}
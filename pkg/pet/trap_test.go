@@ -0,0 +1,245 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package pet
+
+import (
+	"encoding/binary"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
+)
+
+func encodeTLV(tag byte, contents ...[]byte) []byte {
+	value := []byte{}
+	for _, content := range contents {
+		value = append(value, content...)
+	}
+
+	length := len(value)
+	if length < 0x80 {
+		return append([]byte{tag, byte(length)}, value...)
+	}
+
+	lengthBytes := []byte{}
+	for ; length > 0; length >>= 8 {
+		lengthBytes = append([]byte{byte(length)}, lengthBytes...)
+	}
+
+	return append(append([]byte{tag, 0x80 | byte(len(lengthBytes))}, lengthBytes...), value...)
+}
+
+func encodeInteger(tag byte, value int64) []byte {
+	contents := []byte{byte(value)}
+	for value >>= 8; value != 0 && value != -1; value >>= 8 {
+		contents = append([]byte{byte(value)}, contents...)
+	}
+
+	// Keep the sign of positive values whose high bit is set.
+	if value == 0 && contents[0]&0x80 != 0 {
+		contents = append([]byte{0}, contents...)
+	}
+
+	return encodeTLV(tag, contents)
+}
+
+func encodeOID(oid string) []byte {
+	arcs := []uint64{}
+
+	for _, arc := range strings.Split(oid, ".") {
+		value, _ := strconv.ParseUint(arc, 10, 64)
+		arcs = append(arcs, value)
+	}
+
+	contents := []byte{byte(arcs[0]*40 + arcs[1])}
+
+	for _, arc := range arcs[2:] {
+		encoded := []byte{byte(arc & 0x7F)}
+		for arc >>= 7; arc > 0; arc >>= 7 {
+			encoded = append([]byte{byte(arc&0x7F) | 0x80}, encoded...)
+		}
+
+		contents = append(contents, encoded...)
+	}
+
+	return encodeTLV(tagOID, contents)
+}
+
+type testTrap struct {
+	version      int64
+	community    string
+	enterprise   string
+	genericTrap  int64
+	specificTrap int64
+	bindingOID   string
+	data         []byte
+}
+
+func (trap testTrap) encode() []byte {
+	binding := encodeTLV(tagSequence, encodeOID(trap.bindingOID), encodeTLV(tagOctetString, trap.data))
+
+	pdu := encodeTLV(tagTrapPDU,
+		encodeOID(trap.enterprise),
+		encodeTLV(tagIPAddress, []byte{192, 168, 1, 10}),
+		encodeInteger(tagInteger, trap.genericTrap),
+		encodeInteger(tagInteger, trap.specificTrap),
+		encodeInteger(tagTimeTicks, 12345),
+		encodeTLV(tagSequence, encodeTLV(tagSequence, encodeOID("1.3.6.1.2.1.1.3.0"), encodeTLV(tagNull)), binding),
+	)
+
+	return encodeTLV(tagSequence, encodeInteger(tagInteger, trap.version), encodeTLV(tagOctetString, []byte(trap.community)), pdu)
+}
+
+// petData returns the PET data of 10 failed authentications, sent 2024-01-05 10:00 local time at UTC+2.
+func petData() []byte {
+	data := []byte{
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10, // GUID
+		0x00, 0x2A, // Sequence number
+		0x00, 0x00, 0x00, 0x00, // Local timestamp
+		0x00, 0x78, // UTC offset
+		0x20,                                           // Trap source type
+		0x68,                                           // Event source type
+		0x10,                                           // Event severity
+		0xFF,                                           // Sensor device
+		0xFF,                                           // Sensor number
+		0x26,                                           // Entity
+		0x61,                                           // Entity instance
+		0xAA, 0x0A, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // Event data
+		0x19,                   // Language code
+		0x00, 0x00, 0x01, 0x57, // Manufacturer ID
+		0x00, 0x01, // System ID
+		0xC1, // OEM custom fields terminator
+	}
+
+	seconds := time.Date(2024, time.January, 5, 10, 0, 0, 0, time.UTC).Sub(petEpoch) / time.Second
+	binary.BigEndian.PutUint32(data[18:22], uint32(seconds))
+
+	return data
+}
+
+func validTrap() testTrap {
+	return testTrap{
+		version:      0,
+		community:    "public",
+		enterprise:   EnterpriseOID,
+		genericTrap:  6,
+		specificTrap: 0x066F05,
+		bindingOID:   PETDataOID,
+		data:         petData(),
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Run("should decode a Platform Event Trap", func(t *testing.T) {
+		trap, err := Parse(validTrap().encode())
+		assert.NoError(t, err)
+
+		assert.Equal(t, Trap{
+			Community:      "public",
+			AgentAddress:   net.IP{192, 168, 1, 10},
+			Uptime:         123450 * time.Millisecond,
+			GUID:           "01020304-0506-0708-090a-0b0c0d0e0f10",
+			SequenceNumber: 42,
+			Assertion:      true,
+			TrapSourceType: 0x20,
+			LanguageCode:   0x19,
+			ManufacturerID: 343,
+			SystemID:       1,
+			Event: messagelog.PlatformEvent{
+				TimeStamp:       time.Date(2024, time.January, 5, 8, 0, 0, 0, time.UTC),
+				DeviceAddress:   0xFF,
+				SensorType:      messagelog.SensorTypePlatformSecurityViolationAttempt,
+				EventType:       messagelog.EventTypeSensorSpecific,
+				EventOffset:     5,
+				EventSourceType: 0x68,
+				Severity:        messagelog.SeverityCritical,
+				SensorNumber:    0xFF,
+				Entity:          0x26,
+				EntityInstance:  0x61,
+				EventData:       []uint8{0xAA, 0x0A, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+				Description:     "Authentication failed 10 times. The system may be under attack.",
+			},
+		}, trap)
+	})
+
+	t.Run("should decode deassertions and OEM custom fields", func(t *testing.T) {
+		test := validTrap()
+		test.specificTrap = 0x0F6F82
+		test.data = append(test.data[:46], 0x01, 0x02, 0xC1)
+		test.data[22], test.data[23] = 0xFF, 0xFF
+
+		trap, err := Parse(test.encode())
+		assert.NoError(t, err)
+		assert.False(t, trap.Assertion)
+		assert.Equal(t, []byte{0x01, 0x02}, trap.OEMData)
+		assert.Equal(t, messagelog.SensorTypeSystemFirmwareProgress, trap.Event.SensorType)
+		assert.Equal(t, uint8(2), trap.Event.EventOffset)
+		assert.Equal(t, time.Date(2024, time.January, 5, 10, 0, 0, 0, time.UTC), trap.Event.TimeStamp)
+	})
+
+	t.Run("should return the zero time for an unspecified timestamp", func(t *testing.T) {
+		test := validTrap()
+		test.data[18], test.data[19], test.data[20], test.data[21] = 0, 0, 0, 0
+
+		trap, err := Parse(test.encode())
+		assert.NoError(t, err)
+		assert.True(t, trap.Event.TimeStamp.IsZero())
+	})
+
+	t.Run("should reject packets that are not Platform Event Traps", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			modify   func(trap *testTrap)
+			expected error
+		}{
+			{"SNMPv2c", func(trap *testTrap) { trap.version = 1 }, ErrUnsupportedVersion},
+			{"other enterprise", func(trap *testTrap) { trap.enterprise = "1.3.6.1.4.1.343" }, ErrNotPlatformEventTrap},
+			{"generic trap", func(trap *testTrap) { trap.genericTrap = 0 }, ErrNotPlatformEventTrap},
+			{"missing PET data", func(trap *testTrap) { trap.bindingOID = "1.3.6.1.4.1.3183.1.1.2" }, ErrNotPlatformEventTrap},
+			{"short PET data", func(trap *testTrap) { trap.data = trap.data[:40] }, ErrNotPlatformEventTrap},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				trap := validTrap()
+				test.modify(&trap)
+
+				_, err := Parse(trap.encode())
+				assert.ErrorIs(t, err, test.expected)
+			})
+		}
+	})
+
+	t.Run("should reject malformed packets", func(t *testing.T) {
+		packet := validTrap().encode()
+
+		for _, length := range []int{0, 1, 10, len(packet) / 2, len(packet) - 1} {
+			_, err := Parse(packet[:length])
+			assert.ErrorIs(t, err, ErrMalformedPacket, "length %d", length)
+		}
+
+		_, err := Parse([]byte{tagSequence, 0x85, 0x01, 0x02, 0x03, 0x04, 0x05})
+		assert.ErrorIs(t, err, ErrMalformedPacket)
+	})
+}
+
+func TestParseOID(t *testing.T) {
+	oid, err := parseOID(encodeOID(EnterpriseOID)[2:])
+	assert.NoError(t, err)
+	assert.Equal(t, EnterpriseOID, oid)
+
+	oid, err = parseOID([]byte{0x88, 0x37, 0x01})
+	assert.NoError(t, err)
+	assert.Equal(t, "2.999.1", oid)
+
+	_, err = parseOID([]byte{0x2B, 0x86})
+	assert.ErrorIs(t, err, ErrMalformedPacket)
+}
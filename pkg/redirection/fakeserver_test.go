@@ -0,0 +1,226 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+package redirection
+
+import (
+	"crypto/md5"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// tcpTransport is a Transport to the fake server, standing in for client.NewWsmanTCP.
+type tcpTransport struct {
+	address string
+	conn    net.Conn
+}
+
+func (t *tcpTransport) Connect() error {
+	conn, err := net.Dial("tcp", t.address)
+	t.conn = conn
+
+	return err
+}
+
+func (t *tcpTransport) Send(data []byte) error {
+	_, err := t.conn.Write(data)
+
+	return err
+}
+
+func (t *tcpTransport) Receive() ([]byte, error) {
+	buf := make([]byte, 4096)
+
+	n, err := t.conn.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf[:n], nil
+}
+
+func (t *tcpTransport) CloseConnection() error {
+	return t.conn.Close()
+}
+
+// fakeServer is an in-process AMT redirection server. It performs the session handshake and
+// authentication and then hands the connection to the protocol handler.
+type fakeServer struct {
+	t                *testing.T
+	listener         net.Listener
+	protocol         Protocol
+	username         string
+	password         string
+	authTypes        []byte
+	startStatus      byte
+	handler          func(conn net.Conn)
+	done             chan struct{}
+	authenticatedVia byte
+	// skipHandshake hands the connection to the handler directly, like the RFB port 5900.
+	skipHandshake bool
+}
+
+func newFakeServer(t *testing.T, protocol Protocol, handler func(conn net.Conn)) *fakeServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	server := &fakeServer{
+		t:         t,
+		listener:  listener,
+		protocol:  protocol,
+		username:  "admin",
+		password:  "P@ssw0rd",
+		authTypes: []byte{AuthUsernamePassword, AuthDigest},
+		handler:   handler,
+		done:      make(chan struct{}),
+	}
+
+	t.Cleanup(func() {
+		listener.Close()
+	})
+
+	return server
+}
+
+func (f *fakeServer) transport() *tcpTransport {
+	return &tcpTransport{address: f.listener.Addr().String()}
+}
+
+func (f *fakeServer) serve() {
+	f.t.Cleanup(func() {
+		f.listener.Close()
+		<-f.done
+	})
+
+	go func() {
+		defer close(f.done)
+
+		conn, err := f.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		defer conn.Close()
+
+		if (f.skipHandshake || f.handshake(conn)) && f.handler != nil {
+			f.handler(conn)
+		}
+	}()
+}
+
+func (f *fakeServer) handshake(conn net.Conn) bool {
+	start := readBytes(f.t, conn, 8)
+	assert.Equal(f.t, byte(StartRedirectionSession), start[0])
+	assert.Equal(f.t, f.protocol[:], start[4:8])
+
+	reply := []byte{StartRedirectionSessionReply, f.startStatus, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xAA, 0xBB}
+	writeBytes(f.t, conn, reply)
+
+	if f.startStatus != StatusSuccess {
+		return false
+	}
+
+	authType, _ := readAuthenticate(f.t, conn)
+	assert.Equal(f.t, byte(AuthQuery), authType)
+	writeAuthenticateReply(f.t, conn, StatusSuccess, AuthQuery, f.authTypes)
+
+	if !containsByte(f.authTypes, AuthUsernamePassword) && !containsByte(f.authTypes, AuthDigest) {
+		return false
+	}
+
+	authType, data := readAuthenticate(f.t, conn)
+	f.authenticatedVia = authType
+
+	switch authType {
+	case AuthUsernamePassword:
+		fields, err := splitLengthPrefixed(data, 2)
+		assert.NoError(f.t, err)
+
+		return f.result(conn, fields[0] == f.username && fields[1] == f.password)
+	case AuthDigest:
+		fields, err := splitLengthPrefixed(data, 8)
+		assert.NoError(f.t, err)
+		assert.Equal(f.t, f.username, fields[0])
+		assert.Equal(f.t, DefaultAuthURI, fields[3])
+
+		challenge := append(lengthPrefixed("Digest:A1B2C3"), lengthPrefixed("0123456789abcdef")...)
+		challenge = append(challenge, lengthPrefixed("auth")...)
+		writeAuthenticateReply(f.t, conn, StatusFailure, AuthDigest, challenge)
+
+		_, data = readAuthenticate(f.t, conn)
+		fields, err = splitLengthPrefixed(data, 8)
+		assert.NoError(f.t, err)
+
+		ha1 := md5Hex(f.username + ":Digest:A1B2C3:" + f.password)
+		ha2 := md5Hex("POST:" + fields[3])
+		expected := md5Hex(ha1 + ":0123456789abcdef:" + fields[5] + ":" + fields[4] + ":" + fields[7] + ":" + ha2)
+
+		return f.result(conn, fields[6] == expected)
+	}
+
+	return f.result(conn, false)
+}
+
+func (f *fakeServer) result(conn net.Conn, success bool) bool {
+	status := byte(StatusFailure)
+	if success {
+		status = StatusSuccess
+	}
+
+	writeAuthenticateReply(f.t, conn, status, f.authenticatedVia, nil)
+
+	return success
+}
+
+func md5Hex(value string) string {
+	return fmt.Sprintf("%x", md5.Sum([]byte(value)))
+}
+
+func readBytes(t *testing.T, conn net.Conn, n int) []byte {
+	t.Helper()
+
+	buf := make([]byte, n)
+
+	_, err := io.ReadFull(conn, buf)
+	assert.NoError(t, err)
+
+	return buf
+}
+
+func writeBytes(t *testing.T, conn net.Conn, data []byte) {
+	t.Helper()
+
+	_, err := conn.Write(data)
+	assert.NoError(t, err)
+}
+
+func readAuthenticate(t *testing.T, conn net.Conn) (byte, []byte) {
+	t.Helper()
+
+	header := readBytes(t, conn, authenticateSessionHeaderLength)
+	assert.Equal(t, byte(AuthenticateSession), header[0])
+
+	length := int(binary.LittleEndian.Uint32(header[5:9]))
+
+	return header[4], readBytes(t, conn, length)
+}
+
+func writeAuthenticateReply(t *testing.T, conn net.Conn, status, authType byte, data []byte) {
+	t.Helper()
+
+	header := make([]byte, authenticateSessionHeaderLength)
+	header[0] = AuthenticateSessionReply
+	header[1] = status
+	header[4] = authType
+	binary.LittleEndian.PutUint32(header[5:9], uint32(len(data)))
+
+	writeBytes(t, conn, append(header, data...))
+}
@@ -0,0 +1,541 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+package redirection
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// IDER is an IDE redirection (IDE-R / USB-R) session. It serves the SCSI/ATAPI commands issued by the
+// managed host from local media: an ISO image as CD-ROM and an IMG image as floppy. AMT redirects only
+// these two devices; with USB-R the floppy device is presented to the host as a removable disk, so an
+// IMG disk image is served through Floppy. There is no separate hard disk device.
+type IDER struct {
+	session   *Session
+	lock      sync.Mutex
+	closed    bool
+	enabled   bool
+	info      IDERSessionInfo
+	stats     IDERStatistics
+	sequence  uint32
+	writeLock sync.Mutex
+	// CDROM is served on the slave device, Floppy on the master device. Either may be nil.
+	CDROM  *Media
+	Floppy *Media
+	// StartMode controls when the host starts using the redirected devices.
+	StartMode IDERStartMode
+	// Settings are sent to AMT in IDER OPEN_SESSION.
+	Settings IDERSettings
+}
+
+// Media is a read-only disk image served to the managed host.
+type Media struct {
+	Reader io.ReaderAt
+	Size   int64
+}
+
+// NewIDER creates an IDE-R client on top of the redirection transport.
+func NewIDER(transport Transport, username, password string) *IDER {
+	return &IDER{
+		session:   NewSession(transport, username, password),
+		StartMode: IDERStartOnReboot,
+		Settings:  DefaultIDERSettings,
+	}
+}
+
+// Session returns the underlying redirection session.
+func (i *IDER) Session() *Session {
+	return i.session
+}
+
+// Info returns the values reported by AMT in the OPEN_SESSION reply.
+func (i *IDER) Info() IDERSessionInfo {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	return i.info
+}
+
+// Statistics returns the transfer statistics of the session.
+func (i *IDER) Statistics() IDERStatistics {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	return i.stats
+}
+
+// Enabled reports whether AMT reported the IDE-R devices as enabled.
+func (i *IDER) Enabled() bool {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	return i.enabled
+}
+
+// Connect starts and authenticates the redirection session and opens the IDE-R session.
+// The managed host's requests are served by Serve. Media smaller than one block is rejected
+// with ErrMediaTooSmall before the session is started.
+func (i *IDER) Connect() error {
+	if err := validateMedia(i.CDROM, CDROMBlockSize); err != nil {
+		return fmt.Errorf("CD-ROM: %w", err)
+	}
+
+	if err := validateMedia(i.Floppy, FloppyBlockSize); err != nil {
+		return fmt.Errorf("floppy: %w", err)
+	}
+
+	if err := i.session.Start(ProtocolIDER); err != nil {
+		return err
+	}
+
+	data := make([]byte, 10)
+	binary.LittleEndian.PutUint16(data[0:2], i.Settings.RxTimeout)
+	binary.LittleEndian.PutUint16(data[2:4], i.Settings.TxTimeout)
+	binary.LittleEndian.PutUint16(data[4:6], i.Settings.Heartbeat)
+	binary.LittleEndian.PutUint32(data[6:10], i.Settings.Version)
+
+	if err := i.send(IDEROpenSession, data, false, false); err != nil {
+		return err
+	}
+
+	header, err := i.session.Peek(iderOpenSessionReplyLength)
+	if err != nil {
+		return err
+	}
+
+	if header[0] != IDEROpenSessionReply {
+		return fmt.Errorf("%w: expected IDER OPEN_SESSION reply, got 0x%02x", ErrUnexpectedMessage, header[0])
+	}
+
+	reply, err := i.receive(iderOpenSessionReplyLength + int(header[29]))
+	if err != nil {
+		return err
+	}
+
+	i.lock.Lock()
+	i.info = IDERSessionInfo{
+		MajorVersion:         reply[8],
+		MinorVersion:         reply[9],
+		FirmwareMajorVersion: reply[10],
+		FirmwareMinorVersion: reply[11],
+		ReadBufferSize:       binary.LittleEndian.Uint16(reply[16:18]),
+		WriteBufferSize:      binary.LittleEndian.Uint16(reply[18:20]),
+		ProtocolVersion:      reply[21],
+		IANA:                 binary.LittleEndian.Uint32(reply[25:29]),
+	}
+	i.lock.Unlock()
+
+	if reply[21] >= 1 {
+		return i.send(IDERDisableEnableFeatures, []byte{IDERFeatureRegistersAvailable}, false, false)
+	}
+
+	return nil
+}
+
+// Enable enables the IDE-R devices on the managed host using StartMode.
+func (i *IDER) Enable() error {
+	value := make([]byte, 5)
+	value[0] = IDERFeatureRegistersToggle
+	binary.LittleEndian.PutUint32(value[1:5], uint32(IDERFeatureEnable)|uint32(i.StartMode))
+
+	return i.send(IDERDisableEnableFeatures, value, false, false)
+}
+
+// Disable disables the IDE-R devices on the managed host.
+func (i *IDER) Disable() error {
+	value := make([]byte, 5)
+	value[0] = IDERFeatureRegistersToggle
+
+	return i.send(IDERDisableEnableFeatures, value, false, false)
+}
+
+// Serve handles the managed host's requests until AMT closes the IDE-R session or Close is called.
+func (i *IDER) Serve() error {
+	for {
+		closed, err := i.serveMessage()
+		if err != nil {
+			if i.isClosed() {
+				return nil
+			}
+
+			return err
+		}
+
+		if closed {
+			return nil
+		}
+	}
+}
+
+// Close closes the IDE-R session and the transport.
+func (i *IDER) Close() error {
+	i.lock.Lock()
+	if i.closed {
+		i.lock.Unlock()
+
+		return nil
+	}
+
+	i.closed = true
+	i.lock.Unlock()
+
+	err := i.send(IDERClose, nil, false, false)
+	closeErr := i.session.transport.CloseConnection()
+
+	if err != nil {
+		return err
+	}
+
+	return closeErr
+}
+
+func (i *IDER) isClosed() bool {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	return i.closed
+}
+
+func (i *IDER) serveMessage() (bool, error) {
+	header, err := i.session.Peek(iderHeaderLength)
+	if err != nil {
+		return false, err
+	}
+
+	switch header[0] {
+	case IDERClose:
+		_, err = i.receive(iderHeaderLength)
+
+		return true, err
+	case IDERKeepAlivePing:
+		if _, err = i.receive(iderHeaderLength); err != nil {
+			return false, err
+		}
+
+		return false, i.send(IDERKeepAlivePong, nil, false, false)
+	case IDERKeepAlivePong, IDERHeartbeat:
+		_, err = i.receive(iderHeaderLength)
+
+		return false, err
+	case IDERResetOccurred:
+		if _, err = i.receive(iderResetOccurredLength); err != nil {
+			return false, err
+		}
+
+		return false, i.send(IDERResetOccurredResponse, nil, false, false)
+	case IDERStatusData:
+		return false, i.statusData()
+	case IDERErrorOccurred:
+		_, err = i.receive(iderErrorOccurredLength)
+
+		return false, err
+	case IDERCommandWritten:
+		return false, i.commandWritten()
+	case IDERDataFromHost:
+		header, err = i.session.Peek(iderDataFromHostLength)
+		if err != nil {
+			return false, err
+		}
+
+		// the media is read-only, data written by the host is discarded.
+		_, err = i.receive(iderDataFromHostLength + int(binary.LittleEndian.Uint16(header[9:11])))
+
+		return false, err
+	}
+
+	return false, fmt.Errorf("%w: 0x%02x", ErrUnexpectedMessage, header[0])
+}
+
+func (i *IDER) statusData() error {
+	message, err := i.receive(iderStatusDataLength)
+	if err != nil {
+		return err
+	}
+
+	value := binary.LittleEndian.Uint32(message[9:13])
+
+	switch message[8] {
+	case IDERFeatureRegistersAvailable:
+		if value&0x01 != 0 {
+			return i.Enable()
+		}
+	case IDERFeatureRegistersStatus:
+		i.lock.Lock()
+		i.enabled = value&0x02 != 0
+		i.lock.Unlock()
+	case IDERFeatureRegistersToggle:
+		if value != 1 {
+			return ErrIDERToggleFailed
+		}
+
+		return i.send(IDERDisableEnableFeatures, []byte{IDERFeatureRegistersStatus}, false, false)
+	}
+
+	return nil
+}
+
+func (i *IDER) commandWritten() error {
+	message, err := i.receive(iderCommandWrittenLength)
+	if err != nil {
+		return err
+	}
+
+	device := byte(IDERDeviceFloppy)
+	if message[14]&0x10 != 0 {
+		device = IDERDeviceCDROM
+	}
+
+	i.lock.Lock()
+	i.stats.Commands++
+	i.lock.Unlock()
+
+	return i.handleSCSI(device, message[16:28], message[9]&0x01 != 0)
+}
+
+func (i *IDER) media(device byte) (*Media, int64) {
+	if device == IDERDeviceCDROM {
+		return i.CDROM, CDROMBlockSize
+	}
+
+	return i.Floppy, FloppyBlockSize
+}
+
+func (i *IDER) handleSCSI(device byte, cdb []byte, dma bool) error {
+	media, blockSize := i.media(device)
+
+	switch cdb[0] {
+	case SCSITestUnitReady, SCSIStartStop, SCSIPreventAllowMediumRemoval:
+		if media == nil {
+			return i.senseNotReady(device)
+		}
+
+		return i.commandEnd(device)
+	case SCSIRead6:
+		lba := uint32(cdb[1]&0x1F)<<16 | uint32(cdb[2])<<8 | uint32(cdb[3])
+		length := uint32(cdb[4])
+
+		if length == 0 {
+			length = 256
+		}
+
+		return i.read(device, media, blockSize, lba, length, dma)
+	case SCSIRead10:
+		return i.read(device, media, blockSize, binary.BigEndian.Uint32(cdb[2:6]), uint32(binary.BigEndian.Uint16(cdb[7:9])), dma)
+	case SCSIRead12:
+		return i.read(device, media, blockSize, binary.BigEndian.Uint32(cdb[2:6]), binary.BigEndian.Uint32(cdb[6:10]), dma)
+	case SCSIReadCapacity:
+		if media == nil || media.Size < blockSize {
+			return i.senseNotReady(device)
+		}
+
+		data := make([]byte, 8)
+		binary.BigEndian.PutUint32(data[0:4], uint32(media.Size/blockSize)-1)
+		binary.BigEndian.PutUint32(data[4:8], uint32(blockSize))
+
+		return i.dataToHost(device, true, data, dma)
+	case SCSIWrite6, SCSIWrite10, SCSIWrite12:
+		return i.commandEndSense(device, SenseDataProtect, 0x27, 0x00)
+	case SCSIModeSense6, SCSIModeSense10:
+		if media == nil {
+			return i.senseNotReady(device)
+		}
+
+		return i.modeSense(device, cdb, dma)
+	case SCSIReadFormatCapacities:
+		if media == nil || device != IDERDeviceFloppy {
+			return i.senseNotReady(device)
+		}
+
+		data := []byte{0x00, 0x00, 0x00, 0x08, 0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 0x02, 0x00}
+		binary.BigEndian.PutUint32(data[4:8], uint32(media.Size/blockSize))
+
+		return i.dataToHost(device, true, data, dma)
+	case SCSIReadTOC:
+		if media == nil || device != IDERDeviceCDROM {
+			return i.senseNotReady(device)
+		}
+
+		// a single data track starting at LBA 0 followed by the lead-out.
+		data := []byte{0x00, 0x12, 0x01, 0x01, 0x00, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0xAA, 0x00, 0x00, 0x00, 0x00, 0x00}
+		binary.BigEndian.PutUint32(data[16:20], uint32(media.Size/blockSize))
+
+		return i.dataToHost(device, true, trimAllocation(data, binary.BigEndian.Uint16(cdb[7:9])), dma)
+	}
+
+	return i.commandEndSense(device, SenseIllegalRequest, 0x20, 0x00)
+}
+
+func (i *IDER) modeSense(device byte, cdb []byte, dma bool) error {
+	// mode parameter header only, write protected.
+	if cdb[0] == SCSIModeSense6 {
+		return i.dataToHost(device, true, trimAllocation([]byte{0x03, 0x00, 0x80, 0x00}, uint16(cdb[4])), dma)
+	}
+
+	data := []byte{0x00, 0x06, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00}
+
+	return i.dataToHost(device, true, trimAllocation(data, binary.BigEndian.Uint16(cdb[7:9])), dma)
+}
+
+func trimAllocation(data []byte, allocationLength uint16) []byte {
+	if int(allocationLength) < len(data) {
+		return data[:allocationLength]
+	}
+
+	return data
+}
+
+func (i *IDER) read(device byte, media *Media, blockSize int64, lba, blocks uint32, dma bool) error {
+	if media == nil {
+		return i.senseNotReady(device)
+	}
+
+	if int64(lba)+int64(blocks) > media.Size/blockSize {
+		return i.commandEndSense(device, SenseIllegalRequest, 0x21, 0x00)
+	}
+
+	if blocks == 0 {
+		return i.commandEnd(device)
+	}
+
+	chunk := int64(i.Info().ReadBufferSize) / blockSize * blockSize
+	if chunk == 0 {
+		chunk = blockSize
+	}
+
+	offset := int64(lba) * blockSize
+	remaining := int64(blocks) * blockSize
+	buffer := make([]byte, chunk)
+
+	for remaining > 0 {
+		length := chunk
+		if length > remaining {
+			length = remaining
+		}
+
+		n, err := media.Reader.ReadAt(buffer[:length], offset)
+		if err != nil && !(errors.Is(err, io.EOF) && int64(n) == length) {
+			return err
+		}
+
+		remaining -= length
+		offset += length
+
+		if err = i.dataToHost(device, remaining == 0, buffer[:length], dma); err != nil {
+			return err
+		}
+
+		i.lock.Lock()
+		if device == IDERDeviceCDROM {
+			i.stats.CDROMBytesRead += uint64(length)
+		} else {
+			i.stats.FloppyBytesRead += uint64(length)
+		}
+		i.lock.Unlock()
+	}
+
+	return nil
+}
+
+func (i *IDER) senseNotReady(device byte) error {
+	return i.commandEndSense(device, SenseNotReady, 0x3A, 0x00)
+}
+
+func (i *IDER) commandEnd(device byte) error {
+	return i.send(IDERCommandEndResponse, []byte{0x00, 0x00, 0x00, 0x00, 0xC5, 0x00, 0x03, 0x00, 0x00, 0x00, device, 0x50, 0x00, 0x00, 0x00}, true, false)
+}
+
+func (i *IDER) commandEndSense(device, senseKey, asc, ascq byte) error {
+	return i.send(IDERCommandEndResponse, []byte{0x00, 0x00, 0x00, 0x00, 0x87, senseKey << 4, 0x03, 0x00, 0x00, 0x00, device, 0x51, senseKey, asc, ascq}, true, false)
+}
+
+func (i *IDER) dataToHost(device byte, completed bool, data []byte, dma bool) error {
+	dmaLength := len(data)
+	mode := byte(0xB5)
+
+	if dma {
+		dmaLength = 0
+		mode = 0xB4
+	}
+
+	header := []byte{
+		0x00, byte(len(data)), byte(len(data) >> 8), 0x00, mode, 0x00, 0x02, 0x00,
+		byte(dmaLength), byte(dmaLength >> 8), device, 0x58, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+
+	if completed {
+		header[12], header[14], header[18] = 0x85, 0x03, 0x50
+	}
+
+	return i.send(IDERDataToHost, append(header, data...), completed, dma)
+}
+
+func (i *IDER) send(command byte, data []byte, completed, dma bool) error {
+	i.writeLock.Lock()
+	defer i.writeLock.Unlock()
+
+	var attributes byte
+
+	if completed {
+		attributes = 0x02
+	}
+
+	if dma {
+		attributes |= 0x01
+	}
+
+	message := make([]byte, iderHeaderLength, iderHeaderLength+len(data))
+	message[0] = command
+	message[3] = attributes
+	binary.LittleEndian.PutUint32(message[4:8], i.sequence)
+	i.sequence++
+	message = append(message, data...)
+
+	if err := i.session.Send(message); err != nil {
+		return err
+	}
+
+	i.lock.Lock()
+	i.stats.BytesToAMT += uint64(len(message))
+	i.lock.Unlock()
+
+	return nil
+}
+
+func (i *IDER) receive(n int) ([]byte, error) {
+	message, err := i.session.ReadFull(n)
+	if err != nil {
+		return nil, err
+	}
+
+	i.lock.Lock()
+	i.stats.BytesFromAMT += uint64(n)
+	i.lock.Unlock()
+
+	return message, nil
+}
+
+func validateMedia(media *Media, blockSize int64) error {
+	if media != nil && media.Size < blockSize {
+		return fmt.Errorf("%w: %d bytes", ErrMediaTooSmall, media.Size)
+	}
+
+	return nil
+}
+
+// NewMedia creates a Media from an opened image file.
+func NewMedia(file *os.File) (*Media, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Media{Reader: file, Size: info.Size()}, nil
+}
@@ -0,0 +1,244 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+package redirection
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeIDERDevice plays the managed host and AMT side of an IDE-R session.
+type fakeIDERDevice struct {
+	t        *testing.T
+	conn     net.Conn
+	sequence uint32
+}
+
+func (d *fakeIDERDevice) send(command byte, data []byte) {
+	message := make([]byte, iderHeaderLength)
+	message[0] = command
+	binary.LittleEndian.PutUint32(message[4:8], d.sequence)
+	d.sequence++
+
+	writeBytes(d.t, d.conn, append(message, data...))
+}
+
+func (d *fakeIDERDevice) statusData(register byte, value uint32) {
+	data := make([]byte, 5)
+	data[0] = register
+	binary.LittleEndian.PutUint32(data[1:5], value)
+	d.send(IDERStatusData, data)
+}
+
+// commandWritten issues an ATAPI packet command to the given device.
+func (d *fakeIDERDevice) commandWritten(device byte, cdb []byte) {
+	data := make([]byte, iderCommandWrittenLength-iderHeaderLength)
+	data[6] = device & 0x10
+	copy(data[8:], cdb)
+	d.send(IDERCommandWritten, data)
+}
+
+func (d *fakeIDERDevice) expect(command byte, length int) []byte {
+	message := readBytes(d.t, d.conn, iderHeaderLength+length)
+	assert.Equal(d.t, command, message[0])
+
+	return message
+}
+
+// dataToHost reads a DATA_TO_HOST message and returns its attributes and payload.
+func (d *fakeIDERDevice) dataToHost() (byte, []byte) {
+	header := readBytes(d.t, d.conn, iderHeaderLength+25)
+	assert.Equal(d.t, byte(IDERDataToHost), header[0])
+
+	length := int(header[iderHeaderLength+1]) | int(header[iderHeaderLength+2])<<8
+
+	return header[3], readBytes(d.t, d.conn, length)
+}
+
+func read10(lba uint32, blocks uint16) []byte {
+	cdb := make([]byte, 12)
+	cdb[0] = SCSIRead10
+	binary.BigEndian.PutUint32(cdb[2:6], lba)
+	binary.BigEndian.PutUint16(cdb[7:9], blocks)
+
+	return cdb
+}
+
+func iderHandler(t *testing.T, iso []byte) func(conn net.Conn) {
+	t.Helper()
+
+	return func(conn net.Conn) {
+		device := &fakeIDERDevice{t: t, conn: conn}
+
+		open := device.expect(IDEROpenSession, 10)
+		assert.Equal(t, uint16(30000), binary.LittleEndian.Uint16(open[8:10]))
+
+		reply := make([]byte, iderOpenSessionReplyLength-iderHeaderLength)
+		reply[0], reply[1] = 1, 0
+		binary.LittleEndian.PutUint16(reply[8:10], 4096)
+		reply[13] = 1
+		device.send(IDEROpenSessionReply, reply)
+
+		assert.Equal(t, byte(IDERFeatureRegistersAvailable), device.expect(IDERDisableEnableFeatures, 1)[8])
+		device.statusData(IDERFeatureRegistersAvailable, 1)
+
+		toggle := device.expect(IDERDisableEnableFeatures, 5)
+		assert.Equal(t, byte(IDERFeatureRegistersToggle), toggle[8])
+		assert.Equal(t, uint32(0x09), binary.LittleEndian.Uint32(toggle[9:13]))
+		device.statusData(IDERFeatureRegistersToggle, 1)
+
+		assert.Equal(t, byte(IDERFeatureRegistersStatus), device.expect(IDERDisableEnableFeatures, 1)[8])
+		device.statusData(IDERFeatureRegistersStatus, 2)
+
+		device.send(IDERKeepAlivePing, nil)
+		device.expect(IDERKeepAlivePong, 0)
+
+		// READ CAPACITY on the CD-ROM.
+		device.commandWritten(IDERDeviceCDROM, []byte{SCSIReadCapacity})
+		attributes, data := device.dataToHost()
+		assert.Equal(t, byte(0x02), attributes)
+		assert.Equal(t, uint32(len(iso)/CDROMBlockSize-1), binary.BigEndian.Uint32(data[0:4]))
+		assert.Equal(t, uint32(CDROMBlockSize), binary.BigEndian.Uint32(data[4:8]))
+
+		// READ(10) of three blocks is split by the 4096 byte read buffer.
+		device.commandWritten(IDERDeviceCDROM, read10(1, 3))
+		attributes, data = device.dataToHost()
+		assert.Equal(t, byte(0x00), attributes)
+		assert.Equal(t, iso[2048:6144], data)
+		attributes, data = device.dataToHost()
+		assert.Equal(t, byte(0x02), attributes)
+		assert.Equal(t, iso[6144:8192], data)
+
+		// reading past the end of the media.
+		device.commandWritten(IDERDeviceCDROM, read10(3, 2))
+		sense := device.expect(IDERCommandEndResponse, 15)
+		assert.Equal(t, []byte{SenseIllegalRequest, 0x21, 0x00}, sense[20:23])
+
+		// the media is read-only.
+		device.commandWritten(IDERDeviceCDROM, []byte{SCSIWrite10})
+		sense = device.expect(IDERCommandEndResponse, 15)
+		assert.Equal(t, byte(SenseDataProtect), sense[20])
+
+		// no floppy image is attached.
+		device.commandWritten(IDERDeviceFloppy, []byte{SCSITestUnitReady})
+		sense = device.expect(IDERCommandEndResponse, 15)
+		assert.Equal(t, []byte{SenseNotReady, 0x3A, 0x00}, sense[20:23])
+
+		device.commandWritten(IDERDeviceCDROM, []byte{SCSITestUnitReady})
+		end := device.expect(IDERCommandEndResponse, 15)
+		assert.Equal(t, byte(0x02), end[3])
+		assert.Equal(t, byte(0x50), end[19])
+
+		device.send(IDERClose, nil)
+		device.expect(IDERClose, 0)
+	}
+}
+
+func TestIDERServesISO(t *testing.T) {
+	t.Parallel()
+
+	iso := make([]byte, 4*CDROMBlockSize)
+	for i := range iso {
+		iso[i] = byte(i / CDROMBlockSize)
+	}
+
+	server := newFakeServer(t, ProtocolIDER, iderHandler(t, iso))
+	server.serve()
+
+	ider := NewIDER(server.transport(), "admin", "P@ssw0rd")
+	ider.CDROM = &Media{Reader: bytes.NewReader(iso), Size: int64(len(iso))}
+
+	assert.NoError(t, ider.Connect())
+	assert.Equal(t, uint16(4096), ider.Info().ReadBufferSize)
+	assert.Equal(t, uint8(1), ider.Info().ProtocolVersion)
+	assert.NoError(t, ider.Serve())
+	assert.True(t, ider.Enabled())
+
+	stats := ider.Statistics()
+	assert.Equal(t, uint64(3*CDROMBlockSize), stats.CDROMBytesRead)
+	assert.Equal(t, uint64(0), stats.FloppyBytesRead)
+	assert.Equal(t, uint64(6), stats.Commands)
+	assert.NotZero(t, stats.BytesToAMT)
+	assert.NotZero(t, stats.BytesFromAMT)
+
+	assert.NoError(t, ider.Close())
+	<-server.done
+}
+
+func TestIDERCloseStopsServe(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, ProtocolIDER, func(conn net.Conn) {
+		device := &fakeIDERDevice{t: t, conn: conn}
+		device.expect(IDEROpenSession, 10)
+		device.send(IDEROpenSessionReply, make([]byte, iderOpenSessionReplyLength-iderHeaderLength))
+		device.expect(IDERClose, 0)
+	})
+	server.serve()
+
+	ider := NewIDER(server.transport(), "admin", "P@ssw0rd")
+
+	assert.NoError(t, ider.Connect())
+
+	served := make(chan error)
+
+	go func() {
+		served <- ider.Serve()
+	}()
+
+	assert.NoError(t, ider.Close())
+	assert.NoError(t, <-served)
+	assert.NoError(t, ider.Close())
+}
+
+func TestIDERRejectsMediaSmallerThanABlock(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		setup func(ider *IDER)
+	}{
+		{"empty CD-ROM", func(ider *IDER) { ider.CDROM = &Media{Reader: bytes.NewReader(nil)} }},
+		{"tiny CD-ROM", func(ider *IDER) { ider.CDROM = &Media{Reader: bytes.NewReader(nil), Size: CDROMBlockSize - 1} }},
+		{"tiny floppy", func(ider *IDER) { ider.Floppy = &Media{Reader: bytes.NewReader(nil), Size: 100} }},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			transport := &tcpTransport{}
+			ider := NewIDER(transport, "admin", "P@ssw0rd")
+			test.setup(ider)
+
+			assert.ErrorIs(t, ider.Connect(), ErrMediaTooSmall)
+			assert.Nil(t, transport.conn)
+		})
+	}
+}
+
+func TestNewMedia(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "floppy.img")
+	assert.NoError(t, os.WriteFile(path, make([]byte, 1474560), 0o600))
+
+	file, err := os.Open(path)
+	assert.NoError(t, err)
+
+	defer file.Close()
+
+	media, err := NewMedia(file)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1474560), media.Size)
+}
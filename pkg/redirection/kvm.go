@@ -0,0 +1,987 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+package redirection
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/des"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math/bits"
+	"sync"
+)
+
+// KVM is a remote desktop client for Intel AMT KVM. It speaks RFB either inside an authenticated
+// redirection session (ports 16994/16995) or directly on the RFB port 5900.
+//
+// Update, Screenshot and Framebuffer must be called from a single goroutine; the input events
+// may be sent concurrently.
+type KVM struct {
+	session     *Session
+	direct      bool
+	password    string
+	minor       int
+	lock        sync.Mutex
+	framebuffer *image.RGBA
+	name        string
+	// serverFormat is the native pixel format announced in ServerInit.
+	serverFormat PixelFormat
+	colourMap    map[uint32]color.RGBA
+	zlibInput    bytes.Buffer
+	zlibReader   io.ReadCloser
+	// PixelFormat is requested from the server after the RFB initialisation, defaults to PixelFormat32.
+	PixelFormat PixelFormat
+	// Encodings are requested from the server in order of preference, defaults to DefaultEncodings.
+	Encodings []int32
+	// KVMData, if set, receives the data sent by Intel AMT on the KVM data channel once
+	// EncodingKVMDataChannel was requested. It is called from Update.
+	KVMData func(data []byte)
+}
+
+// NewKVM creates a KVM client that authenticates the redirection session with the given AMT credentials.
+func NewKVM(transport Transport, username, password string) *KVM {
+	return &KVM{
+		session:     NewSession(transport, username, password),
+		colourMap:   map[uint32]color.RGBA{},
+		PixelFormat: PixelFormat32,
+		Encodings:   DefaultEncodings,
+	}
+}
+
+// NewRFBKVM creates a KVM client for the RFB port 5900, authenticating with the AMT RFB password.
+func NewRFBKVM(transport Transport, password string) *KVM {
+	kvm := NewKVM(transport, "", "")
+	kvm.direct = true
+	kvm.password = password
+
+	return kvm
+}
+
+// Session returns the underlying redirection session.
+func (k *KVM) Session() *Session {
+	return k.session
+}
+
+// Name returns the desktop name reported by the server.
+func (k *KVM) Name() string {
+	return k.name
+}
+
+// ServerPixelFormat returns the native pixel format announced by the server.
+func (k *KVM) ServerPixelFormat() PixelFormat {
+	return k.serverFormat
+}
+
+// Connect starts the session and performs the RFB handshake, security and initialisation, then
+// negotiates the pixel format and encodings.
+func (k *KVM) Connect() error {
+	var err error
+
+	if k.direct {
+		err = k.session.transport.Connect()
+	} else {
+		err = k.session.Start(ProtocolKVM)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err = k.handshake(); err != nil {
+		return err
+	}
+
+	if err = k.security(); err != nil {
+		return err
+	}
+
+	if err = k.initialise(); err != nil {
+		return err
+	}
+
+	if err = k.SetPixelFormat(k.PixelFormat); err != nil {
+		return err
+	}
+
+	return k.SetEncodings(k.Encodings...)
+}
+
+// SetPixelFormat requests the pixel format used by the server for all further updates.
+func (k *KVM) SetPixelFormat(format PixelFormat) error {
+	message := make([]byte, 4, 4+rfbPixelFormatLength)
+	message[0] = RFBSetPixelFormat
+
+	if err := k.session.Send(append(message, format.marshal()...)); err != nil {
+		return err
+	}
+
+	k.PixelFormat = format
+
+	return nil
+}
+
+// SetEncodings requests the encodings used by the server, in order of preference. Encodings the
+// client cannot decode are rejected with ErrUnsupportedEncoding before anything is sent.
+func (k *KVM) SetEncodings(encodings ...int32) error {
+	for _, encoding := range encodings {
+		if !supportedEncoding(encoding) {
+			return fmt.Errorf("%w: %d", ErrUnsupportedEncoding, encoding)
+		}
+	}
+
+	message := make([]byte, 4+4*len(encodings))
+	message[0] = RFBSetEncodings
+	binary.BigEndian.PutUint16(message[2:4], uint16(len(encodings)))
+
+	for i, encoding := range encodings {
+		binary.BigEndian.PutUint32(message[4+4*i:], uint32(encoding))
+	}
+
+	if err := k.session.Send(message); err != nil {
+		return err
+	}
+
+	k.Encodings = encodings
+
+	return nil
+}
+
+// Update requests a framebuffer update and processes server messages until it is received.
+// An incremental update only contains the areas that changed since the last update.
+func (k *KVM) Update(incremental bool) error {
+	bounds := k.bounds()
+
+	message := make([]byte, 10)
+	message[0] = RFBFramebufferUpdateRequest
+
+	if incremental {
+		message[1] = 1
+	}
+
+	binary.BigEndian.PutUint16(message[6:8], uint16(bounds.Dx()))
+	binary.BigEndian.PutUint16(message[8:10], uint16(bounds.Dy()))
+
+	if err := k.session.Send(message); err != nil {
+		return err
+	}
+
+	for {
+		updated, err := k.readMessage()
+		if err != nil {
+			return err
+		}
+
+		if updated {
+			return nil
+		}
+	}
+}
+
+// Framebuffer returns a copy of the current framebuffer without requesting an update, or nil
+// before Connect has received the framebuffer size.
+func (k *KVM) Framebuffer() *image.RGBA {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	if k.framebuffer == nil {
+		return nil
+	}
+
+	framebuffer := image.NewRGBA(k.framebuffer.Bounds())
+	copy(framebuffer.Pix, k.framebuffer.Pix)
+
+	return framebuffer
+}
+
+// Screenshot requests a full framebuffer update and returns a copy of the screen.
+func (k *KVM) Screenshot() (*image.RGBA, error) {
+	if err := k.Update(false); err != nil {
+		return nil, err
+	}
+
+	return k.Framebuffer(), nil
+}
+
+// WriteScreenshot captures the screen and writes it to w as PNG.
+func (k *KVM) WriteScreenshot(w io.Writer) error {
+	screenshot, err := k.Screenshot()
+	if err != nil {
+		return err
+	}
+
+	return png.Encode(w, screenshot)
+}
+
+// KeyEvent presses or releases the key identified by an X11 keysym.
+func (k *KVM) KeyEvent(key uint32, down bool) error {
+	message := make([]byte, 8)
+	message[0] = RFBKeyEvent
+
+	if down {
+		message[1] = 1
+	}
+
+	binary.BigEndian.PutUint32(message[4:8], key)
+
+	return k.session.Send(message)
+}
+
+// PressKey presses and releases the key identified by an X11 keysym.
+func (k *KVM) PressKey(key uint32) error {
+	if err := k.KeyEvent(key, true); err != nil {
+		return err
+	}
+
+	return k.KeyEvent(key, false)
+}
+
+// TypeText presses and releases the key of every character in text.
+func (k *KVM) TypeText(text string) error {
+	for _, r := range text {
+		key := uint32(r)
+
+		switch {
+		case r == '\n':
+			key = KeyEnter
+		case r == '\t':
+			key = KeyTab
+		case r > 0xFF:
+			key = 0x01000000 | uint32(r)
+		}
+
+		if err := k.PressKey(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SendKVMData sends data to Intel AMT on the KVM data channel, which must have been enabled by
+// requesting EncodingKVMDataChannel. The data is carried in a ClientCutText message.
+func (k *KVM) SendKVMData(data []byte) error {
+	text := append([]byte(kvmDataChannelPrefix), data...)
+
+	message := make([]byte, 8, 8+len(text))
+	message[0] = RFBClientCutText
+	binary.BigEndian.PutUint32(message[4:8], uint32(len(text)))
+
+	return k.session.Send(append(message, text...))
+}
+
+// PointerEvent moves the pointer to x, y with the given buttons held down.
+func (k *KVM) PointerEvent(buttons byte, x, y uint16) error {
+	message := make([]byte, 6)
+	message[0] = RFBPointerEvent
+	message[1] = buttons
+	binary.BigEndian.PutUint16(message[2:4], x)
+	binary.BigEndian.PutUint16(message[4:6], y)
+
+	return k.session.Send(message)
+}
+
+// Close ends the session and closes the transport.
+func (k *KVM) Close() error {
+	if k.zlibReader != nil {
+		k.zlibReader.Close()
+	}
+
+	if k.direct {
+		return k.session.transport.CloseConnection()
+	}
+
+	return k.session.End()
+}
+
+func (k *KVM) bounds() image.Rectangle {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	if k.framebuffer == nil {
+		return image.Rectangle{}
+	}
+
+	return k.framebuffer.Bounds()
+}
+
+func (k *KVM) handshake() error {
+	version, err := k.session.ReadFull(rfbVersionLength)
+	if err != nil {
+		return err
+	}
+
+	var major, minor int
+
+	if _, err = fmt.Sscanf(string(version), "RFB %03d.%03d\n", &major, &minor); err != nil || major != 3 {
+		return fmt.Errorf("%w: %q", ErrRFBVersion, version)
+	}
+
+	switch {
+	case minor >= 8:
+		k.minor = 8
+	case minor == 7:
+		k.minor = 7
+	default:
+		k.minor = 3
+	}
+
+	return k.session.Send([]byte(fmt.Sprintf("RFB 003.%03d\n", k.minor)))
+}
+
+func (k *KVM) security() error {
+	var securityTypes []byte
+
+	if k.minor == 3 {
+		data, err := k.session.ReadFull(4)
+		if err != nil {
+			return err
+		}
+
+		securityTypes = []byte{byte(binary.BigEndian.Uint32(data))}
+	} else {
+		count, err := k.session.ReadFull(1)
+		if err != nil {
+			return err
+		}
+
+		securityTypes, err = k.session.ReadFull(int(count[0]))
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(securityTypes) == 0 || securityTypes[0] == RFBSecurityInvalid {
+		return k.failure(ErrRFBSecurityNotSupported)
+	}
+
+	var securityType byte
+
+	switch {
+	case containsByte(securityTypes, RFBSecurityNone):
+		securityType = RFBSecurityNone
+	case containsByte(securityTypes, RFBSecurityVNC):
+		securityType = RFBSecurityVNC
+	default:
+		return ErrRFBSecurityNotSupported
+	}
+
+	if k.minor != 3 {
+		if err := k.session.Send([]byte{securityType}); err != nil {
+			return err
+		}
+	}
+
+	if securityType == RFBSecurityVNC {
+		challenge, err := k.session.ReadFull(rfbVNCChallengeSize)
+		if err != nil {
+			return err
+		}
+
+		if err = k.session.Send(vncAuthResponse(k.password, challenge)); err != nil {
+			return err
+		}
+	} else if k.minor != 8 {
+		// before 3.8 there is no SecurityResult for security type None.
+		return nil
+	}
+
+	result, err := k.session.ReadFull(4)
+	if err != nil {
+		return err
+	}
+
+	if binary.BigEndian.Uint32(result) != 0 {
+		if k.minor == 8 {
+			return k.failure(ErrRFBAuthFailed)
+		}
+
+		return ErrRFBAuthFailed
+	}
+
+	return nil
+}
+
+// failure reads the reason string sent by the server and wraps it in err.
+func (k *KVM) failure(err error) error {
+	length, readErr := k.session.ReadFull(4)
+	if readErr != nil {
+		return err
+	}
+
+	reason, readErr := k.session.ReadFull(int(binary.BigEndian.Uint32(length)))
+	if readErr != nil {
+		return err
+	}
+
+	return fmt.Errorf("%w: %s", err, reason)
+}
+
+func (k *KVM) initialise() error {
+	// shared flag, leave other clients connected.
+	if err := k.session.Send([]byte{0x01}); err != nil {
+		return err
+	}
+
+	serverInit, err := k.session.ReadFull(rfbServerInitLength)
+	if err != nil {
+		return err
+	}
+
+	name, err := k.session.ReadFull(int(binary.BigEndian.Uint32(serverInit[20:24])))
+	if err != nil {
+		return err
+	}
+
+	k.name = string(name)
+	k.serverFormat = unmarshalPixelFormat(serverInit[4:20])
+
+	k.lock.Lock()
+	k.framebuffer = image.NewRGBA(image.Rect(0, 0, int(binary.BigEndian.Uint16(serverInit[0:2])), int(binary.BigEndian.Uint16(serverInit[2:4]))))
+	k.lock.Unlock()
+
+	return nil
+}
+
+func (k *KVM) readMessage() (bool, error) {
+	messageType, err := k.session.ReadFull(1)
+	if err != nil {
+		return false, err
+	}
+
+	switch messageType[0] {
+	case RFBFramebufferUpdate:
+		return true, k.framebufferUpdate()
+	case RFBSetColourMapEntries:
+		return false, k.setColourMapEntries()
+	case RFBBell:
+		return false, nil
+	case RFBServerCutText:
+		return false, k.serverCutText()
+	}
+
+	return false, fmt.Errorf("%w: RFB message %d", ErrUnexpectedMessage, messageType[0])
+}
+
+// serverCutText reads a ServerCutText message and passes the KVM data channel data it carries to
+// KVMData. Clipboard text is discarded.
+func (k *KVM) serverCutText() error {
+	header, err := k.session.ReadFull(7)
+	if err != nil {
+		return err
+	}
+
+	text, err := k.session.ReadFull(int(binary.BigEndian.Uint32(header[3:7])))
+	if err != nil {
+		return err
+	}
+
+	if k.KVMData != nil && bytes.HasPrefix(text, []byte(kvmDataChannelPrefix)) {
+		k.KVMData(text[len(kvmDataChannelPrefix):])
+	}
+
+	return nil
+}
+
+func (k *KVM) setColourMapEntries() error {
+	header, err := k.session.ReadFull(5)
+	if err != nil {
+		return err
+	}
+
+	first := uint32(binary.BigEndian.Uint16(header[1:3]))
+	count := int(binary.BigEndian.Uint16(header[3:5]))
+
+	entries, err := k.session.ReadFull(6 * count)
+	if err != nil {
+		return err
+	}
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	for i := 0; i < count; i++ {
+		entry := entries[6*i:]
+		k.colourMap[first+uint32(i)] = color.RGBA{R: entry[0], G: entry[2], B: entry[4], A: 0xFF}
+	}
+
+	return nil
+}
+
+func (k *KVM) framebufferUpdate() error {
+	header, err := k.session.ReadFull(3)
+	if err != nil {
+		return err
+	}
+
+	count := int(binary.BigEndian.Uint16(header[1:3]))
+
+	for i := 0; i < count; i++ {
+		data, err := k.session.ReadFull(rfbRectangleLength)
+		if err != nil {
+			return err
+		}
+
+		x := int(binary.BigEndian.Uint16(data[0:2]))
+		y := int(binary.BigEndian.Uint16(data[2:4]))
+		rect := image.Rect(x, y, x+int(binary.BigEndian.Uint16(data[4:6])), y+int(binary.BigEndian.Uint16(data[6:8])))
+
+		if err = k.rectangle(rect, int32(binary.BigEndian.Uint32(data[8:12]))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func supportedEncoding(encoding int32) bool {
+	switch encoding {
+	case EncodingRaw, EncodingCopyRect, EncodingRRE, EncodingZRLE, EncodingDesktopSize, EncodingKVMDataChannel:
+		return true
+	}
+
+	return false
+}
+
+func (k *KVM) rectangle(rect image.Rectangle, encoding int32) error {
+	switch encoding {
+	case EncodingRaw:
+		return k.raw(rect)
+	case EncodingCopyRect:
+		return k.copyRect(rect)
+	case EncodingRRE:
+		return k.rre(rect)
+	case EncodingZRLE:
+		return k.zrle(rect)
+	case EncodingDesktopSize:
+		k.lock.Lock()
+		k.framebuffer = image.NewRGBA(image.Rect(0, 0, rect.Dx(), rect.Dy()))
+		k.lock.Unlock()
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: %d", ErrUnsupportedEncoding, encoding)
+}
+
+func (k *KVM) raw(rect image.Rectangle) error {
+	size := int(k.PixelFormat.BitsPerPixel / 8)
+
+	data, err := k.session.ReadFull(rect.Dx() * rect.Dy() * size)
+	if err != nil {
+		return err
+	}
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	for p := 0; p < rect.Dx()*rect.Dy(); p++ {
+		k.framebuffer.SetRGBA(rect.Min.X+p%rect.Dx(), rect.Min.Y+p/rect.Dx(), k.color(k.PixelFormat.value(data[p*size:])))
+	}
+
+	return nil
+}
+
+func (k *KVM) copyRect(rect image.Rectangle) error {
+	data, err := k.session.ReadFull(4)
+	if err != nil {
+		return err
+	}
+
+	source := image.Pt(int(binary.BigEndian.Uint16(data[0:2])), int(binary.BigEndian.Uint16(data[2:4])))
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	// copy through a temporary image, source and destination may overlap.
+	area := image.NewRGBA(image.Rect(0, 0, rect.Dx(), rect.Dy()))
+	draw.Draw(area, area.Bounds(), k.framebuffer, source, draw.Src)
+	draw.Draw(k.framebuffer, rect, area, image.Point{}, draw.Src)
+
+	return nil
+}
+
+func (k *KVM) rre(rect image.Rectangle) error {
+	size := int(k.PixelFormat.BitsPerPixel / 8)
+
+	header, err := k.session.ReadFull(4 + size)
+	if err != nil {
+		return err
+	}
+
+	count := int(binary.BigEndian.Uint32(header[0:4]))
+
+	subrectangles, err := k.session.ReadFull(count * (size + 8))
+	if err != nil {
+		return err
+	}
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	k.fill(rect, k.color(k.PixelFormat.value(header[4:])))
+
+	for i := 0; i < count; i++ {
+		subrectangle := subrectangles[i*(size+8):]
+		data := subrectangle[size:]
+		x := rect.Min.X + int(binary.BigEndian.Uint16(data[0:2]))
+		y := rect.Min.Y + int(binary.BigEndian.Uint16(data[2:4]))
+
+		k.fill(image.Rect(x, y, x+int(binary.BigEndian.Uint16(data[4:6])), y+int(binary.BigEndian.Uint16(data[6:8]))), k.color(k.PixelFormat.value(subrectangle)))
+	}
+
+	return nil
+}
+
+func (k *KVM) zrle(rect image.Rectangle) error {
+	header, err := k.session.ReadFull(4)
+	if err != nil {
+		return err
+	}
+
+	data, err := k.session.ReadFull(int(binary.BigEndian.Uint32(header)))
+	if err != nil {
+		return err
+	}
+
+	// the zlib stream spans all ZRLE rectangles of the connection.
+	k.zlibInput.Write(data)
+
+	if k.zlibReader == nil {
+		if k.zlibReader, err = zlib.NewReader(&k.zlibInput); err != nil {
+			return err
+		}
+	}
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	for y := rect.Min.Y; y < rect.Max.Y; y += rfbZRLETileSize {
+		for x := rect.Min.X; x < rect.Max.X; x += rfbZRLETileSize {
+			tile := image.Rect(x, y, x+rfbZRLETileSize, y+rfbZRLETileSize).Intersect(rect)
+
+			if err = k.zrleTile(tile); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (k *KVM) zrleTile(tile image.Rectangle) error {
+	reader := k.zlibReader
+	size := k.PixelFormat.compactSize()
+
+	subencoding, err := readZRLE(reader, 1)
+	if err != nil {
+		return err
+	}
+
+	pixels := tile.Dx() * tile.Dy()
+	set := func(p int, c color.RGBA) {
+		k.framebuffer.SetRGBA(tile.Min.X+p%tile.Dx(), tile.Min.Y+p/tile.Dx(), c)
+	}
+
+	switch s := int(subencoding[0]); {
+	case s == 0:
+		data, err := readZRLE(reader, pixels*size)
+		if err != nil {
+			return err
+		}
+
+		for p := 0; p < pixels; p++ {
+			set(p, k.color(k.PixelFormat.compactValue(data[p*size:p*size+size])))
+		}
+	case s == 1:
+		data, err := readZRLE(reader, size)
+		if err != nil {
+			return err
+		}
+
+		k.fill(tile, k.color(k.PixelFormat.compactValue(data)))
+	case s <= 16:
+		palette, err := k.zrlePalette(reader, s, size)
+		if err != nil {
+			return err
+		}
+
+		bitsPerIndex := 4
+
+		switch {
+		case s == 2:
+			bitsPerIndex = 1
+		case s <= 4:
+			bitsPerIndex = 2
+		}
+
+		rowLength := (tile.Dx()*bitsPerIndex + 7) / 8
+
+		data, err := readZRLE(reader, rowLength*tile.Dy())
+		if err != nil {
+			return err
+		}
+
+		for p := 0; p < pixels; p++ {
+			bit := (p % tile.Dx()) * bitsPerIndex
+			index := data[(p/tile.Dx())*rowLength+bit/8] >> (8 - bitsPerIndex - bit%8) & (1<<bitsPerIndex - 1)
+
+			if int(index) >= len(palette) {
+				return ErrMalformedMessage
+			}
+
+			set(p, palette[index])
+		}
+	case s == 128, s >= 130:
+		var palette []color.RGBA
+
+		if s >= 130 {
+			if palette, err = k.zrlePalette(reader, s-128, size); err != nil {
+				return err
+			}
+		}
+
+		for p := 0; p < pixels; {
+			var c color.RGBA
+
+			run := 1
+
+			if palette == nil {
+				data, err := readZRLE(reader, size)
+				if err != nil {
+					return err
+				}
+
+				c = k.color(k.PixelFormat.compactValue(data))
+
+				if run, err = readRunLength(reader); err != nil {
+					return err
+				}
+			} else {
+				data, err := readZRLE(reader, 1)
+				if err != nil {
+					return err
+				}
+
+				if int(data[0]&0x7F) >= len(palette) {
+					return ErrMalformedMessage
+				}
+
+				c = palette[data[0]&0x7F]
+
+				if data[0]&0x80 != 0 {
+					if run, err = readRunLength(reader); err != nil {
+						return err
+					}
+				}
+			}
+
+			if p+run > pixels {
+				return ErrMalformedMessage
+			}
+
+			for ; run > 0; run-- {
+				set(p, c)
+				p++
+			}
+		}
+	default:
+		return fmt.Errorf("%w: ZRLE subencoding %d", ErrMalformedMessage, s)
+	}
+
+	return nil
+}
+
+func (k *KVM) zrlePalette(reader io.Reader, count, size int) ([]color.RGBA, error) {
+	data, err := readZRLE(reader, count*size)
+	if err != nil {
+		return nil, err
+	}
+
+	palette := make([]color.RGBA, count)
+	for i := range palette {
+		palette[i] = k.color(k.PixelFormat.compactValue(data[i*size : i*size+size]))
+	}
+
+	return palette, nil
+}
+
+func readZRLE(reader io.Reader, n int) ([]byte, error) {
+	data := make([]byte, n)
+
+	if _, err := io.ReadFull(reader, data); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedMessage, err)
+	}
+
+	return data, nil
+}
+
+func readRunLength(reader io.Reader) (int, error) {
+	length := 1
+
+	for {
+		data, err := readZRLE(reader, 1)
+		if err != nil {
+			return 0, err
+		}
+
+		length += int(data[0])
+
+		if data[0] != 0xFF {
+			return length, nil
+		}
+	}
+}
+
+func (k *KVM) fill(rect image.Rectangle, c color.RGBA) {
+	draw.Draw(k.framebuffer, rect, &image.Uniform{C: c}, image.Point{}, draw.Src)
+}
+
+// color converts a pixel value in the negotiated pixel format. The caller holds the lock.
+func (k *KVM) color(value uint32) color.RGBA {
+	format := k.PixelFormat
+
+	if !format.TrueColor {
+		return k.colourMap[value]
+	}
+
+	return color.RGBA{
+		R: scaleColor(value>>format.RedShift, format.RedMax),
+		G: scaleColor(value>>format.GreenShift, format.GreenMax),
+		B: scaleColor(value>>format.BlueShift, format.BlueMax),
+		A: 0xFF,
+	}
+}
+
+func scaleColor(value uint32, maximum uint16) uint8 {
+	if maximum == 0 {
+		return 0
+	}
+
+	return uint8((value & uint32(maximum)) * 0xFF / uint32(maximum))
+}
+
+func (f PixelFormat) marshal() []byte {
+	data := make([]byte, rfbPixelFormatLength)
+	data[0] = f.BitsPerPixel
+	data[1] = f.Depth
+
+	if f.BigEndian {
+		data[2] = 1
+	}
+
+	if f.TrueColor {
+		data[3] = 1
+	}
+
+	binary.BigEndian.PutUint16(data[4:6], f.RedMax)
+	binary.BigEndian.PutUint16(data[6:8], f.GreenMax)
+	binary.BigEndian.PutUint16(data[8:10], f.BlueMax)
+	data[10] = f.RedShift
+	data[11] = f.GreenShift
+	data[12] = f.BlueShift
+
+	return data
+}
+
+func unmarshalPixelFormat(data []byte) PixelFormat {
+	return PixelFormat{
+		BitsPerPixel: data[0],
+		Depth:        data[1],
+		BigEndian:    data[2] != 0,
+		TrueColor:    data[3] != 0,
+		RedMax:       binary.BigEndian.Uint16(data[4:6]),
+		GreenMax:     binary.BigEndian.Uint16(data[6:8]),
+		BlueMax:      binary.BigEndian.Uint16(data[8:10]),
+		RedShift:     data[10],
+		GreenShift:   data[11],
+		BlueShift:    data[12],
+	}
+}
+
+// value decodes a pixel of BitsPerPixel from data.
+func (f PixelFormat) value(data []byte) uint32 {
+	switch f.BitsPerPixel {
+	case 8:
+		return uint32(data[0])
+	case 16:
+		if f.BigEndian {
+			return uint32(binary.BigEndian.Uint16(data))
+		}
+
+		return uint32(binary.LittleEndian.Uint16(data))
+	}
+
+	if f.BigEndian {
+		return binary.BigEndian.Uint32(data)
+	}
+
+	return binary.LittleEndian.Uint32(data)
+}
+
+// fitsLow reports whether all colour bits fit in the least significant three bytes.
+func (f PixelFormat) fitsLow() bool {
+	return int(f.RedShift)+bits.Len16(f.RedMax) <= 24 &&
+		int(f.GreenShift)+bits.Len16(f.GreenMax) <= 24 &&
+		int(f.BlueShift)+bits.Len16(f.BlueMax) <= 24
+}
+
+// fitsHigh reports whether all colour bits fit in the most significant three bytes.
+func (f PixelFormat) fitsHigh() bool {
+	return f.RedShift >= 8 && f.GreenShift >= 8 && f.BlueShift >= 8
+}
+
+// compactSize is the size of a ZRLE CPIXEL, which drops the unused byte of 32 bit true colour pixels.
+func (f PixelFormat) compactSize() int {
+	if f.TrueColor && f.BitsPerPixel == 32 && f.Depth <= 24 && (f.fitsLow() || f.fitsHigh()) {
+		return 3
+	}
+
+	return int(f.BitsPerPixel / 8)
+}
+
+// compactValue decodes a ZRLE CPIXEL.
+func (f PixelFormat) compactValue(data []byte) uint32 {
+	if len(data) != 3 {
+		return f.value(data)
+	}
+
+	value := uint32(data[0]) | uint32(data[1])<<8 | uint32(data[2])<<16
+	if f.BigEndian {
+		value = uint32(data[0])<<16 | uint32(data[1])<<8 | uint32(data[2])
+	}
+
+	if !f.fitsLow() {
+		value <<= 8
+	}
+
+	return value
+}
+
+// vncAuthResponse encrypts the challenge with the password using the VNC flavour of DES,
+// which reverses the bits of every key byte.
+func vncAuthResponse(password string, challenge []byte) []byte {
+	key := make([]byte, 8)
+	copy(key, password)
+
+	for i := range key {
+		key[i] = bits.Reverse8(key[i])
+	}
+
+	// the key is always 8 bytes long.
+	cipher, _ := des.NewCipher(key)
+
+	response := make([]byte, len(challenge))
+	for i := 0; i+8 <= len(challenge); i += 8 {
+		cipher.Encrypt(response[i:i+8], challenge[i:i+8])
+	}
+
+	return response
+}
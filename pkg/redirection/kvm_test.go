@@ -0,0 +1,408 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+package redirection
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/des"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/png"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	red   = color.RGBA{R: 0xFF, A: 0xFF}
+	green = color.RGBA{G: 0xFF, A: 0xFF}
+	blue  = color.RGBA{B: 0xFF, A: 0xFF}
+	white = color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}
+	black = color.RGBA{A: 0xFF}
+)
+
+// fakeRFBServer plays the RFB server side of a KVM session using PixelFormat32.
+type fakeRFBServer struct {
+	t          *testing.T
+	conn       net.Conn
+	zlibBuffer bytes.Buffer
+	zlibWriter *zlib.Writer
+}
+
+func newFakeRFBServer(t *testing.T, conn net.Conn) *fakeRFBServer {
+	t.Helper()
+
+	server := &fakeRFBServer{t: t, conn: conn}
+	server.zlibWriter = zlib.NewWriter(&server.zlibBuffer)
+
+	return server
+}
+
+func (s *fakeRFBServer) version(version string) {
+	writeBytes(s.t, s.conn, []byte(version))
+	assert.Equal(s.t, version, string(readBytes(s.t, s.conn, rfbVersionLength)))
+}
+
+func (s *fakeRFBServer) serverInit(width, height uint16, format PixelFormat, name string) {
+	assert.Equal(s.t, []byte{0x01}, readBytes(s.t, s.conn, 1))
+
+	data := make([]byte, 4)
+	binary.BigEndian.PutUint16(data[0:2], width)
+	binary.BigEndian.PutUint16(data[2:4], height)
+	data = append(data, format.marshal()...)
+	data = binary.BigEndian.AppendUint32(data, uint32(len(name)))
+	writeBytes(s.t, s.conn, append(data, name...))
+
+	setPixelFormat := readBytes(s.t, s.conn, 4+rfbPixelFormatLength)
+	assert.Equal(s.t, byte(RFBSetPixelFormat), setPixelFormat[0])
+	assert.Equal(s.t, PixelFormat32.marshal(), setPixelFormat[4:])
+
+	setEncodings := readBytes(s.t, s.conn, 4)
+	assert.Equal(s.t, byte(RFBSetEncodings), setEncodings[0])
+
+	encodings := readBytes(s.t, s.conn, 4*int(binary.BigEndian.Uint16(setEncodings[2:4])))
+	assert.Equal(s.t, len(DefaultEncodings)*4, len(encodings))
+	assert.Equal(s.t, uint32(EncodingZRLE), binary.BigEndian.Uint32(encodings[0:4]))
+}
+
+func (s *fakeRFBServer) expectUpdateRequest(incremental byte, width, height uint16) {
+	request := readBytes(s.t, s.conn, 10)
+	assert.Equal(s.t, []byte{RFBFramebufferUpdateRequest, incremental, 0, 0, 0, 0}, request[:6])
+	assert.Equal(s.t, width, binary.BigEndian.Uint16(request[6:8]))
+	assert.Equal(s.t, height, binary.BigEndian.Uint16(request[8:10]))
+}
+
+func rectangle(x, y, width, height uint16, encoding int32) []byte {
+	data := make([]byte, rfbRectangleLength)
+	binary.BigEndian.PutUint16(data[0:2], x)
+	binary.BigEndian.PutUint16(data[2:4], y)
+	binary.BigEndian.PutUint16(data[4:6], width)
+	binary.BigEndian.PutUint16(data[6:8], height)
+	binary.BigEndian.PutUint32(data[8:12], uint32(encoding))
+
+	return data
+}
+
+// zrle compresses the tile data on the connection's zlib stream.
+func (s *fakeRFBServer) zrle(tiles []byte) []byte {
+	_, err := s.zlibWriter.Write(tiles)
+	assert.NoError(s.t, err)
+	assert.NoError(s.t, s.zlibWriter.Flush())
+
+	data := binary.BigEndian.AppendUint32(nil, uint32(s.zlibBuffer.Len()))
+	data = append(data, s.zlibBuffer.Bytes()...)
+	s.zlibBuffer.Reset()
+
+	return data
+}
+
+func pixel(c color.RGBA) []byte {
+	return []byte{c.B, c.G, c.R, 0x00}
+}
+
+func cpixel(c color.RGBA) []byte {
+	return []byte{c.B, c.G, c.R}
+}
+
+func (s *fakeRFBServer) framebufferUpdate() []byte {
+	update := []byte{RFBFramebufferUpdate, 0x00, 0x00, 0x05}
+
+	// ZRLE covering four tiles of an 80x70 screen.
+	tiles := append([]byte{0x01}, cpixel(red)...)
+
+	tiles = append(tiles, 0x00)
+	for p := 0; p < 16*64; p++ {
+		tiles = append(tiles, byte(p), 0x80, 0x00)
+	}
+
+	tiles = append(tiles, 0x02)
+	tiles = append(tiles, cpixel(white)...)
+	tiles = append(tiles, cpixel(black)...)
+	tiles = append(tiles, bytes.Repeat([]byte{0xAA}, 8*6)...)
+
+	tiles = append(tiles, 0x80)
+	tiles = append(tiles, cpixel(blue)...)
+	tiles = append(tiles, 95)
+
+	update = append(update, rectangle(0, 0, 80, 70, EncodingZRLE)...)
+	update = append(update, s.zrle(tiles)...)
+
+	// palette RLE on the same zlib stream.
+	tiles = []byte{130}
+	tiles = append(tiles, cpixel(green)...)
+	tiles = append(tiles, cpixel(blue)...)
+	tiles = append(tiles, 0x80, 0x02, 0x01)
+
+	update = append(update, rectangle(10, 10, 4, 1, EncodingZRLE)...)
+	update = append(update, s.zrle(tiles)...)
+
+	update = append(update, rectangle(0, 0, 2, 2, EncodingRaw)...)
+	update = append(update, pixel(red)...)
+	update = append(update, pixel(green)...)
+	update = append(update, pixel(blue)...)
+	update = append(update, pixel(white)...)
+
+	update = append(update, rectangle(20, 20, 10, 10, EncodingRRE)...)
+	update = append(update, 0x00, 0x00, 0x00, 0x01)
+	update = append(update, pixel(white)...)
+	update = append(update, pixel(black)...)
+	update = append(update, 0x00, 0x02, 0x00, 0x02, 0x00, 0x03, 0x00, 0x03)
+
+	update = append(update, rectangle(40, 40, 2, 2, EncodingCopyRect)...)
+
+	return append(update, 0x00, 0x00, 0x00, 0x00)
+}
+
+func kvmHandler(t *testing.T) func(conn net.Conn) {
+	t.Helper()
+
+	return func(conn net.Conn) {
+		server := newFakeRFBServer(t, conn)
+		server.version("RFB 003.008\n")
+
+		writeBytes(t, conn, []byte{0x02, RFBSecurityNone, RFBSecurityVNC})
+		assert.Equal(t, []byte{RFBSecurityNone}, readBytes(t, conn, 1))
+		writeBytes(t, conn, []byte{0x00, 0x00, 0x00, 0x00})
+
+		server.serverInit(80, 70, PixelFormat16, "Intel(r) AMT KVM")
+
+		server.expectUpdateRequest(0, 80, 70)
+		writeBytes(t, conn, server.framebufferUpdate())
+
+		assert.Equal(t, []byte{RFBKeyEvent, 0x01, 0x00, 0x00, 0x00, 0x00, 0xFF, 0xBF}, readBytes(t, conn, 8))
+		assert.Equal(t, []byte{RFBKeyEvent, 0x00, 0x00, 0x00, 0x00, 0x00, 0xFF, 0xBF}, readBytes(t, conn, 8))
+		assert.Equal(t, []byte{RFBKeyEvent, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 'a'}, readBytes(t, conn, 8))
+		assert.Equal(t, []byte{RFBKeyEvent, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 'a'}, readBytes(t, conn, 8))
+		assert.Equal(t, []byte{RFBKeyEvent, 0x01, 0x00, 0x00, 0x00, 0x00, 0xFF, 0x0D}, readBytes(t, conn, 8))
+		assert.Equal(t, []byte{RFBKeyEvent, 0x00, 0x00, 0x00, 0x00, 0x00, 0xFF, 0x0D}, readBytes(t, conn, 8))
+		assert.Equal(t, []byte{RFBPointerEvent, ButtonLeft, 0x00, 0x0A, 0x00, 0x14}, readBytes(t, conn, 6))
+
+		server.expectUpdateRequest(1, 80, 70)
+		writeBytes(t, conn, []byte{RFBBell})
+		writeBytes(t, conn, []byte{RFBServerCutText, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 'x'})
+
+		update := []byte{RFBFramebufferUpdate, 0x00, 0x00, 0x01}
+		writeBytes(t, conn, append(update, rectangle(0, 0, 100, 50, EncodingDesktopSize)...))
+
+		assert.Equal(t, []byte{EndRedirectionSession, 0x00, 0x00, 0x00}, readBytes(t, conn, 4))
+	}
+}
+
+func TestKVMScreenshot(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, ProtocolKVM, kvmHandler(t))
+	server.serve()
+
+	kvm := NewKVM(server.transport(), "admin", "P@ssw0rd")
+
+	assert.NoError(t, kvm.Connect())
+	assert.Equal(t, "Intel(r) AMT KVM", kvm.Name())
+	assert.Equal(t, PixelFormat16, kvm.ServerPixelFormat())
+
+	screenshot, err := kvm.Screenshot()
+	assert.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 80, 70), screenshot.Bounds())
+
+	expected := map[image.Point]color.RGBA{
+		// raw
+		{0, 0}: red, {1, 0}: green, {0, 1}: blue, {1, 1}: white,
+		// copy rect of the raw pixels
+		{40, 40}: red, {41, 41}: white,
+		// ZRLE solid, raw, packed palette and plain RLE tiles
+		{30, 30}: red,
+		{65, 0}:  {R: 0x00, G: 0x80, B: 0x01, A: 0xFF},
+		{64, 1}:  {R: 0x00, G: 0x80, B: 0x10, A: 0xFF},
+		{0, 64}:  black, {1, 64}: white, {63, 69}: white,
+		{70, 68}: blue, {79, 69}: blue,
+		// ZRLE palette RLE
+		{10, 10}: green, {12, 10}: green, {13, 10}: blue,
+		// RRE
+		{21, 21}: white, {22, 22}: black, {24, 24}: black, {25, 25}: white,
+	}
+	for point, c := range expected {
+		assert.Equal(t, c, screenshot.RGBAAt(point.X, point.Y), "pixel %v", point)
+	}
+
+	assert.NoError(t, kvm.PressKey(KeyF2))
+	assert.NoError(t, kvm.TypeText("a\n"))
+	assert.NoError(t, kvm.PointerEvent(ButtonLeft, 10, 20))
+
+	assert.NoError(t, kvm.Update(true))
+	assert.Equal(t, image.Rect(0, 0, 100, 50), kvm.Framebuffer().Bounds())
+
+	assert.NoError(t, kvm.Close())
+	<-server.done
+}
+
+func expectedVNCResponse(password string, challenge []byte) []byte {
+	key := make([]byte, 8)
+	copy(key, password)
+
+	for i, b := range key {
+		var reversed byte
+
+		for bit := 0; bit < 8; bit++ {
+			if b&(1<<bit) != 0 {
+				reversed |= 0x80 >> bit
+			}
+		}
+
+		key[i] = reversed
+	}
+
+	cipher, _ := des.NewCipher(key)
+	response := make([]byte, 16)
+	cipher.Encrypt(response[0:8], challenge[0:8])
+	cipher.Encrypt(response[8:16], challenge[8:16])
+
+	return response
+}
+
+func TestRFBKVMWriteScreenshot(t *testing.T) {
+	t.Parallel()
+
+	challenge := []byte("0123456789abcdef")
+
+	server := newFakeServer(t, ProtocolKVM, func(conn net.Conn) {
+		rfb := newFakeRFBServer(t, conn)
+		rfb.version("RFB 003.003\n")
+
+		writeBytes(t, conn, []byte{0x00, 0x00, 0x00, RFBSecurityVNC})
+		writeBytes(t, conn, challenge)
+		assert.Equal(t, expectedVNCResponse("P@ssw0rd", challenge), readBytes(t, conn, rfbVNCChallengeSize))
+		writeBytes(t, conn, []byte{0x00, 0x00, 0x00, 0x00})
+
+		rfb.serverInit(2, 1, PixelFormat32, "")
+
+		rfb.expectUpdateRequest(0, 2, 1)
+
+		update := []byte{RFBFramebufferUpdate, 0x00, 0x00, 0x01}
+		update = append(update, rectangle(0, 0, 2, 1, EncodingRaw)...)
+		update = append(update, pixel(green)...)
+		writeBytes(t, conn, append(update, pixel(blue)...))
+	})
+	server.skipHandshake = true
+	server.serve()
+
+	kvm := NewRFBKVM(server.transport(), "P@ssw0rd")
+
+	assert.NoError(t, kvm.Connect())
+
+	var buf bytes.Buffer
+
+	assert.NoError(t, kvm.WriteScreenshot(&buf))
+
+	decoded, err := png.Decode(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 2, 1), decoded.Bounds())
+	assert.Equal(t, green, color.RGBAModel.Convert(decoded.At(0, 0)))
+	assert.Equal(t, blue, color.RGBAModel.Convert(decoded.At(1, 0)))
+
+	assert.NoError(t, kvm.Close())
+	<-server.done
+}
+
+func TestRFBKVMAuthFailed(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, ProtocolKVM, func(conn net.Conn) {
+		rfb := newFakeRFBServer(t, conn)
+		rfb.version("RFB 003.008\n")
+
+		writeBytes(t, conn, []byte{0x01, RFBSecurityVNC})
+		assert.Equal(t, []byte{RFBSecurityVNC}, readBytes(t, conn, 1))
+		writeBytes(t, conn, make([]byte, rfbVNCChallengeSize))
+		readBytes(t, conn, rfbVNCChallengeSize)
+
+		writeBytes(t, conn, []byte{0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x0C})
+		writeBytes(t, conn, []byte("bad password"))
+	})
+	server.skipHandshake = true
+	server.serve()
+
+	kvm := NewRFBKVM(server.transport(), "wrong")
+
+	err := kvm.Connect()
+	assert.ErrorIs(t, err, ErrRFBAuthFailed)
+	assert.ErrorContains(t, err, "bad password")
+	assert.NoError(t, kvm.Close())
+}
+
+func TestKVMUnsupportedVersion(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, ProtocolKVM, func(conn net.Conn) {
+		writeBytes(t, conn, []byte("RFB 004.001\n"))
+	})
+	server.serve()
+
+	kvm := NewKVM(server.transport(), "admin", "P@ssw0rd")
+
+	assert.ErrorIs(t, kvm.Connect(), ErrRFBVersion)
+	assert.NoError(t, kvm.Session().transport.CloseConnection())
+}
+
+func TestKVMSetEncodingsRejectsUnsupported(t *testing.T) {
+	t.Parallel()
+
+	kvm := NewKVM(&tcpTransport{}, "admin", "P@ssw0rd")
+
+	assert.ErrorIs(t, kvm.SetEncodings(EncodingZRLE, 5, EncodingRaw), ErrUnsupportedEncoding)
+	assert.EqualError(t, kvm.SetEncodings(-239), "unsupported RFB encoding: -239")
+	assert.Equal(t, DefaultEncodings, kvm.Encodings)
+}
+
+func TestKVMFramebufferBeforeConnect(t *testing.T) {
+	t.Parallel()
+
+	kvm := NewKVM(&tcpTransport{}, "admin", "P@ssw0rd")
+
+	assert.NotPanics(t, func() {
+		assert.Nil(t, kvm.Framebuffer())
+		assert.Equal(t, image.Rectangle{}, kvm.bounds())
+	})
+}
+
+func TestKVMDataChannel(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, ProtocolKVM, func(conn net.Conn) {
+		setEncodings := readBytes(t, conn, 8)
+		assert.Equal(t, []byte{RFBSetEncodings, 0x00, 0x00, 0x01, 0x00, 0x00, 0x04, 0x44}, setEncodings)
+
+		text := []byte(kvmDataChannelPrefix + "ping")
+		assert.Equal(t, append([]byte{RFBClientCutText, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, byte(len(text))}, text...), readBytes(t, conn, 8+len(text)))
+
+		messages := []byte{RFBServerCutText, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 'x'}
+		text = []byte(kvmDataChannelPrefix + "pong")
+		messages = append(messages, RFBServerCutText, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, byte(len(text)))
+		messages = append(messages, text...)
+		messages = append(messages, RFBFramebufferUpdate, 0x00, 0x00, 0x00)
+		writeBytes(t, conn, messages)
+	})
+	server.skipHandshake = true
+	server.serve()
+
+	kvm := NewRFBKVM(server.transport(), "")
+	assert.NoError(t, kvm.session.transport.Connect())
+
+	var received [][]byte
+
+	kvm.KVMData = func(data []byte) {
+		received = append(received, data)
+	}
+
+	assert.NoError(t, kvm.SetEncodings(EncodingKVMDataChannel))
+	assert.NoError(t, kvm.SendKVMData([]byte("ping")))
+	assert.NoError(t, kvm.Update(true))
+	assert.Equal(t, [][]byte{[]byte("pong")}, received)
+
+	assert.NoError(t, kvm.Close())
+	<-server.done
+}
@@ -0,0 +1,337 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package redirection implements the Intel AMT redirection protocol (SOL, IDE-R and KVM) spoken on ports 16994/16995.
+package redirection
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// Transport is the connection to the AMT redirection port. It is satisfied by the *client.Target returned by client.NewWsmanTCP.
+type Transport interface {
+	Connect() error
+	Send(data []byte) error
+	Receive() ([]byte, error)
+	CloseConnection() error
+}
+
+var _ Transport = (*client.Target)(nil)
+
+// Session is an authenticated redirection session. It is shared by the SOL, IDE-R and KVM clients.
+type Session struct {
+	transport Transport
+	username  string
+	password  string
+	writeLock sync.Mutex
+	buffer    []byte
+	sequence  uint32
+	// AuthURI is the uri used in the digest authentication, defaults to /RedirectionService.
+	AuthURI string
+	// OEMData holds the OEM defined data returned in the StartRedirectionSessionReply.
+	OEMData []byte
+}
+
+// NewSession creates a redirection session that authenticates with the given AMT credentials.
+func NewSession(transport Transport, username, password string) *Session {
+	return &Session{
+		transport: transport,
+		username:  username,
+		password:  password,
+		AuthURI:   DefaultAuthURI,
+	}
+}
+
+// Start connects the transport, starts a redirection session for the protocol and authenticates it.
+func (s *Session) Start(protocol Protocol) error {
+	if err := s.transport.Connect(); err != nil {
+		return err
+	}
+
+	if err := s.startRedirectionSession(protocol); err != nil {
+		return err
+	}
+
+	return s.authenticate()
+}
+
+// End sends EndRedirectionSession and closes the transport.
+func (s *Session) End() error {
+	err := s.Send([]byte{EndRedirectionSession, 0x00, 0x00, 0x00})
+	closeErr := s.transport.CloseConnection()
+
+	if err != nil {
+		return err
+	}
+
+	return closeErr
+}
+
+// Send writes a complete redirection message to the transport.
+func (s *Session) Send(data []byte) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	return s.transport.Send(data)
+}
+
+// NextSequence returns the sequence number used by the next SOL or keep-alive message.
+func (s *Session) NextSequence() uint32 {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	sequence := s.sequence
+	s.sequence++
+
+	return sequence
+}
+
+// Peek returns the first n received bytes without consuming them, reading from the transport as needed.
+func (s *Session) Peek(n int) ([]byte, error) {
+	for len(s.buffer) < n {
+		data, err := s.transport.Receive()
+		if err != nil {
+			return nil, err
+		}
+
+		s.buffer = append(s.buffer, data...)
+	}
+
+	return s.buffer[:n], nil
+}
+
+// ReadFull consumes and returns the next n received bytes.
+func (s *Session) ReadFull(n int) ([]byte, error) {
+	data, err := s.Peek(n)
+	if err != nil {
+		return nil, err
+	}
+
+	result := append([]byte(nil), data...)
+	s.buffer = s.buffer[n:]
+
+	return result, nil
+}
+
+func (s *Session) startRedirectionSession(protocol Protocol) error {
+	message := []byte{StartRedirectionSession, 0x00, 0x00, 0x00}
+	if protocol == ProtocolKVM {
+		message[1] = 0x01
+	}
+
+	message = append(message, protocol[:]...)
+	if err := s.Send(message); err != nil {
+		return err
+	}
+
+	header, err := s.Peek(startRedirectionSessionReplyLength)
+	if err != nil {
+		return err
+	}
+
+	if header[0] != StartRedirectionSessionReply {
+		return fmt.Errorf("%w: expected StartRedirectionSessionReply, got 0x%02x", ErrUnexpectedMessage, header[0])
+	}
+
+	if header[1] != StatusSuccess {
+		return &StatusError{Command: StartRedirectionSessionReply, Status: header[1]}
+	}
+
+	oemLength := int(header[12])
+
+	reply, err := s.ReadFull(startRedirectionSessionReplyLength + oemLength)
+	if err != nil {
+		return err
+	}
+
+	s.OEMData = reply[startRedirectionSessionReplyLength:]
+
+	return nil
+}
+
+func (s *Session) authenticate() error {
+	if err := s.sendAuthenticate(AuthQuery, nil); err != nil {
+		return err
+	}
+
+	status, authType, data, err := s.readAuthenticateReply()
+	if err != nil {
+		return err
+	}
+
+	if status != StatusSuccess || authType != AuthQuery {
+		return &StatusError{Command: AuthenticateSessionReply, Status: status}
+	}
+
+	switch {
+	case containsByte(data, AuthDigest):
+		return s.authenticateDigest()
+	case containsByte(data, AuthUsernamePassword):
+		return s.authenticateUsernamePassword()
+	}
+
+	return ErrAuthNotSupported
+}
+
+func (s *Session) authenticateUsernamePassword() error {
+	data := lengthPrefixed(s.username)
+	data = append(data, lengthPrefixed(s.password)...)
+
+	if err := s.sendAuthenticate(AuthUsernamePassword, data); err != nil {
+		return err
+	}
+
+	return s.authenticateResult()
+}
+
+func (s *Session) authenticateDigest() error {
+	// initial request: username, realm, nonce, uri, cnonce, nonce count, response and qop with only username and uri set.
+	data := lengthPrefixed(s.username)
+	data = append(data, 0x00, 0x00)
+	data = append(data, lengthPrefixed(s.AuthURI)...)
+	data = append(data, 0x00, 0x00, 0x00, 0x00)
+
+	if err := s.sendAuthenticate(AuthDigest, data); err != nil {
+		return err
+	}
+
+	status, authType, challengeData, err := s.readAuthenticateReply()
+	if err != nil {
+		return err
+	}
+
+	if status != StatusFailure || authType != AuthDigest {
+		return &StatusError{Command: AuthenticateSessionReply, Status: status}
+	}
+
+	fields, err := splitLengthPrefixed(challengeData, 3)
+	if err != nil {
+		return err
+	}
+
+	cnonce, err := randomNonce()
+	if err != nil {
+		return err
+	}
+
+	challenge := &client.AuthChallenge{
+		Username:   s.username,
+		Password:   s.password,
+		Realm:      fields[0],
+		Nonce:      fields[1],
+		Qop:        fields[2],
+		CNonce:     cnonce,
+		NonceCount: 1,
+	}
+	response := challenge.ComputeDigestHash("POST", s.AuthURI, challenge.GetFormattedNonceData(challenge.Nonce))
+
+	data = lengthPrefixed(s.username)
+	data = append(data, lengthPrefixed(challenge.Realm)...)
+	data = append(data, lengthPrefixed(challenge.Nonce)...)
+	data = append(data, lengthPrefixed(s.AuthURI)...)
+	data = append(data, lengthPrefixed(cnonce)...)
+	data = append(data, lengthPrefixed(fmt.Sprintf("%08x", challenge.NonceCount))...)
+	data = append(data, lengthPrefixed(response)...)
+	data = append(data, lengthPrefixed(challenge.Qop)...)
+
+	if err := s.sendAuthenticate(AuthDigest, data); err != nil {
+		return err
+	}
+
+	return s.authenticateResult()
+}
+
+func (s *Session) authenticateResult() error {
+	status, _, _, err := s.readAuthenticateReply()
+	if err != nil {
+		return err
+	}
+
+	if status != StatusSuccess {
+		return ErrAuthFailed
+	}
+
+	return nil
+}
+
+func (s *Session) sendAuthenticate(authType byte, data []byte) error {
+	message := make([]byte, authenticateSessionHeaderLength, authenticateSessionHeaderLength+len(data))
+	message[0] = AuthenticateSession
+	message[4] = authType
+	binary.LittleEndian.PutUint32(message[5:9], uint32(len(data)))
+
+	return s.Send(append(message, data...))
+}
+
+func (s *Session) readAuthenticateReply() (status, authType byte, data []byte, err error) {
+	header, err := s.Peek(authenticateSessionHeaderLength)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	if header[0] != AuthenticateSessionReply {
+		return 0, 0, nil, fmt.Errorf("%w: expected AuthenticateSessionReply, got 0x%02x", ErrUnexpectedMessage, header[0])
+	}
+
+	length := int(binary.LittleEndian.Uint32(header[5:9]))
+
+	reply, err := s.ReadFull(authenticateSessionHeaderLength + length)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	return reply[1], reply[4], reply[authenticateSessionHeaderLength:], nil
+}
+
+func lengthPrefixed(value string) []byte {
+	return append([]byte{byte(len(value))}, value...)
+}
+
+func splitLengthPrefixed(data []byte, count int) ([]string, error) {
+	fields := make([]string, 0, count)
+
+	for i := 0; i < count; i++ {
+		if len(data) == 0 {
+			return nil, ErrMalformedMessage
+		}
+
+		length := int(data[0])
+		if len(data) < length+1 {
+			return nil, ErrMalformedMessage
+		}
+
+		fields = append(fields, string(data[1:length+1]))
+		data = data[length+1:]
+	}
+
+	return fields, nil
+}
+
+func containsByte(data []byte, value byte) bool {
+	for _, b := range data {
+		if b == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func randomNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		errRandRead := errors.New("failed to generate random bytes")
+
+		return "", fmt.Errorf("%w: %w", errRandRead, err)
+	}
+
+	return fmt.Sprintf("%x", b), nil
+}
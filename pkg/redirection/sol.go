@@ -0,0 +1,296 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+package redirection
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultKeepAliveInterval is how often a SOL keep-alive is sent to AMT.
+const DefaultKeepAliveInterval = 2 * time.Second
+
+// SOL is a Serial-over-LAN session. Once connected it is an io.ReadWriteCloser carrying the terminal data.
+type SOL struct {
+	session *Session
+	lock    sync.Mutex
+	cond    *sync.Cond
+	buffer  bytes.Buffer
+	err     error
+	done    chan struct{}
+	control uint16
+	// Settings are sent to AMT in StartSOLRedirection.
+	Settings SOLSettings
+	// AcceptedSettings are the settings returned by AMT in StartSOLRedirectionReply.
+	AcceptedSettings SOLSettings
+	// KeepAliveInterval is the period between keep-alive messages, zero disables them.
+	KeepAliveInterval time.Duration
+}
+
+// NewSOL creates a Serial-over-LAN client on top of the redirection transport.
+func NewSOL(transport Transport, username, password string) *SOL {
+	sol := &SOL{
+		session:           NewSession(transport, username, password),
+		Settings:          DefaultSOLSettings,
+		KeepAliveInterval: DefaultKeepAliveInterval,
+	}
+	sol.cond = sync.NewCond(&sol.lock)
+
+	return sol
+}
+
+// Session returns the underlying redirection session.
+func (s *SOL) Session() *Session {
+	return s.session
+}
+
+// Connect starts and authenticates the redirection session, exchanges the SOL settings and starts
+// receiving terminal data and sending keep-alives.
+func (s *SOL) Connect() error {
+	if err := s.session.Start(ProtocolSOL); err != nil {
+		return err
+	}
+
+	if err := s.session.Send(s.startSOLRedirection()); err != nil {
+		return err
+	}
+
+	reply, err := s.session.ReadFull(startSOLRedirectionReplyLength)
+	if err != nil {
+		return err
+	}
+
+	if reply[0] != StartSOLRedirectionReply {
+		return fmt.Errorf("%w: expected StartSOLRedirectionReply, got 0x%02x", ErrUnexpectedMessage, reply[0])
+	}
+
+	if reply[1] != StatusSuccess {
+		return &StatusError{Command: StartSOLRedirectionReply, Status: reply[1]}
+	}
+
+	s.AcceptedSettings = decodeSOLSettings(reply[8:20])
+
+	control := make([]byte, 8, 14)
+	control[0] = SOLControl
+	binary.LittleEndian.PutUint32(control[4:8], s.session.NextSequence())
+	control = append(control, 0x00, 0x00, 0x1B, 0x00, 0x00, 0x00)
+
+	if err := s.session.Send(control); err != nil {
+		return err
+	}
+
+	s.done = make(chan struct{})
+
+	go s.receive()
+
+	if s.KeepAliveInterval > 0 {
+		go s.keepAlive()
+	}
+
+	return nil
+}
+
+// ControlStatus returns the last serial control status reported by AMT.
+func (s *SOL) ControlStatus() uint16 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.control
+}
+
+// Read reads terminal data sent by the host's serial port.
+func (s *SOL) Read(p []byte) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for s.buffer.Len() == 0 && s.err == nil {
+		s.cond.Wait()
+	}
+
+	if s.buffer.Len() == 0 {
+		return 0, s.err
+	}
+
+	return s.buffer.Read(p)
+}
+
+// Write sends terminal input to the host's serial port, in messages no larger than the transmit buffer accepted by AMT.
+func (s *SOL) Write(p []byte) (int, error) {
+	s.lock.Lock()
+	err := s.err
+	s.lock.Unlock()
+
+	if err != nil {
+		return 0, err
+	}
+
+	maxTxBuffer := s.maxTxBuffer()
+	written := 0
+
+	for written < len(p) {
+		length := len(p) - written
+		if length > maxTxBuffer && maxTxBuffer > 0 {
+			length = maxTxBuffer
+		}
+
+		message := make([]byte, solDataHeaderLength, solDataHeaderLength+length)
+		message[0] = SOLDataToHost
+		binary.LittleEndian.PutUint32(message[4:8], s.session.NextSequence())
+		binary.LittleEndian.PutUint16(message[8:10], uint16(length))
+
+		if err := s.session.Send(append(message, p[written:written+length]...)); err != nil {
+			return written, err
+		}
+
+		written += length
+	}
+
+	return written, nil
+}
+
+// maxTxBuffer returns the transmit buffer size accepted by AMT, or the requested one before the session is connected.
+func (s *SOL) maxTxBuffer() int {
+	if s.AcceptedSettings.MaxTxBuffer > 0 {
+		return int(s.AcceptedSettings.MaxTxBuffer)
+	}
+
+	return int(s.Settings.MaxTxBuffer)
+}
+
+// Close ends the redirection session.
+func (s *SOL) Close() error {
+	s.lock.Lock()
+	if errors.Is(s.err, ErrSessionClosed) {
+		s.lock.Unlock()
+
+		return nil
+	}
+
+	s.err = ErrSessionClosed
+	s.cond.Broadcast()
+	s.lock.Unlock()
+
+	if s.done != nil {
+		close(s.done)
+	}
+
+	return s.session.End()
+}
+
+func (s *SOL) startSOLRedirection() []byte {
+	message := make([]byte, 24)
+	message[0] = StartSOLRedirection
+	binary.LittleEndian.PutUint32(message[4:8], s.session.NextSequence())
+	binary.LittleEndian.PutUint16(message[8:10], s.Settings.MaxTxBuffer)
+	binary.LittleEndian.PutUint16(message[10:12], s.Settings.TxTimeout)
+	binary.LittleEndian.PutUint16(message[12:14], s.Settings.TxOverflowTimeout)
+	binary.LittleEndian.PutUint16(message[14:16], s.Settings.RxTimeout)
+	binary.LittleEndian.PutUint16(message[16:18], s.Settings.RxFlushTimeout)
+	binary.LittleEndian.PutUint16(message[18:20], s.Settings.Heartbeat)
+
+	return message
+}
+
+func decodeSOLSettings(data []byte) SOLSettings {
+	return SOLSettings{
+		MaxTxBuffer:       binary.LittleEndian.Uint16(data[0:2]),
+		TxTimeout:         binary.LittleEndian.Uint16(data[2:4]),
+		TxOverflowTimeout: binary.LittleEndian.Uint16(data[4:6]),
+		RxTimeout:         binary.LittleEndian.Uint16(data[6:8]),
+		RxFlushTimeout:    binary.LittleEndian.Uint16(data[8:10]),
+		Heartbeat:         binary.LittleEndian.Uint16(data[10:12]),
+	}
+}
+
+func (s *SOL) receive() {
+	for {
+		err := s.receiveMessage()
+		if err != nil {
+			s.fail(err)
+
+			return
+		}
+	}
+}
+
+func (s *SOL) receiveMessage() error {
+	header, err := s.session.Peek(1)
+	if err != nil {
+		return err
+	}
+
+	switch header[0] {
+	case SOLDataFromHost:
+		header, err = s.session.Peek(solDataHeaderLength)
+		if err != nil {
+			return err
+		}
+
+		length := int(binary.LittleEndian.Uint16(header[8:10]))
+
+		message, err := s.session.ReadFull(solDataHeaderLength + length)
+		if err != nil {
+			return err
+		}
+
+		s.lock.Lock()
+		s.buffer.Write(message[solDataHeaderLength:])
+		s.cond.Broadcast()
+		s.lock.Unlock()
+	case SOLControlFromHost:
+		message, err := s.session.ReadFull(solControlFromHostLength)
+		if err != nil {
+			return err
+		}
+
+		s.lock.Lock()
+		s.control = binary.LittleEndian.Uint16(message[8:10])
+		s.lock.Unlock()
+	case SOLKeepAlive:
+		_, err = s.session.ReadFull(solKeepAliveLength)
+
+		return err
+	default:
+		return fmt.Errorf("%w: 0x%02x", ErrUnexpectedMessage, header[0])
+	}
+
+	return nil
+}
+
+func (s *SOL) keepAlive() {
+	ticker := time.NewTicker(s.KeepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			message := make([]byte, solKeepAliveLength)
+			message[0] = SOLKeepAlive
+			binary.LittleEndian.PutUint32(message[4:8], s.session.NextSequence())
+
+			if err := s.session.Send(message); err != nil {
+				s.fail(err)
+
+				return
+			}
+		}
+	}
+}
+
+func (s *SOL) fail(err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.err == nil {
+		s.err = err
+		s.cond.Broadcast()
+	}
+}
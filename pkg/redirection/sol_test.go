@@ -0,0 +1,235 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+package redirection
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// solHandler plays the AMT side of a SOL session after authentication.
+func solHandler(t *testing.T, received chan []byte) func(conn net.Conn) {
+	t.Helper()
+
+	return solHandlerWithMaxTxBuffer(t, received, 4096)
+}
+
+// solHandlerWithMaxTxBuffer plays the AMT side of a SOL session that accepts a transmit buffer of maxTxBuffer bytes.
+func solHandlerWithMaxTxBuffer(t *testing.T, received chan []byte, maxTxBuffer uint16) func(conn net.Conn) {
+	t.Helper()
+
+	return func(conn net.Conn) {
+		start := readBytes(t, conn, 24)
+		assert.Equal(t, byte(StartSOLRedirection), start[0])
+
+		reply := make([]byte, startSOLRedirectionReplyLength)
+		reply[0] = StartSOLRedirectionReply
+		copy(reply[8:20], start[8:20])
+		binary.LittleEndian.PutUint16(reply[8:10], maxTxBuffer)
+		writeBytes(t, conn, reply)
+
+		control := readBytes(t, conn, 14)
+		assert.Equal(t, byte(SOLControl), control[0])
+
+		writeBytes(t, conn, []byte{SOLControlFromHost, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x00})
+		writeBytes(t, conn, []byte{SOLKeepAlive, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+		writeBytes(t, conn, solData("login: "))
+
+		for {
+			header := make([]byte, 8)
+			if _, err := io.ReadFull(conn, header); err != nil {
+				return
+			}
+
+			switch header[0] {
+			case SOLDataToHost:
+				length := readBytes(t, conn, 2)
+				received <- append(header, append(length, readBytes(t, conn, int(binary.LittleEndian.Uint16(length)))...)...)
+			case SOLKeepAlive:
+				received <- header
+			case EndRedirectionSession:
+				return
+			}
+		}
+	}
+}
+
+func solData(data string) []byte {
+	message := make([]byte, solDataHeaderLength)
+	message[0] = SOLDataFromHost
+	binary.LittleEndian.PutUint16(message[8:10], uint16(len(data)))
+
+	return append(message, data...)
+}
+
+func TestSOLDigestSession(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan []byte, 10)
+	server := newFakeServer(t, ProtocolSOL, solHandler(t, received))
+	server.serve()
+
+	sol := NewSOL(server.transport(), "admin", "P@ssw0rd")
+	sol.KeepAliveInterval = 0
+
+	assert.NoError(t, sol.Connect())
+	assert.Equal(t, []byte{0xAA, 0xBB}, sol.Session().OEMData)
+	assert.Equal(t, uint16(4096), sol.AcceptedSettings.MaxTxBuffer)
+	assert.Equal(t, DefaultSOLSettings.RxTimeout, sol.AcceptedSettings.RxTimeout)
+
+	buf := make([]byte, 7)
+
+	_, err := io.ReadFull(sol, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "login: ", string(buf))
+	assert.Equal(t, uint16(3), sol.ControlStatus())
+
+	n, err := sol.Write([]byte("root\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, 5, n)
+
+	message := <-received
+	assert.Equal(t, byte(SOLDataToHost), message[0])
+	assert.Equal(t, "root\n", string(message[solDataHeaderLength:]))
+
+	assert.NoError(t, sol.Close())
+	<-server.done
+	assert.Equal(t, byte(AuthDigest), server.authenticatedVia)
+
+	_, err = sol.Read(buf)
+	assert.ErrorIs(t, err, ErrSessionClosed)
+	assert.NoError(t, sol.Close())
+}
+
+func TestSOLWriteUsesAcceptedMaxTxBuffer(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan []byte, 10)
+	server := newFakeServer(t, ProtocolSOL, solHandlerWithMaxTxBuffer(t, received, 4))
+	server.serve()
+
+	sol := NewSOL(server.transport(), "admin", "P@ssw0rd")
+	sol.KeepAliveInterval = 0
+
+	assert.NoError(t, sol.Connect())
+	assert.Equal(t, uint16(10000), sol.Settings.MaxTxBuffer)
+
+	n, err := sol.Write([]byte("root\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, 5, n)
+	assert.Equal(t, "root", string((<-received)[solDataHeaderLength:]))
+	assert.Equal(t, "\n", string((<-received)[solDataHeaderLength:]))
+
+	assert.NoError(t, sol.Close())
+	<-server.done
+}
+
+func TestSOLUsernamePasswordSession(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan []byte, 10)
+	server := newFakeServer(t, ProtocolSOL, solHandler(t, received))
+	server.authTypes = []byte{AuthUsernamePassword}
+	server.serve()
+
+	sol := NewSOL(server.transport(), "admin", "P@ssw0rd")
+	sol.KeepAliveInterval = 0
+
+	assert.NoError(t, sol.Connect())
+
+	_, err := io.ReadFull(sol, make([]byte, 7))
+	assert.NoError(t, err)
+	assert.NoError(t, sol.Close())
+	<-server.done
+	assert.Equal(t, byte(AuthUsernamePassword), server.authenticatedVia)
+}
+
+func TestSOLAuthenticationFailed(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, ProtocolSOL, nil)
+	server.serve()
+
+	sol := NewSOL(server.transport(), "admin", "wrong")
+
+	assert.ErrorIs(t, sol.Connect(), ErrAuthFailed)
+}
+
+func TestSOLNoSupportedAuthentication(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, ProtocolSOL, nil)
+	server.authTypes = []byte{0x03}
+	server.serve()
+
+	sol := NewSOL(server.transport(), "admin", "P@ssw0rd")
+
+	assert.ErrorIs(t, sol.Connect(), ErrAuthNotSupported)
+	assert.NoError(t, sol.Session().End())
+}
+
+func TestSOLStartRedirectionSessionRejected(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, ProtocolSOL, nil)
+	server.startStatus = StatusFailure
+	server.serve()
+
+	sol := NewSOL(server.transport(), "admin", "P@ssw0rd")
+
+	var statusErr *StatusError
+
+	assert.ErrorAs(t, sol.Connect(), &statusErr)
+	assert.Equal(t, byte(StartRedirectionSessionReply), statusErr.Command)
+}
+
+func TestSOLKeepAlive(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan []byte, 10)
+	server := newFakeServer(t, ProtocolSOL, solHandler(t, received))
+	server.serve()
+
+	sol := NewSOL(server.transport(), "admin", "P@ssw0rd")
+	sol.KeepAliveInterval = 10 * time.Millisecond
+
+	assert.NoError(t, sol.Connect())
+
+	select {
+	case message := <-received:
+		assert.Equal(t, byte(SOLKeepAlive), message[0])
+	case <-time.After(2 * time.Second):
+		t.Fatal("no keep-alive received")
+	}
+
+	assert.NoError(t, sol.Close())
+}
+
+func TestSOLRemoteClose(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, ProtocolSOL, func(conn net.Conn) {
+		readBytes(t, conn, 24)
+
+		reply := make([]byte, startSOLRedirectionReplyLength)
+		reply[0] = StartSOLRedirectionReply
+		writeBytes(t, conn, reply)
+		readBytes(t, conn, 14)
+	})
+	server.serve()
+
+	sol := NewSOL(server.transport(), "admin", "P@ssw0rd")
+	sol.KeepAliveInterval = 0
+
+	assert.NoError(t, sol.Connect())
+
+	_, err := sol.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, io.EOF)
+}
@@ -0,0 +1,349 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+package redirection
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Protocol identifies the redirection protocol requested in StartRedirectionSession.
+type Protocol [4]byte
+
+var (
+	ProtocolSOL  = Protocol{'S', 'O', 'L', ' '}
+	ProtocolIDER = Protocol{'I', 'D', 'E', 'R'}
+	ProtocolKVM  = Protocol{'K', 'V', 'M', 'R'}
+)
+
+// redirection session commands.
+const (
+	StartRedirectionSession      = 0x10
+	StartRedirectionSessionReply = 0x11
+	EndRedirectionSession        = 0x12
+	AuthenticateSession          = 0x13
+	AuthenticateSessionReply     = 0x14
+)
+
+// SOL commands.
+const (
+	StartSOLRedirection      = 0x20
+	StartSOLRedirectionReply = 0x21
+	SOLControl               = 0x27
+	SOLDataToHost            = 0x28
+	SOLControlFromHost       = 0x29
+	SOLDataFromHost          = 0x2A
+	SOLKeepAlive             = 0x2B
+)
+
+// status codes.
+const (
+	StatusSuccess = 0x00
+	StatusFailure = 0x01
+)
+
+// authentication types.
+const (
+	AuthQuery            = 0x00
+	AuthUsernamePassword = 0x01
+	AuthDigest           = 0x04
+)
+
+const (
+	DefaultAuthURI = "/RedirectionService"
+
+	startRedirectionSessionReplyLength = 13
+	authenticateSessionHeaderLength    = 9
+	startSOLRedirectionReplyLength     = 23
+	solControlFromHostLength           = 10
+	solDataHeaderLength                = 10
+	solKeepAliveLength                 = 8
+)
+
+var (
+	ErrUnexpectedMessage = errors.New("unexpected redirection message")
+	ErrMalformedMessage  = errors.New("malformed redirection message")
+	ErrAuthNotSupported  = errors.New("no supported redirection authentication method")
+	ErrAuthFailed        = errors.New("redirection authentication failed")
+	ErrSessionClosed     = errors.New("redirection session is closed")
+)
+
+// StatusError is returned when AMT replies to a redirection command with a non-success status.
+type StatusError struct {
+	Command byte
+	Status  byte
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("redirection command 0x%02x failed with status %d", e.Command, e.Status)
+}
+
+// SOLSettings are sent to AMT in StartSOLRedirection. Timeouts are in milliseconds.
+type SOLSettings struct {
+	MaxTxBuffer       uint16
+	TxTimeout         uint16
+	TxOverflowTimeout uint16
+	RxTimeout         uint16
+	RxFlushTimeout    uint16
+	Heartbeat         uint16
+}
+
+// DefaultSOLSettings are the settings used by NewSOL.
+var DefaultSOLSettings = SOLSettings{
+	MaxTxBuffer:       10000,
+	TxTimeout:         100,
+	TxOverflowTimeout: 0,
+	RxTimeout:         10000,
+	RxFlushTimeout:    100,
+	Heartbeat:         0,
+}
+
+// IDE-R commands.
+const (
+	IDEROpenSession           = 0x40
+	IDEROpenSessionReply      = 0x41
+	IDERClose                 = 0x42
+	IDERKeepAlivePing         = 0x43
+	IDERKeepAlivePong         = 0x44
+	IDERResetOccurred         = 0x45
+	IDERStatusData            = 0x46
+	IDERResetOccurredResponse = 0x47
+	IDERDisableEnableFeatures = 0x48
+	IDERErrorOccurred         = 0x4A
+	IDERHeartbeat             = 0x4B
+	IDERCommandWritten        = 0x50
+	IDERCommandEndResponse    = 0x51
+	IDERGetDataFromHost       = 0x52
+	IDERDataFromHost          = 0x53
+	IDERDataToHost            = 0x54
+)
+
+// IDE-R DisableEnableFeatures / STATUS_DATA register types.
+const (
+	IDERFeatureRegistersAvailable = 0x01
+	IDERFeatureRegistersStatus    = 0x02
+	IDERFeatureRegistersToggle    = 0x03
+	IDERFeatureEnable             = 0x01
+)
+
+// IDE-R devices.
+const (
+	IDERDeviceFloppy = 0xA0
+	IDERDeviceCDROM  = 0xB0
+)
+
+// block sizes of the redirected devices.
+const (
+	FloppyBlockSize = 512
+	CDROMBlockSize  = 2048
+)
+
+// SCSI/ATAPI operation codes handled by the IDE-R client.
+const (
+	SCSITestUnitReady             = 0x00
+	SCSIRead6                     = 0x08
+	SCSIWrite6                    = 0x0A
+	SCSIModeSense6                = 0x1A
+	SCSIStartStop                 = 0x1B
+	SCSIPreventAllowMediumRemoval = 0x1E
+	SCSIReadFormatCapacities      = 0x23
+	SCSIReadCapacity              = 0x25
+	SCSIRead10                    = 0x28
+	SCSIWrite10                   = 0x2A
+	SCSIReadTOC                   = 0x43
+	SCSIModeSense10               = 0x5A
+	SCSIRead12                    = 0xA8
+	SCSIWrite12                   = 0xAA
+)
+
+// SCSI sense keys.
+const (
+	SenseNotReady       = 0x02
+	SenseIllegalRequest = 0x05
+	SenseDataProtect    = 0x07
+)
+
+const (
+	iderHeaderLength           = 8
+	iderOpenSessionReplyLength = 30
+	iderResetOccurredLength    = 9
+	iderStatusDataLength       = 13
+	iderErrorOccurredLength    = 11
+	iderCommandWrittenLength   = 28
+	iderDataFromHostLength     = 14
+)
+
+var (
+	// ErrIDERToggleFailed is returned when AMT fails to enable or disable the IDE-R devices.
+	ErrIDERToggleFailed = errors.New("failed to toggle IDE-R feature registers")
+	// ErrMediaTooSmall is returned when an IDE-R media holds less than one block of its device.
+	ErrMediaTooSmall = errors.New("IDE-R media is smaller than one block")
+)
+
+// IDERStartMode controls when the host starts using the redirected devices after they are enabled.
+type IDERStartMode uint32
+
+const (
+	IDERStartOnReboot  IDERStartMode = 0x08
+	IDERStartGraceful  IDERStartMode = 0x10
+	IDERStartImmediate IDERStartMode = 0x18
+)
+
+// IDERSettings are sent to AMT in IDE-R OPEN_SESSION. Timeouts are in milliseconds.
+type IDERSettings struct {
+	RxTimeout uint16
+	TxTimeout uint16
+	Heartbeat uint16
+	Version   uint32
+}
+
+// DefaultIDERSettings are the settings used by NewIDER.
+var DefaultIDERSettings = IDERSettings{
+	RxTimeout: 30000,
+	TxTimeout: 0,
+	Heartbeat: 20000,
+	Version:   1,
+}
+
+// IDERSessionInfo holds the values returned by AMT in the IDE-R OPEN_SESSION reply.
+type IDERSessionInfo struct {
+	MajorVersion         uint8
+	MinorVersion         uint8
+	FirmwareMajorVersion uint8
+	FirmwareMinorVersion uint8
+	ReadBufferSize       uint16
+	WriteBufferSize      uint16
+	ProtocolVersion      uint8
+	IANA                 uint32
+}
+
+// IDERStatistics reports the progress of an IDE-R session.
+type IDERStatistics struct {
+	BytesToAMT      uint64
+	BytesFromAMT    uint64
+	CDROMBytesRead  uint64
+	FloppyBytesRead uint64
+	Commands        uint64
+}
+
+// RFB client to server messages.
+const (
+	RFBSetPixelFormat           = 0
+	RFBSetEncodings             = 2
+	RFBFramebufferUpdateRequest = 3
+	RFBKeyEvent                 = 4
+	RFBPointerEvent             = 5
+	RFBClientCutText            = 6
+)
+
+// RFB server to client messages.
+const (
+	RFBFramebufferUpdate   = 0
+	RFBSetColourMapEntries = 1
+	RFBBell                = 2
+	RFBServerCutText       = 3
+)
+
+// RFB security types.
+const (
+	RFBSecurityInvalid = 0
+	RFBSecurityNone    = 1
+	RFBSecurityVNC     = 2
+)
+
+// RFB encodings supported by the KVM client. Other encodings are rejected by SetEncodings.
+const (
+	EncodingRaw         int32 = 0
+	EncodingCopyRect    int32 = 1
+	EncodingRRE         int32 = 2
+	EncodingZRLE        int32 = 16
+	EncodingDesktopSize int32 = -223
+	// EncodingKVMDataChannel is the Intel AMT pseudo-encoding that enables the KVM data channel, see
+	// KVM.SendKVMData. It is not requested by default.
+	EncodingKVMDataChannel int32 = 1092
+)
+
+// kvmDataChannelPrefix starts the cut text messages that carry the Intel AMT KVM data channel.
+const kvmDataChannelPrefix = "\x00KvmDataChannel\x00"
+
+// DefaultEncodings are the encodings requested by NewKVM, in order of preference.
+var DefaultEncodings = []int32{EncodingZRLE, EncodingCopyRect, EncodingRRE, EncodingRaw, EncodingDesktopSize}
+
+// X11 keysyms commonly needed to drive firmware setup screens.
+const (
+	KeyBackspace   uint32 = 0xFF08
+	KeyTab         uint32 = 0xFF09
+	KeyEnter       uint32 = 0xFF0D
+	KeyEscape      uint32 = 0xFF1B
+	KeyHome        uint32 = 0xFF50
+	KeyLeft        uint32 = 0xFF51
+	KeyUp          uint32 = 0xFF52
+	KeyRight       uint32 = 0xFF53
+	KeyDown        uint32 = 0xFF54
+	KeyPageUp      uint32 = 0xFF55
+	KeyPageDown    uint32 = 0xFF56
+	KeyEnd         uint32 = 0xFF57
+	KeyInsert      uint32 = 0xFF63
+	KeyF1          uint32 = 0xFFBE
+	KeyF2          uint32 = 0xFFBF
+	KeyF3          uint32 = 0xFFC0
+	KeyF4          uint32 = 0xFFC1
+	KeyF5          uint32 = 0xFFC2
+	KeyF6          uint32 = 0xFFC3
+	KeyF7          uint32 = 0xFFC4
+	KeyF8          uint32 = 0xFFC5
+	KeyF9          uint32 = 0xFFC6
+	KeyF10         uint32 = 0xFFC7
+	KeyF11         uint32 = 0xFFC8
+	KeyF12         uint32 = 0xFFC9
+	KeyShiftLeft   uint32 = 0xFFE1
+	KeyControlLeft uint32 = 0xFFE3
+	KeyAltLeft     uint32 = 0xFFE9
+	KeyDelete      uint32 = 0xFFFF
+)
+
+// pointer button masks.
+const (
+	ButtonLeft   byte = 0x01
+	ButtonMiddle byte = 0x02
+	ButtonRight  byte = 0x04
+)
+
+const (
+	rfbVersionLength     = 12
+	rfbServerInitLength  = 24
+	rfbRectangleLength   = 12
+	rfbVNCChallengeSize  = 16
+	rfbZRLETileSize      = 64
+	rfbPixelFormatLength = 16
+)
+
+var (
+	ErrRFBVersion              = errors.New("unsupported RFB protocol version")
+	ErrRFBSecurityNotSupported = errors.New("no supported RFB security type")
+	ErrRFBAuthFailed           = errors.New("RFB authentication failed")
+	ErrUnsupportedEncoding     = errors.New("unsupported RFB encoding")
+)
+
+// PixelFormat is the RFB pixel format negotiated with the KVM server.
+type PixelFormat struct {
+	BitsPerPixel uint8
+	Depth        uint8
+	BigEndian    bool
+	TrueColor    bool
+	RedMax       uint16
+	GreenMax     uint16
+	BlueMax      uint16
+	RedShift     uint8
+	GreenShift   uint8
+	BlueShift    uint8
+}
+
+// pixel formats supported by Intel AMT KVM. The smaller formats trade colour depth for bandwidth.
+var (
+	PixelFormat32 = PixelFormat{BitsPerPixel: 32, Depth: 24, TrueColor: true, RedMax: 255, GreenMax: 255, BlueMax: 255, RedShift: 16, GreenShift: 8, BlueShift: 0}
+	PixelFormat16 = PixelFormat{BitsPerPixel: 16, Depth: 16, TrueColor: true, RedMax: 31, GreenMax: 63, BlueMax: 31, RedShift: 11, GreenShift: 5, BlueShift: 0}
+	PixelFormat8  = PixelFormat{BitsPerPixel: 8, Depth: 8, TrueColor: true, RedMax: 7, GreenMax: 7, BlueMax: 3, RedShift: 0, GreenShift: 3, BlueShift: 6}
+)
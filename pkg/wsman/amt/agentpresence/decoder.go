@@ -0,0 +1,73 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+// INPUTS Constants.
+const (
+	AMTAgentPresenceService        string = "AMT_AgentPresenceService"
+	AMTAgentPresenceWatchdog       string = "AMT_AgentPresenceWatchdog"
+	AMTAgentPresenceWatchdogAction string = "AMT_AgentPresenceWatchdogAction"
+	RegisterAgent                  string = "RegisterAgent"
+	AssertPresence                 string = "AssertPresence"
+	AssertShutdown                 string = "AssertShutdown"
+	AddAction                      string = "AddAction"
+	DeleteAllActions               string = "DeleteAllActions"
+	ValueNotFound                  string = "Value not found in map"
+)
+
+const (
+	WatchdogStateNotStarted WatchdogState = 1
+	WatchdogStateRunning    WatchdogState = 2
+	WatchdogStateExpired    WatchdogState = 4
+	WatchdogStateStopped    WatchdogState = 8
+)
+
+// watchdogStateToString is a map of the WatchdogState enumeration.
+var watchdogStateToString = map[WatchdogState]string{
+	WatchdogStateNotStarted: "NotStarted",
+	WatchdogStateRunning:    "Running",
+	WatchdogStateExpired:    "Expired",
+	WatchdogStateStopped:    "Stopped",
+}
+
+// String returns a human-readable string representation of the WatchdogState enumeration.
+func (e WatchdogState) String() string {
+	if s, ok := watchdogStateToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	ReturnValueSuccess          ReturnValue = 0
+	ReturnValueInternalError    ReturnValue = 1
+	ReturnValueNotPermitted     ReturnValue = 16
+	ReturnValueMaxLimitReached  ReturnValue = 23
+	ReturnValueInvalidParameter ReturnValue = 36
+	ReturnValueInvalidName      ReturnValue = 38
+	ReturnValueInvalidSequence  ReturnValue = 2064
+)
+
+// returnValueToString is a map of ReturnValue value to string.
+var returnValueToString = map[ReturnValue]string{
+	ReturnValueSuccess:          "Success",
+	ReturnValueInternalError:    "InternalError",
+	ReturnValueNotPermitted:     "NotPermitted",
+	ReturnValueMaxLimitReached:  "MaxLimitReached",
+	ReturnValueInvalidParameter: "InvalidParameter",
+	ReturnValueInvalidName:      "InvalidName",
+	ReturnValueInvalidSequence:  "InvalidSequence",
+}
+
+// String returns the string representation of the ReturnValue value.
+func (r ReturnValue) String() string {
+	if value, exists := returnValueToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
@@ -0,0 +1,51 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import "testing"
+
+func TestWatchdogState_String(t *testing.T) {
+	tests := []struct {
+		state    WatchdogState
+		expected string
+	}{
+		{WatchdogStateNotStarted, "NotStarted"},
+		{WatchdogStateRunning, "Running"},
+		{WatchdogStateExpired, "Expired"},
+		{WatchdogStateStopped, "Stopped"},
+		{WatchdogState(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestReturnValue_String(t *testing.T) {
+	tests := []struct {
+		state    ReturnValue
+		expected string
+	}{
+		{ReturnValueSuccess, "Success"},
+		{ReturnValueInternalError, "InternalError"},
+		{ReturnValueNotPermitted, "NotPermitted"},
+		{ReturnValueMaxLimitReached, "MaxLimitReached"},
+		{ReturnValueInvalidParameter, "InvalidParameter"},
+		{ReturnValueInvalidName, "InvalidName"},
+		{ReturnValueInvalidSequence, "InvalidSequence"},
+		{ReturnValue(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotRegistered is returned by Heartbeat.Beat and Heartbeat.Shutdown before the agent is registered.
+var ErrNotRegistered = errors.New("agentpresence: agent is not registered")
+
+// Presence is the subset of Watchdog used by the Heartbeat.
+type Presence interface {
+	RegisterAgent(deviceID string) (Response, error)
+	AssertPresence(deviceID string, sequenceNumber int) (Response, error)
+	AssertShutdown(deviceID string, sequenceNumber int) (Response, error)
+}
+
+// Heartbeat asserts the presence of an agent to its watchdog, so that the watchdog does not expire while the agent runs.
+type Heartbeat struct {
+	watchdog       Presence
+	deviceID       string
+	sequenceNumber int
+	registered     bool
+	timeout        time.Duration
+	// Interval is the time between assertions in Run. When it is not set, Run asserts the presence of the agent at half
+	// the timeout returned by RegisterAgent.
+	Interval time.Duration
+}
+
+// DeviceID returns the DeviceID of the watchdog of the agent with the given UUID.
+func DeviceID(agent uuid.UUID) string {
+	return base64.StdEncoding.EncodeToString(agent[:])
+}
+
+// NewHeartbeat creates a heartbeat for the agent with the given DeviceID. The watchdog of the agent must have been
+// created with Watchdog.Create.
+func NewHeartbeat(watchdog Presence, deviceID string) *Heartbeat {
+	return &Heartbeat{
+		watchdog: watchdog,
+		deviceID: deviceID,
+	}
+}
+
+// Register registers the agent, which starts its watchdog and a new session of sequence numbers.
+func (h *Heartbeat) Register() error {
+	response, err := h.watchdog.RegisterAgent(h.deviceID)
+	if err != nil {
+		return err
+	}
+
+	h.sequenceNumber = response.Body.RegisterAgent_OUTPUT.SessionSequenceNumber
+	h.timeout = time.Duration(response.Body.RegisterAgent_OUTPUT.TimeoutInterval) * time.Second
+	h.registered = true
+
+	return nil
+}
+
+// Beat asserts the presence of the agent, resetting its watchdog.
+func (h *Heartbeat) Beat() error {
+	if !h.registered {
+		return ErrNotRegistered
+	}
+
+	h.sequenceNumber++
+
+	_, err := h.watchdog.AssertPresence(h.deviceID, h.sequenceNumber)
+
+	return err
+}
+
+// Shutdown asserts the orderly shutdown of the agent, stopping its watchdog. The agent must register again to restart it.
+func (h *Heartbeat) Shutdown() error {
+	if !h.registered {
+		return ErrNotRegistered
+	}
+
+	h.sequenceNumber++
+	h.registered = false
+
+	_, err := h.watchdog.AssertShutdown(h.deviceID, h.sequenceNumber)
+
+	return err
+}
+
+// Run registers the agent and asserts its presence every Interval until ctx is done or an assertion fails. When ctx is
+// done, Run asserts the shutdown of the agent and returns ctx.Err, or the error of the shutdown.
+func (h *Heartbeat) Run(ctx context.Context) error {
+	if err := h.Register(); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(h.interval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := h.Beat(); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			if err := h.Shutdown(); err != nil {
+				return err
+			}
+
+			return ctx.Err()
+		}
+	}
+}
+
+// interval returns Interval, or half the timeout of the watchdog and at least a second when Interval is not set.
+func (h *Heartbeat) interval() time.Duration {
+	if h.Interval > 0 {
+		return h.Interval
+	}
+
+	if h.timeout/2 < time.Second {
+		return time.Second
+	}
+
+	return h.timeout / 2
+}
@@ -0,0 +1,111 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var errPresence = errors.New("presence error")
+
+// presence records the calls of a Heartbeat as "<method> <sequence number>".
+type presence struct {
+	calls   []string
+	timeout int
+	failOn  string
+}
+
+func (p *presence) record(method string, sequenceNumber int) (Response, error) {
+	p.calls = append(p.calls, fmt.Sprintf("%s %d", method, sequenceNumber))
+
+	if method == p.failOn {
+		return Response{}, errPresence
+	}
+
+	return Response{}, nil
+}
+
+func (p *presence) RegisterAgent(deviceID string) (response Response, err error) {
+	response, err = p.record(RegisterAgent, 0)
+	response.Body.RegisterAgent_OUTPUT = RegisterAgent_OUTPUT{SessionSequenceNumber: 10, TimeoutInterval: p.timeout}
+
+	return response, err
+}
+
+func (p *presence) AssertPresence(deviceID string, sequenceNumber int) (Response, error) {
+	return p.record(AssertPresence, sequenceNumber)
+}
+
+func (p *presence) AssertShutdown(deviceID string, sequenceNumber int) (Response, error) {
+	return p.record(AssertShutdown, sequenceNumber)
+}
+
+func TestDeviceID(t *testing.T) {
+	agent := uuid.MustParse("3bd46c70-9885-45b6-9fe2-622f1497387e")
+	assert.Equal(t, "O9RscJiFRbaf4mIvFJc4fg==", DeviceID(agent))
+}
+
+func TestHeartbeat(t *testing.T) {
+	watchdog := &presence{timeout: 160}
+	heartbeat := NewHeartbeat(watchdog, agentDeviceID)
+
+	assert.ErrorIs(t, heartbeat.Beat(), ErrNotRegistered)
+	assert.ErrorIs(t, heartbeat.Shutdown(), ErrNotRegistered)
+
+	assert.NoError(t, heartbeat.Register())
+	assert.Equal(t, 80*time.Second, heartbeat.interval())
+	assert.NoError(t, heartbeat.Beat())
+	assert.NoError(t, heartbeat.Beat())
+	assert.NoError(t, heartbeat.Shutdown())
+	assert.ErrorIs(t, heartbeat.Beat(), ErrNotRegistered)
+	assert.Equal(t, []string{"RegisterAgent 0", "AssertPresence 11", "AssertPresence 12", "AssertShutdown 13"}, watchdog.calls)
+}
+
+func TestHeartbeatInterval(t *testing.T) {
+	heartbeat := NewHeartbeat(&presence{}, agentDeviceID)
+	assert.Equal(t, time.Second, heartbeat.interval())
+
+	heartbeat.Interval = 5 * time.Second
+	assert.Equal(t, 5*time.Second, heartbeat.interval())
+}
+
+func TestHeartbeatRun(t *testing.T) {
+	t.Run("should assert the shutdown of the agent when the context is done", func(t *testing.T) {
+		watchdog := &presence{}
+		heartbeat := NewHeartbeat(watchdog, agentDeviceID)
+		heartbeat.Interval = time.Millisecond
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		err := heartbeat.Run(ctx)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, "RegisterAgent 0", watchdog.calls[0])
+		assert.Equal(t, "AssertPresence 11", watchdog.calls[1])
+		assert.Equal(t, fmt.Sprintf("AssertShutdown %d", 10+len(watchdog.calls)-1), watchdog.calls[len(watchdog.calls)-1])
+	})
+
+	for _, method := range []string{RegisterAgent, AssertPresence} {
+		t.Run("should return the error of "+method, func(t *testing.T) {
+			watchdog := &presence{failOn: method}
+			heartbeat := NewHeartbeat(watchdog, agentDeviceID)
+			heartbeat.Interval = time.Millisecond
+
+			err := heartbeat.Run(context.Background())
+
+			assert.ErrorIs(t, err, errPresence)
+			assert.Equal(t, method, watchdog.calls[len(watchdog.calls)-1][:len(method)])
+		})
+	}
+}
@@ -0,0 +1,100 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package agentpresence facilitates communication with Intel® AMT devices to monitor the agents of the host with Agent Presence watchdogs.
+//
+// AgentPresenceService:
+// The limits of the Agent Presence implementation of the device.
+//
+// AgentPresenceWatchdog:
+// A watchdog that expires when its agent does not assert its presence in time. Agents register with and assert their
+// presence to their watchdog; management consoles create watchdogs and add the actions taken on their state transitions.
+//
+// AgentPresenceWatchdogAction:
+// An action of a watchdog, such as generating an event or activating a System Defense policy on a state transition.
+package agentpresence
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewAgentPresenceServiceWithClient instantiates a new agent presence Service.
+func NewAgentPresenceServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Service {
+	return Service{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTAgentPresenceService, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (service Service) Get() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Get(nil),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (service Service) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Enumerate(),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (service Service) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Pull(enumerationContext),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,210 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func TestJson(t *testing.T) {
+	response := Response{
+		Body: Body{
+			PullResponse: PullResponse{},
+		},
+	}
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ServiceGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"ElementName\":\"\",\"EnabledState\":0,\"RequestedState\":0,\"MaxTotalAgents\":0,\"MaxTotalActions\":0,\"MinGuaranteedActionList\":0},\"WatchdogGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"DeviceID\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"ElementName\":\"\",\"CurrentState\":0,\"TimeoutInterval\":0,\"StartupInterval\":0},\"WatchdogActionGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"Name\":\"\",\"ElementName\":\"\",\"OldState\":0,\"NewState\":0,\"EventOnTransition\":false,\"ActionSd\":false,\"ActionEac\":false},\"CreateResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Address\":\"\",\"ReferenceParameters\":{\"ResourceURI\":\"\",\"SelectorSet\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Selector\":null}}},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ServiceItems\":null,\"WatchdogItems\":null,\"WatchdogActionItems\":null},\"RegisterAgent_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"SessionSequenceNumber\":0,\"TimeoutInterval\":0,\"ReturnValue\":0},\"AssertPresence_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"AssertShutdown_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"AddAction_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Action\":{\"Address\":\"\",\"ReferenceParameters\":{\"ResourceURI\":\"\",\"SelectorSet\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Selector\":null}}},\"ReturnValue\":0},\"DeleteAllActions_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0}}"
+	result := response.JSON()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestYaml(t *testing.T) {
+	response := Response{
+		Body: Body{
+			PullResponse: PullResponse{},
+		},
+	}
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\nservicegetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    elementname: \"\"\n    enabledstate: 0\n    requestedstate: 0\n    maxtotalagents: 0\n    maxtotalactions: 0\n    minguaranteedactionlist: 0\nwatchdoggetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    deviceid: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    elementname: \"\"\n    currentstate: 0\n    timeoutinterval: 0\n    startupinterval: 0\nwatchdogactiongetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    name: \"\"\n    elementname: \"\"\n    oldstate: 0\n    newstate: 0\n    eventontransition: false\n    actionsd: false\n    actioneac: false\ncreateresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    address: \"\"\n    referenceparameters:\n        resourceuri: \"\"\n        selectorset:\n            xmlname:\n                space: \"\"\n                local: \"\"\n            selector: []\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    serviceitems: []\n    watchdogitems: []\n    watchdogactionitems: []\nregisteragent_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    sessionsequencenumber: 0\n    timeoutinterval: 0\n    returnvalue: 0\nassertpresence_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nassertshutdown_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\naddaction_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    action:\n        address: \"\"\n        referenceparameters:\n            resourceuri: \"\"\n            selectorset:\n                xmlname:\n                    space: \"\"\n                    local: \"\"\n                selector: []\n    returnvalue: 0\ndeleteallactions_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\n"
+	result := response.YAML()
+	assert.Equal(t, expectedResult, result)
+}
+
+func serviceResponse() ServiceResponse {
+	return ServiceResponse{
+		XMLName:                 xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceService", Local: "AMT_AgentPresenceService"},
+		CreationClassName:       AMTAgentPresenceService,
+		Name:                    "Intel(r) AMT Agent Presence Service",
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+		ElementName:             "Intel(r) AMT Agent Presence Service",
+		EnabledState:            5,
+		RequestedState:          12,
+		MaxTotalAgents:          16,
+		MaxTotalActions:         64,
+		MinGuaranteedActionList: 4,
+	}
+}
+
+func TestPositiveAgentPresenceService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/agentpresence/service",
+	}
+	elementUnderTest := NewAgentPresenceServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_agentpresenceservice Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should create and parse a valid AMT_AgentPresenceService Get call",
+				AMTAgentPresenceService,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:            xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ServiceGetResponse: serviceResponse(),
+				},
+			},
+			{
+				"should create and parse a valid AMT_AgentPresenceService Enumerate call",
+				AMTAgentPresenceService,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_AgentPresenceService Pull call",
+				AMTAgentPresenceService,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:      xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						ServiceItems: []ServiceResponse{serviceResponse()},
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAgentPresenceService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/agentpresence/service",
+	}
+	elementUnderTest := NewAgentPresenceServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_agentpresenceservice Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_AgentPresenceService Get call",
+				AMTAgentPresenceService,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceService Enumerate call",
+				AMTAgentPresenceService,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceService Pull call",
+				AMTAgentPresenceService,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,171 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/models"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type (
+	Service struct {
+		base message.Base
+	}
+	Watchdog struct {
+		base message.Base
+	}
+	WatchdogAction struct {
+		base message.Base
+	}
+)
+
+// OUTPUT
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName                   xml.Name               `xml:"Body"`
+		ServiceGetResponse        ServiceResponse        `xml:"AMT_AgentPresenceService"`
+		WatchdogGetResponse       WatchdogResponse       `xml:"AMT_AgentPresenceWatchdog"`
+		WatchdogActionGetResponse WatchdogActionResponse `xml:"AMT_AgentPresenceWatchdogAction"`
+		CreateResponse            CreateResponse         `xml:"ResourceCreated"`
+		EnumerateResponse         common.EnumerateResponse
+		PullResponse              PullResponse
+		RegisterAgent_OUTPUT      RegisterAgent_OUTPUT
+		AssertPresence_OUTPUT     AssertPresence_OUTPUT
+		AssertShutdown_OUTPUT     AssertShutdown_OUTPUT
+		AddAction_OUTPUT          AddAction_OUTPUT
+		DeleteAllActions_OUTPUT   DeleteAllActions_OUTPUT
+	}
+	PullResponse struct {
+		XMLName             xml.Name                 `xml:"PullResponse"`
+		ServiceItems        []ServiceResponse        `xml:"Items>AMT_AgentPresenceService"`
+		WatchdogItems       []WatchdogResponse       `xml:"Items>AMT_AgentPresenceWatchdog"`
+		WatchdogActionItems []WatchdogActionResponse `xml:"Items>AMT_AgentPresenceWatchdogAction"`
+	}
+	ServiceResponse struct {
+		XMLName                 xml.Name `xml:"AMT_AgentPresenceService"`
+		CreationClassName       string   `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass that is used in the creation of an instance.
+		Name                    string   `xml:"Name,omitempty"`                    // The Name property uniquely identifies the Service and provides an indication of the functionality that is managed.
+		SystemCreationClassName string   `xml:"SystemCreationClassName,omitempty"` // The CreationClassName of the scoping System.
+		SystemName              string   `xml:"SystemName,omitempty"`              // The Name of the scoping System.
+		ElementName             string   `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		EnabledState            int      `xml:"EnabledState"`                      // EnabledState is an integer enumeration that indicates the enabled and disabled states of an element.
+		RequestedState          int      `xml:"RequestedState"`                    // RequestedState is an integer enumeration that indicates the last requested or desired state for the element.
+		MaxTotalAgents          int      `xml:"MaxTotalAgents"`                    // The maximum number of agent watchdogs.
+		MaxTotalActions         int      `xml:"MaxTotalActions"`                   // The maximum number of actions of all the agent watchdogs.
+		MinGuaranteedActionList int      `xml:"MinGuaranteedActionList"`           // The number of actions each agent watchdog is guaranteed to support.
+	}
+	WatchdogResponse struct {
+		XMLName                 xml.Name      `xml:"AMT_AgentPresenceWatchdog"`
+		CreationClassName       string        `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass that is used in the creation of an instance.
+		DeviceID                string        `xml:"DeviceID,omitempty"`                // The identifier of the agent, the Base64 encoding of its UUID.
+		SystemCreationClassName string        `xml:"SystemCreationClassName,omitempty"` // The CreationClassName of the scoping System.
+		SystemName              string        `xml:"SystemName,omitempty"`              // The Name of the scoping System.
+		ElementName             string        `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		CurrentState            WatchdogState `xml:"CurrentState"`                      // The current state of the watchdog.
+		TimeoutInterval         int           `xml:"TimeoutInterval"`                   // The time, in seconds, within which the agent must assert its presence before the watchdog expires.
+		StartupInterval         int           `xml:"StartupInterval"`                   // The time, in seconds, within which the agent must register after the watchdog is started.
+	}
+	WatchdogActionResponse struct {
+		XMLName           xml.Name      `xml:"AMT_AgentPresenceWatchdogAction"`
+		CreationClassName string        `xml:"CreationClassName,omitempty"` // CreationClassName indicates the name of the class or the subclass that is used in the creation of an instance.
+		Name              string        `xml:"Name,omitempty"`              // The identifier of the action.
+		ElementName       string        `xml:"ElementName,omitempty"`       // A user-friendly name for the object.
+		OldState          WatchdogState `xml:"OldState"`                    // The states the watchdog transitions from, as bit flags.
+		NewState          WatchdogState `xml:"NewState"`                    // The state the watchdog transitions to.
+		EventOnTransition bool          `xml:"EventOnTransition"`           // Whether AMT generates an event on the transition.
+		ActionSd          bool          `xml:"ActionSd"`                    // Whether AMT activates the System Defense policies of Agent Presence on the transition.
+		ActionEac         bool          `xml:"ActionEac"`                   // Whether AMT updates the Endpoint Access Control posture on the transition.
+	}
+	CreateResponse struct {
+		XMLName             xml.Name                          `xml:"ResourceCreated"`
+		Address             string                            `xml:"Address,omitempty"`
+		ReferenceParameters models.ReferenceParameters_OUTPUT `xml:"ReferenceParameters,omitempty"`
+	}
+	EndpointReference struct {
+		Address             string                            `xml:"Address,omitempty"`
+		ReferenceParameters models.ReferenceParameters_OUTPUT `xml:"ReferenceParameters,omitempty"`
+	}
+	RegisterAgent_OUTPUT struct {
+		XMLName               xml.Name    `xml:"RegisterAgent_OUTPUT"`
+		SessionSequenceNumber int         `xml:"SessionSequenceNumber"` // The sequence number of the session; each assertion of the agent must use a greater number.
+		TimeoutInterval       int         `xml:"TimeoutInterval"`       // The time, in seconds, within which the agent must assert its presence.
+		ReturnValue           ReturnValue `xml:"ReturnValue"`
+	}
+	AssertPresence_OUTPUT struct {
+		XMLName     xml.Name    `xml:"AssertPresence_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	AssertShutdown_OUTPUT struct {
+		XMLName     xml.Name    `xml:"AssertShutdown_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	AddAction_OUTPUT struct {
+		XMLName     xml.Name          `xml:"AddAction_OUTPUT"`
+		Action      EndpointReference `xml:"Action"` // The endpoint reference of the created AMT_AgentPresenceWatchdogAction.
+		ReturnValue ReturnValue       `xml:"ReturnValue"`
+	}
+	DeleteAllActions_OUTPUT struct {
+		XMLName     xml.Name    `xml:"DeleteAllActions_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+)
+
+// INPUT
+// Request Types.
+type (
+	WatchdogRequest struct {
+		XMLName         xml.Name `xml:"h:AMT_AgentPresenceWatchdog"`
+		H               string   `xml:"xmlns:h,attr"`
+		DeviceID        string   `xml:"h:DeviceID"`              // The identifier of the agent, the Base64 encoding of its UUID; see DeviceID.
+		ElementName     string   `xml:"h:ElementName,omitempty"` // A user-friendly name for the object.
+		TimeoutInterval int      `xml:"h:TimeoutInterval"`       // The time, in seconds, within which the agent must assert its presence before the watchdog expires.
+		StartupInterval int      `xml:"h:StartupInterval"`       // The time, in seconds, within which the agent must register after the watchdog is started.
+	}
+	AssertPresence_INPUT struct {
+		XMLName        xml.Name `xml:"h:AssertPresence_INPUT"`
+		H              string   `xml:"xmlns:h,attr"`
+		SequenceNumber int      `xml:"h:SequenceNumber"` // The sequence number of the assertion, greater than that of the previous one.
+	}
+	AssertShutdown_INPUT struct {
+		XMLName        xml.Name `xml:"h:AssertShutdown_INPUT"`
+		H              string   `xml:"xmlns:h,attr"`
+		SequenceNumber int      `xml:"h:SequenceNumber"` // The sequence number of the assertion, greater than that of the previous one.
+	}
+	AddAction_INPUT struct {
+		XMLName           xml.Name      `xml:"h:AddAction_INPUT"`
+		H                 string        `xml:"xmlns:h,attr"`
+		OldState          WatchdogState `xml:"h:OldState"`          // The states the watchdog transitions from, as bit flags.
+		NewState          WatchdogState `xml:"h:NewState"`          // The state the watchdog transitions to.
+		EventOnTransition bool          `xml:"h:EventOnTransition"` // Whether AMT generates an event on the transition.
+		ActionSd          bool          `xml:"h:ActionSd"`          // Whether AMT activates the System Defense policies of Agent Presence on the transition.
+		ActionEac         bool          `xml:"h:ActionEac"`         // Whether AMT updates the Endpoint Access Control posture on the transition.
+	}
+	// Action is an action of a watchdog, taken when the watchdog transitions from one of the OldState states to NewState.
+	Action struct {
+		OldState              WatchdogState // The states the watchdog transitions from, as bit flags.
+		NewState              WatchdogState // The state the watchdog transitions to.
+		EventOnTransition     bool          // Generate an event on the transition.
+		ActivateSystemDefense bool          // Activate the System Defense policies of Agent Presence on the transition.
+		UpdateEAC             bool          // Update the Endpoint Access Control posture on the transition.
+	}
+)
+
+type (
+	// WatchdogState is the state of an agent watchdog. The states are bit flags, so that the OldState of an action can match several states.
+	WatchdogState int
+	ReturnValue   int
+)
@@ -0,0 +1,305 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"encoding/xml"
+	"errors"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewAgentPresenceWatchdogWithClient instantiates a new agent presence Watchdog.
+func NewAgentPresenceWatchdogWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Watchdog {
+	return Watchdog{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTAgentPresenceWatchdog, client),
+	}
+}
+
+// Get retrieves the representation of the watchdog of the agent with the given DeviceID.
+func (watchdog Watchdog) Get(deviceID string) (response Response, err error) {
+	selector := message.Selector{Name: "DeviceID", Value: deviceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: watchdog.base.Get(&selector),
+		},
+	}
+
+	// send the message to AMT
+	err = watchdog.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (watchdog Watchdog) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: watchdog.base.Enumerate(),
+		},
+	}
+
+	// send the message to AMT
+	err = watchdog.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (watchdog Watchdog) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: watchdog.base.Pull(enumerationContext),
+		},
+	}
+
+	// send the message to AMT
+	err = watchdog.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Create registers a watchdog for an agent. The watchdog starts once the agent registers with RegisterAgent.
+func (watchdog Watchdog) Create(request WatchdogRequest) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: watchdog.base.Create(&request, nil),
+		},
+	}
+
+	// send the message to AMT
+	err = watchdog.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Delete removes the watchdog of the agent with the given DeviceID.
+func (watchdog Watchdog) Delete(deviceID string) (response Response, err error) {
+	selector := message.Selector{Name: "DeviceID", Value: deviceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: watchdog.base.Delete(selector),
+		},
+	}
+
+	// send the message to AMT
+	err = watchdog.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// RegisterAgent is called by the agent to start its watchdog. The response holds the session sequence number and timeout of the agent.
+// Any return code other than 0 indicates an error condition.
+func (watchdog Watchdog) RegisterAgent(deviceID string) (response Response, err error) {
+	selector := message.Selector{Name: "DeviceID", Value: deviceID}
+	header := watchdog.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAgentPresenceWatchdog, RegisterAgent), AMTAgentPresenceWatchdog, &selector, "", "")
+	body := watchdog.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(RegisterAgent), AMTAgentPresenceWatchdog, nil)
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: watchdog.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = watchdog.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.RegisterAgent_OUTPUT.ReturnValue != ReturnValueSuccess {
+		err = errors.New("RegisterAgent failed with return code " + response.Body.RegisterAgent_OUTPUT.ReturnValue.String())
+	}
+
+	return response, err
+}
+
+// AssertPresence is called by the agent to reset its watchdog. sequenceNumber must be greater than that of the previous assertion of the session.
+// Any return code other than 0 indicates an error condition.
+func (watchdog Watchdog) AssertPresence(deviceID string, sequenceNumber int) (response Response, err error) {
+	selector := message.Selector{Name: "DeviceID", Value: deviceID}
+	header := watchdog.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAgentPresenceWatchdog, AssertPresence), AMTAgentPresenceWatchdog, &selector, "", "")
+	body := watchdog.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(AssertPresence), AMTAgentPresenceWatchdog, &AssertPresence_INPUT{SequenceNumber: sequenceNumber})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: watchdog.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = watchdog.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.AssertPresence_OUTPUT.ReturnValue != ReturnValueSuccess {
+		err = errors.New("AssertPresence failed with return code " + response.Body.AssertPresence_OUTPUT.ReturnValue.String())
+	}
+
+	return response, err
+}
+
+// AssertShutdown is called by the agent to stop its watchdog on an orderly shutdown, so that it does not expire.
+// Any return code other than 0 indicates an error condition.
+func (watchdog Watchdog) AssertShutdown(deviceID string, sequenceNumber int) (response Response, err error) {
+	selector := message.Selector{Name: "DeviceID", Value: deviceID}
+	header := watchdog.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAgentPresenceWatchdog, AssertShutdown), AMTAgentPresenceWatchdog, &selector, "", "")
+	body := watchdog.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(AssertShutdown), AMTAgentPresenceWatchdog, &AssertShutdown_INPUT{SequenceNumber: sequenceNumber})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: watchdog.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = watchdog.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.AssertShutdown_OUTPUT.ReturnValue != ReturnValueSuccess {
+		err = errors.New("AssertShutdown failed with return code " + response.Body.AssertShutdown_OUTPUT.ReturnValue.String())
+	}
+
+	return response, err
+}
+
+// AddAction adds an action taken when the watchdog transitions between states.
+// Any return code other than 0 indicates an error condition.
+func (watchdog Watchdog) AddAction(deviceID string, action Action) (response Response, err error) {
+	selector := message.Selector{Name: "DeviceID", Value: deviceID}
+	header := watchdog.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAgentPresenceWatchdog, AddAction), AMTAgentPresenceWatchdog, &selector, "", "")
+	body := watchdog.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(AddAction), AMTAgentPresenceWatchdog, &AddAction_INPUT{
+		OldState:          action.OldState,
+		NewState:          action.NewState,
+		EventOnTransition: action.EventOnTransition,
+		ActionSd:          action.ActivateSystemDefense,
+		ActionEac:         action.UpdateEAC,
+	})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: watchdog.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = watchdog.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.AddAction_OUTPUT.ReturnValue != ReturnValueSuccess {
+		err = errors.New("AddAction failed with return code " + response.Body.AddAction_OUTPUT.ReturnValue.String())
+	}
+
+	return response, err
+}
+
+// DeleteAllActions removes the actions of the watchdog.
+// Any return code other than 0 indicates an error condition.
+func (watchdog Watchdog) DeleteAllActions(deviceID string) (response Response, err error) {
+	selector := message.Selector{Name: "DeviceID", Value: deviceID}
+	header := watchdog.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAgentPresenceWatchdog, DeleteAllActions), AMTAgentPresenceWatchdog, &selector, "", "")
+	body := watchdog.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(DeleteAllActions), AMTAgentPresenceWatchdog, nil)
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: watchdog.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = watchdog.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.DeleteAllActions_OUTPUT.ReturnValue != ReturnValueSuccess {
+		err = errors.New("DeleteAllActions failed with return code " + response.Body.DeleteAllActions_OUTPUT.ReturnValue.String())
+	}
+
+	return response, err
+}
@@ -0,0 +1,432 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/models"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const agentDeviceID = "O9RscJiFRbaf4mIvFJc4fg=="
+
+func watchdogResponse() WatchdogResponse {
+	return WatchdogResponse{
+		XMLName:                 xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog", Local: "AMT_AgentPresenceWatchdog"},
+		CreationClassName:       AMTAgentPresenceWatchdog,
+		DeviceID:                agentDeviceID,
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+		ElementName:             "Endpoint Agent",
+		CurrentState:            WatchdogStateRunning,
+		TimeoutInterval:         160,
+		StartupInterval:         10,
+	}
+}
+
+func selector(name, value string) message.Selector_OUTPUT {
+	return message.Selector_OUTPUT{
+		XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"},
+		Name:    name,
+		Value:   value,
+	}
+}
+
+func TestPositiveAgentPresenceWatchdog(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/agentpresence/watchdog",
+	}
+	elementUnderTest := NewAgentPresenceWatchdogWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_agentpresencewatchdog Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should create and parse a valid AMT_AgentPresenceWatchdog Get call",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"DeviceID\">O9RscJiFRbaf4mIvFJc4fg==</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(agentDeviceID)
+				},
+				Body{
+					XMLName:             xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					WatchdogGetResponse: watchdogResponse(),
+				},
+			},
+			{
+				"should create and parse a valid AMT_AgentPresenceWatchdog Enumerate call",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_AgentPresenceWatchdog Pull call",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:       xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						WatchdogItems: []WatchdogResponse{watchdogResponse()},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_AgentPresenceWatchdog Create call",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Create,
+				"",
+				"<h:AMT_AgentPresenceWatchdog xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog\"><h:DeviceID>O9RscJiFRbaf4mIvFJc4fg==</h:DeviceID><h:ElementName>Endpoint Agent</h:ElementName><h:TimeoutInterval>160</h:TimeoutInterval><h:StartupInterval>10</h:StartupInterval></h:AMT_AgentPresenceWatchdog>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(WatchdogRequest{DeviceID: agentDeviceID, ElementName: "Endpoint Agent", TimeoutInterval: 160, StartupInterval: 10})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CreateResponse: CreateResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/transfer", Local: "ResourceCreated"},
+						Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+						ReferenceParameters: models.ReferenceParameters_OUTPUT{
+							ResourceURI: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog",
+							SelectorSet: models.SelectorSet_OUTPUT{
+								XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "SelectorSet"},
+								Selector: []message.Selector_OUTPUT{
+									selector("CreationClassName", AMTAgentPresenceWatchdog),
+									selector("DeviceID", agentDeviceID),
+									selector("SystemCreationClassName", "CIM_ComputerSystem"),
+									selector("SystemName", "Intel(r) AMT"),
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_AgentPresenceWatchdog Delete call",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"DeviceID\">O9RscJiFRbaf4mIvFJc4fg==</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete(agentDeviceID)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+			{
+				"should create and parse a valid AMT_AgentPresenceWatchdog RegisterAgent call",
+				AMTAgentPresenceWatchdog,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTAgentPresenceWatchdog, RegisterAgent),
+				"<w:SelectorSet><w:Selector Name=\"DeviceID\">O9RscJiFRbaf4mIvFJc4fg==</w:Selector></w:SelectorSet>",
+				"<h:RegisterAgent_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog\"></h:RegisterAgent_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = RegisterAgent
+
+					return elementUnderTest.RegisterAgent(agentDeviceID)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					RegisterAgent_OUTPUT: RegisterAgent_OUTPUT{
+						XMLName:         xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog", Local: "RegisterAgent_OUTPUT"},
+						TimeoutInterval: 160,
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_AgentPresenceWatchdog AssertPresence call",
+				AMTAgentPresenceWatchdog,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTAgentPresenceWatchdog, AssertPresence),
+				"<w:SelectorSet><w:Selector Name=\"DeviceID\">O9RscJiFRbaf4mIvFJc4fg==</w:Selector></w:SelectorSet>",
+				"<h:AssertPresence_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog\"><h:SequenceNumber>1</h:SequenceNumber></h:AssertPresence_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = AssertPresence
+
+					return elementUnderTest.AssertPresence(agentDeviceID, 1)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AssertPresence_OUTPUT: AssertPresence_OUTPUT{
+						XMLName: xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog", Local: "AssertPresence_OUTPUT"},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_AgentPresenceWatchdog AssertShutdown call",
+				AMTAgentPresenceWatchdog,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTAgentPresenceWatchdog, AssertShutdown),
+				"<w:SelectorSet><w:Selector Name=\"DeviceID\">O9RscJiFRbaf4mIvFJc4fg==</w:Selector></w:SelectorSet>",
+				"<h:AssertShutdown_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog\"><h:SequenceNumber>2</h:SequenceNumber></h:AssertShutdown_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = AssertShutdown
+
+					return elementUnderTest.AssertShutdown(agentDeviceID, 2)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AssertShutdown_OUTPUT: AssertShutdown_OUTPUT{
+						XMLName: xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog", Local: "AssertShutdown_OUTPUT"},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_AgentPresenceWatchdog AddAction call",
+				AMTAgentPresenceWatchdog,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTAgentPresenceWatchdog, AddAction),
+				"<w:SelectorSet><w:Selector Name=\"DeviceID\">O9RscJiFRbaf4mIvFJc4fg==</w:Selector></w:SelectorSet>",
+				"<h:AddAction_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog\"><h:OldState>2</h:OldState><h:NewState>4</h:NewState><h:EventOnTransition>true</h:EventOnTransition><h:ActionSd>true</h:ActionSd><h:ActionEac>false</h:ActionEac></h:AddAction_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = AddAction
+
+					return elementUnderTest.AddAction(agentDeviceID, Action{OldState: WatchdogStateRunning, NewState: WatchdogStateExpired, EventOnTransition: true, ActivateSystemDefense: true})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AddAction_OUTPUT: AddAction_OUTPUT{
+						XMLName: xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog", Local: "AddAction_OUTPUT"},
+						Action: EndpointReference{
+							Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+							ReferenceParameters: models.ReferenceParameters_OUTPUT{
+								ResourceURI: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdogAction",
+								SelectorSet: models.SelectorSet_OUTPUT{
+									XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "SelectorSet"},
+									Selector: []message.Selector_OUTPUT{
+										selector("CreationClassName", AMTAgentPresenceWatchdogAction),
+										selector("Name", "Intel(r) AMT Agent Presence Watchdog Action 0"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_AgentPresenceWatchdog DeleteAllActions call",
+				AMTAgentPresenceWatchdog,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTAgentPresenceWatchdog, DeleteAllActions),
+				"<w:SelectorSet><w:Selector Name=\"DeviceID\">O9RscJiFRbaf4mIvFJc4fg==</w:Selector></w:SelectorSet>",
+				"<h:DeleteAllActions_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog\"></h:DeleteAllActions_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = DeleteAllActions
+
+					return elementUnderTest.DeleteAllActions(agentDeviceID)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					DeleteAllActions_OUTPUT: DeleteAllActions_OUTPUT{
+						XMLName: xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog", Local: "DeleteAllActions_OUTPUT"},
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAgentPresenceWatchdog(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/agentpresence/watchdog",
+	}
+	elementUnderTest := NewAgentPresenceWatchdogWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_agentpresencewatchdog Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_AgentPresenceWatchdog Get call",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"DeviceID\">O9RscJiFRbaf4mIvFJc4fg==</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(agentDeviceID)
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdog Enumerate call",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdog Pull call",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdog Create call",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Create,
+				"",
+				"<h:AMT_AgentPresenceWatchdog xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog\"><h:DeviceID>O9RscJiFRbaf4mIvFJc4fg==</h:DeviceID><h:ElementName>Endpoint Agent</h:ElementName><h:TimeoutInterval>160</h:TimeoutInterval><h:StartupInterval>10</h:StartupInterval></h:AMT_AgentPresenceWatchdog>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Create(WatchdogRequest{DeviceID: agentDeviceID, ElementName: "Endpoint Agent", TimeoutInterval: 160, StartupInterval: 10})
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdog Delete call",
+				AMTAgentPresenceWatchdog,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"DeviceID\">O9RscJiFRbaf4mIvFJc4fg==</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete(agentDeviceID)
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdog RegisterAgent call",
+				AMTAgentPresenceWatchdog,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTAgentPresenceWatchdog, RegisterAgent),
+				"<w:SelectorSet><w:Selector Name=\"DeviceID\">O9RscJiFRbaf4mIvFJc4fg==</w:Selector></w:SelectorSet>",
+				"<h:RegisterAgent_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog\"></h:RegisterAgent_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.RegisterAgent(agentDeviceID)
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdog AssertPresence call",
+				AMTAgentPresenceWatchdog,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTAgentPresenceWatchdog, AssertPresence),
+				"<w:SelectorSet><w:Selector Name=\"DeviceID\">O9RscJiFRbaf4mIvFJc4fg==</w:Selector></w:SelectorSet>",
+				"<h:AssertPresence_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog\"><h:SequenceNumber>1</h:SequenceNumber></h:AssertPresence_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.AssertPresence(agentDeviceID, 1)
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdog AssertShutdown call",
+				AMTAgentPresenceWatchdog,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTAgentPresenceWatchdog, AssertShutdown),
+				"<w:SelectorSet><w:Selector Name=\"DeviceID\">O9RscJiFRbaf4mIvFJc4fg==</w:Selector></w:SelectorSet>",
+				"<h:AssertShutdown_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog\"><h:SequenceNumber>2</h:SequenceNumber></h:AssertShutdown_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.AssertShutdown(agentDeviceID, 2)
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdog AddAction call",
+				AMTAgentPresenceWatchdog,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTAgentPresenceWatchdog, AddAction),
+				"<w:SelectorSet><w:Selector Name=\"DeviceID\">O9RscJiFRbaf4mIvFJc4fg==</w:Selector></w:SelectorSet>",
+				"<h:AddAction_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog\"><h:OldState>2</h:OldState><h:NewState>4</h:NewState><h:EventOnTransition>true</h:EventOnTransition><h:ActionSd>true</h:ActionSd><h:ActionEac>false</h:ActionEac></h:AddAction_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.AddAction(agentDeviceID, Action{OldState: WatchdogStateRunning, NewState: WatchdogStateExpired, EventOnTransition: true, ActivateSystemDefense: true})
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdog DeleteAllActions call",
+				AMTAgentPresenceWatchdog,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTAgentPresenceWatchdog, DeleteAllActions),
+				"<w:SelectorSet><w:Selector Name=\"DeviceID\">O9RscJiFRbaf4mIvFJc4fg==</w:Selector></w:SelectorSet>",
+				"<h:DeleteAllActions_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdog\"></h:DeleteAllActions_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.DeleteAllActions(agentDeviceID)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,90 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewAgentPresenceWatchdogActionWithClient instantiates a new agent presence WatchdogAction.
+func NewAgentPresenceWatchdogActionWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) WatchdogAction {
+	return WatchdogAction{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTAgentPresenceWatchdogAction, client),
+	}
+}
+
+// Get retrieves the representation of the action with the given Name.
+func (action WatchdogAction) Get(name string) (response Response, err error) {
+	selector := message.Selector{Name: "Name", Value: name}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: action.base.Get(&selector),
+		},
+	}
+
+	// send the message to AMT
+	err = action.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (action WatchdogAction) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: action.base.Enumerate(),
+		},
+	}
+
+	// send the message to AMT
+	err = action.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (action WatchdogAction) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: action.base.Pull(enumerationContext),
+		},
+	}
+
+	// send the message to AMT
+	err = action.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,187 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package agentpresence
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const watchdogActionName = "Intel(r) AMT Agent Presence Watchdog Action 0"
+
+func watchdogActionResponse() WatchdogActionResponse {
+	return WatchdogActionResponse{
+		XMLName:           xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AgentPresenceWatchdogAction", Local: "AMT_AgentPresenceWatchdogAction"},
+		CreationClassName: AMTAgentPresenceWatchdogAction,
+		Name:              watchdogActionName,
+		ElementName:       watchdogActionName,
+		OldState:          WatchdogStateRunning,
+		NewState:          WatchdogStateExpired,
+		EventOnTransition: true,
+		ActionSd:          true,
+	}
+}
+
+func TestPositiveAgentPresenceWatchdogAction(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/agentpresence/watchdogaction",
+	}
+	elementUnderTest := NewAgentPresenceWatchdogActionWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_agentpresencewatchdogaction Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should create and parse a valid AMT_AgentPresenceWatchdogAction Get call",
+				AMTAgentPresenceWatchdogAction,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"Name\">Intel(r) AMT Agent Presence Watchdog Action 0</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(watchdogActionName)
+				},
+				Body{
+					XMLName:                   xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					WatchdogActionGetResponse: watchdogActionResponse(),
+				},
+			},
+			{
+				"should create and parse a valid AMT_AgentPresenceWatchdogAction Enumerate call",
+				AMTAgentPresenceWatchdogAction,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_AgentPresenceWatchdogAction Pull call",
+				AMTAgentPresenceWatchdogAction,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:             xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						WatchdogActionItems: []WatchdogActionResponse{watchdogActionResponse()},
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAgentPresenceWatchdogAction(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/agentpresence/watchdogaction",
+	}
+	elementUnderTest := NewAgentPresenceWatchdogActionWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_agentpresencewatchdogaction Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_AgentPresenceWatchdogAction Get call",
+				AMTAgentPresenceWatchdogAction,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"Name\">Intel(r) AMT Agent Presence Watchdog Action 0</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(watchdogActionName)
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdogAction Enumerate call",
+				AMTAgentPresenceWatchdogAction,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_AgentPresenceWatchdogAction Pull call",
+				AMTAgentPresenceWatchdogAction,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
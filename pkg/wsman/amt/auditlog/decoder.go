@@ -7,7 +7,6 @@ package auditlog
 
 import (
 	"bytes"
-	"encoding/base64"
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
@@ -19,9 +18,15 @@ import (
 )
 
 const (
-	AMTAuditLog   string = "AMT_AuditLog"
-	ReadRecords   string = "ReadRecords"
-	ValueNotFound string = "Value not found in map"
+	AMTAuditLog             string = "AMT_AuditLog"
+	ReadRecords             string = "ReadRecords"
+	RequestStateChange      string = "RequestStateChange"
+	ClearLog                string = "ClearLog"
+	ExportAuditLogSignature string = "ExportAuditLogSignature"
+	SetSigningKeyMaterial   string = "SetSigningKeyMaterial"
+	SetStoragePolicy        string = "SetStoragePolicy"
+	SetAuditLock            string = "SetAuditLock"
+	ValueNotFound           string = "Value not found in map"
 )
 
 const (
@@ -146,6 +151,79 @@ func (r StoragePolicy) String() string {
 	return ValueNotFound
 }
 
+const (
+	SigningMechanismSHA1RSA   SigningMechanism = 0
+	SigningMechanismSHA256RSA SigningMechanism = 1
+	SigningMechanismSHA384RSA SigningMechanism = 2
+)
+
+var SigningMechanismToString = map[SigningMechanism]string{
+	SigningMechanismSHA1RSA:   "SHA1RSA",
+	SigningMechanismSHA256RSA: "SHA256RSA",
+	SigningMechanismSHA384RSA: "SHA384RSA",
+}
+
+// String returns a string representation of a SigningMechanism.
+func (r SigningMechanism) String() string {
+	if value, exists := SigningMechanismToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	AuditLockFlagLock               AuditLockFlag = 0
+	AuditLockFlagUnlock             AuditLockFlag = 1
+	AuditLockFlagUnprovisioningLock AuditLockFlag = 2
+)
+
+var AuditLockFlagToString = map[AuditLockFlag]string{
+	AuditLockFlagLock:               "Lock",
+	AuditLockFlagUnlock:             "Unlock",
+	AuditLockFlagUnprovisioningLock: "UnprovisioningLock",
+}
+
+// String returns a string representation of a AuditLockFlag.
+func (r AuditLockFlag) String() string {
+	if value, exists := AuditLockFlagToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	ReturnValueSuccess          ReturnValue = 0
+	ReturnValueInternalError    ReturnValue = 1
+	ReturnValueNotReady         ReturnValue = 2
+	ReturnValueNotPermitted     ReturnValue = 16
+	ReturnValueInvalidIndex     ReturnValue = 35
+	ReturnValueInvalidParameter ReturnValue = 36
+	ReturnValueInvalidHandle    ReturnValue = 2053
+	ReturnValueAuditFail        ReturnValue = 2075
+)
+
+var ReturnValueToString = map[ReturnValue]string{
+	ReturnValueSuccess:          "Success",
+	ReturnValueInternalError:    "InternalError",
+	ReturnValueNotReady:         "NotReady",
+	ReturnValueNotPermitted:     "NotPermitted",
+	ReturnValueInvalidIndex:     "InvalidIndex",
+	ReturnValueInvalidParameter: "InvalidParameter",
+	ReturnValueInvalidHandle:    "InvalidHandle",
+	ReturnValueAuditFail:        "AuditFail",
+}
+
+// String returns a string representation of a ReturnValue.
+func (r ReturnValue) String() string {
+	if value, exists := ReturnValueToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
 var provisioningMethodToString = map[int]string{
 	2: "Remote Configuration",
 	3: "Manual Provisioning via MEBx",
@@ -320,6 +398,149 @@ var AMTAuditLogEventToString = map[int]string{
 	3302: "Watchdog Action Pairing Changed",
 }
 
+// Audit event codes, AppID*100 + EventID.
+const (
+	EventAMTProvisioningStarted                            EventCode = 1600
+	EventAMTProvisioningCompleted                          EventCode = 1601
+	EventACLEntryAdded                                     EventCode = 1602
+	EventACLEntryModified                                  EventCode = 1603
+	EventACLEntryRemoved                                   EventCode = 1604
+	EventACLAccessWithInvalidCredentials                   EventCode = 1605
+	EventACLEntryStateChanged                              EventCode = 1606
+	EventTLSStateChanged                                   EventCode = 1607
+	EventTLSServerCertificateSet                           EventCode = 1608
+	EventTLSServerCertificateRemoved                       EventCode = 1609
+	EventTLSTrustedRootCertificateAdded                    EventCode = 1610
+	EventTLSTrustedRootCertificateRemoved                  EventCode = 1611
+	EventTLSPreSharedKeySet                                EventCode = 1612
+	EventKerberosSettingsModified                          EventCode = 1613
+	EventKerberosMasterKeyOrPassphraseModified             EventCode = 1614
+	EventFlashWearOutCountersReset                         EventCode = 1615
+	EventPowerPackageModified                              EventCode = 1616
+	EventSetRealmAuthenticationMode                        EventCode = 1617
+	EventUpgradeClientToAdminControlMode                   EventCode = 1618
+	EventAMTUnProvisioningStarted                          EventCode = 1619
+	EventPerformedPowerUp                                  EventCode = 1700
+	EventPerformedPowerDown                                EventCode = 1701
+	EventPerformedPowerCycle                               EventCode = 1702
+	EventPerformedReset                                    EventCode = 1703
+	EventSetBootOptions                                    EventCode = 1704
+	EventPerformedGracefulPowerDown                        EventCode = 1705
+	EventPerformedGracefulPowerReset                       EventCode = 1706
+	EventPerformedStandby                                  EventCode = 1707
+	EventPerformedHibernate                                EventCode = 1708
+	EventPerformedNMI                                      EventCode = 1709
+	EventIDERSessionOpened                                 EventCode = 1800
+	EventIDERSessionClosed                                 EventCode = 1801
+	EventIDEREnabled                                       EventCode = 1802
+	EventIDERDisabled                                      EventCode = 1803
+	EventSoLSessionOpened                                  EventCode = 1804
+	EventSoLSessionClosed                                  EventCode = 1805
+	EventSoLEnabled                                        EventCode = 1806
+	EventSoLDisabled                                       EventCode = 1807
+	EventKVMSessionStarted                                 EventCode = 1808
+	EventKVMSessionEnded                                   EventCode = 1809
+	EventKVMEnabled                                        EventCode = 1810
+	EventKVMDisabled                                       EventCode = 1811
+	EventVNCPasswordFailed3Times                           EventCode = 1812
+	EventFirmwareUpdateStarted                             EventCode = 1900
+	EventFirmwareUpdateFailed                              EventCode = 1901
+	EventSecurityAuditLogCleared                           EventCode = 2000
+	EventSecurityAuditPolicyModified                       EventCode = 2001
+	EventSecurityAuditLogDisabled                          EventCode = 2002
+	EventSecurityAuditLogEnabled                           EventCode = 2003
+	EventSecurityAuditLogExported                          EventCode = 2004
+	EventSecurityAuditLogRecovered                         EventCode = 2005
+	EventAMTTimeSet                                        EventCode = 2100
+	EventTCPIPParametersSet                                EventCode = 2200
+	EventHostNameSet                                       EventCode = 2201
+	EventDomainNameSet                                     EventCode = 2202
+	EventVLANParametersSet                                 EventCode = 2203
+	EventLinkPolicySet                                     EventCode = 2204
+	EventIPv6ParametersSet                                 EventCode = 2205
+	EventGlobalStorageAttributesSet                        EventCode = 2300
+	EventStorageEACLModified                               EventCode = 2301
+	EventStorageFPACLModified                              EventCode = 2302
+	EventStorageWriteOperation                             EventCode = 2303
+	EventAlertSubscribed                                   EventCode = 2400
+	EventAlertUnsubscribed                                 EventCode = 2401
+	EventLogCleared                                        EventCode = 2402
+	EventLogFrozen                                         EventCode = 2403
+	EventSystemDefenseFilterAdded                          EventCode = 2500
+	EventSystemDefenseFilterRemoved                        EventCode = 2501
+	EventSystemDefensePolicyAdded                          EventCode = 2502
+	EventSystemDefensePolicyRemoved                        EventCode = 2503
+	EventSystemDefenseDefaultPolicySet                     EventCode = 2504
+	EventSystemDefenseHeuristicsOptionSet                  EventCode = 2505
+	EventSystemDefenseHeuristicsStateCleared               EventCode = 2506
+	EventAgentWatchdogAdded                                EventCode = 2600
+	EventAgentWatchdogRemoved                              EventCode = 2601
+	EventAgentWatchdogActionSet                            EventCode = 2602
+	EventWirelessProfileAdded                              EventCode = 2700
+	EventWirelessProfileRemoved                            EventCode = 2701
+	EventWirelessProfileUpdated                            EventCode = 2702
+	EventWirelessProfileModified                           EventCode = 2703
+	EventWirelessLinkPreferenceChanged                     EventCode = 2704
+	EventWirelessProfileShareWithUEFIEnabledSettingChanged EventCode = 2705
+	EventEACPostureSignerSet                               EventCode = 2800
+	EventEACEnabled                                        EventCode = 2801
+	EventEACDisabled                                       EventCode = 2802
+	EventEACPostureStateUpdated                            EventCode = 2803
+	EventEACSetOptions                                     EventCode = 2804
+	EventKVMOptInEnabled                                   EventCode = 2900
+	EventKVMOptInDisabled                                  EventCode = 2901
+	EventKVMPasswordChanged                                EventCode = 2902
+	EventKVMConsentSucceeded                               EventCode = 2903
+	EventKVMConsentFailed                                  EventCode = 2904
+	EventOptInPolicyChange                                 EventCode = 3000
+	EventSendConsentCode                                   EventCode = 3001
+	EventStartOptInBlocked                                 EventCode = 3002
+	EventWatchdogResetTriggeringOptionsChanged             EventCode = 3301
+	EventWatchdogActionPairingChanged                      EventCode = 3302
+)
+
+// String returns the name of the audit event.
+func (c EventCode) String() string {
+	if value, exists := AMTAuditLogEventToString[int(c)]; exists {
+		return value
+	}
+
+	return UnknownEventID
+}
+
+// String returns the name of the Intel® AMT application.
+func (a AppID) String() string {
+	if value, exists := AMTAppIDToString[int(a)]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	InitiatorTypeHTTPDigest     = InitiatorType(HTTPDigest)
+	InitiatorTypeKerberos       = InitiatorType(Kerberos)
+	InitiatorTypeLocal          = InitiatorType(Local)
+	InitiatorTypeKVMDefaultPort = InitiatorType(KvmDefaultPort)
+)
+
+// InitiatorTypeToString is a mapping of the InitiatorType value to a string.
+var InitiatorTypeToString = map[InitiatorType]string{
+	InitiatorTypeHTTPDigest:     "HTTP Digest",
+	InitiatorTypeKerberos:       "Kerberos",
+	InitiatorTypeLocal:          "Local",
+	InitiatorTypeKVMDefaultPort: "KVM Default Port",
+}
+
+// String returns a human-readable string representation of the InitiatorType enumeration.
+func (r InitiatorType) String() string {
+	if value, exists := InitiatorTypeToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
 var RealmNames = []string{
 	"Redirection",
 	"PT Administration",
@@ -347,52 +568,31 @@ var RealmNames = []string{
 	// Add more as needed
 }
 
+// convertToAuditLogResult decodes the records with DecodeEvent, newest first. Records that cannot be decoded are kept
+// with the raw base64 encoded record in Ex and the decoding error in ExStr.
 func convertToAuditLogResult(auditlogdata []string) []AuditLogRecord {
 	records := []AuditLogRecord{}
 
 	for _, eventRecord := range auditlogdata {
-		ptr := 0
-
-		decodedEventRecord, err := base64.StdEncoding.DecodeString(eventRecord)
+		event, err := DecodeEvent(eventRecord)
 		if err != nil {
+			records = append([]AuditLogRecord{{Ex: eventRecord, ExStr: err.Error()}}, records...)
+
 			continue
 		}
 
-		decodedEventRecordStr := string(decodedEventRecord)
-		auditLogRecord := AuditLogRecord{}
-
-		auditLogRecord.AuditAppID = common.ReadShort(decodedEventRecordStr, 0)
-		auditLogRecord.EventID = common.ReadShort(decodedEventRecordStr, 2)
-		auditLogRecord.AuditApp = AMTAppIDToString[auditLogRecord.AuditAppID]
-		auditLogRecord.Event = AMTAuditLogEventToString[(auditLogRecord.AuditAppID*100)+auditLogRecord.EventID]
-
-		initiatorType, initiator, pointer := getInitiatorInfo(decodedEventRecordStr)
-		auditLogRecord.InitiatorType = initiatorType
-		auditLogRecord.Initiator = initiator
-		ptr = pointer
-
-		// Read timestamp
-		timeStamp := common.ReadInt(decodedEventRecordStr, ptr)
-		auditLogRecord.Time = time.Unix(int64(timeStamp), 0)
-		ptr += 4
-
-		// Read network access
-
-		auditLogRecord.MCLocationType = []byte(decodedEventRecordStr[ptr : ptr+1])[0]
-		ptr++
-
-		netlen := []byte(decodedEventRecordStr[ptr : ptr+1])[0]
-		ptr++
-
-		auditLogRecord.NetAddress = strings.ReplaceAll(decodedEventRecordStr[ptr:ptr+int(netlen)], "0000:0000:0000:0000:0000:0000:0000:0001", "::1")
-
-		// Read extended data
-		ptr += int(netlen)
-
-		exlen := []byte(decodedEventRecordStr[ptr : ptr+1])[0]
-		ptr++
-
-		auditLogRecord.Ex = decodedEventRecordStr[ptr : ptr+int(exlen)]
+		auditLogRecord := AuditLogRecord{
+			AuditAppID:     int(event.AppID),
+			EventID:        int(event.EventID),
+			InitiatorType:  uint8(event.Initiator.Type),
+			AuditApp:       AMTAppIDToString[int(event.AppID)],
+			Event:          AMTAuditLogEventToString[int(event.Code())],
+			Initiator:      initiatorString(event.Initiator),
+			Time:           time.Unix(event.Time.Unix(), 0),
+			MCLocationType: event.MCLocationType,
+			NetAddress:     event.NetAddress,
+			Ex:             string(event.ExtendedData),
+		}
 		auditLogRecord.ExStr = GetAuditLogExtendedDataString(auditLogRecord.AuditAppID, auditLogRecord.EventID, auditLogRecord.Ex)
 
 		records = append([]AuditLogRecord{auditLogRecord}, records...)
@@ -401,6 +601,23 @@ func convertToAuditLogResult(auditlogdata []string) []AuditLogRecord {
 	return records
 }
 
+// initiatorString returns the initiator of AuditLogRecord: the user of HTTP digest initiators, the SID of Kerberos
+// initiators and the name of the other initiator types.
+func initiatorString(initiator Initiator) string {
+	switch initiator.Type {
+	case InitiatorTypeHTTPDigest:
+		return initiator.User
+	case InitiatorTypeKerberos:
+		return initiator.SID
+	case InitiatorTypeLocal:
+		return "Local"
+	case InitiatorTypeKVMDefaultPort:
+		return "KVM Default Port"
+	}
+
+	return ""
+}
+
 // Return human readable extended audit log data
 // TODO: Just put some of them here, but many more still need to be added, helpful link here:
 // https://software.intel.com/sites/manageability/AMT_Implementation_and_Reference_Guide/default.htm?turl=WordDocuments%2Fsecurityadminevents.htm
@@ -512,7 +729,7 @@ func parseSecurityAdminEvents(eventId int, data string) string {
 		}
 	case ACLAccessWithInvalidCredentials:
 		if len(data) > 0 {
-			extendedDataString = "User attempted to access " + []string{"Intel AMT", "MEBx"}[data[0]] + " with invalid credentials."
+			extendedDataString = "User attempted to access " + nameAt([]string{"Intel AMT", "MEBx"}, int(data[0])) + " with invalid credentials."
 		}
 	case ACLEntryEnabled:
 		extendedDataString = "ACL entry state was changed."
@@ -525,11 +742,11 @@ func parseSecurityAdminEvents(eventId int, data string) string {
 	case TLSStateChanged:
 		extendedDataString = "TLS state changed."
 
-		if len(byteData) > 0 {
+		if len(byteData) > 1 {
 			extendedDataString += "\nRemote: " +
-				[]string{"No Auth", "Server Auth", "Mutual Auth"}[byteData[0]] +
+				nameAt([]string{"No Auth", "Server Auth", "Mutual Auth"}, int(byteData[0])) +
 				"\nLocal: " +
-				[]string{"No Auth", "Server Auth", "Mutual Auth"}[byteData[1]]
+				nameAt([]string{"No Auth", "Server Auth", "Mutual Auth"}, int(byteData[1]))
 		}
 	case TLSServerCertificateSet:
 		extendedDataString = "TLS server certificate was defined."
@@ -576,9 +793,9 @@ func parseSecurityAdminEvents(eventId int, data string) string {
 	case SetRealmAuthenticationMode:
 		extendedDataString = "Realm authentication mode changed."
 
-		if len(data) > 0 {
+		if len(data) > 4 {
 			byteData := []byte(data)
-			extendedDataString += "\n" + RealmNames[byteData[0]] + ", " + []string{"NoAuth", "Auth", "Disabled"}[byteData[4]]
+			extendedDataString += "\n" + nameAt(RealmNames, int(byteData[0])) + ", " + nameAt([]string{"NoAuth", "Auth", "Disabled"}, int(byteData[4]))
 		}
 	case UpgradeClientToAdmin:
 		extendedDataString = "The control mode of the Intel AMT was changed from Client control to Admin control."
@@ -587,7 +804,7 @@ func parseSecurityAdminEvents(eventId int, data string) string {
 
 		if len(data) > 0 {
 			byteData := []byte(data)
-			extendedDataString += "\nInitiator: " + []string{"BIOS", "MEBx", "Local MEI", "Local WSMAN", "Remote WSMAN"}[byteData[0]]
+			extendedDataString += "\nInitiator: " + nameAt([]string{"BIOS", "MEBx", "Local MEI", "Local WSMAN", "Remote WSMAN"}, int(byteData[0]))
 		}
 	default:
 		extendedDataString = UnknownEventID
@@ -788,7 +1005,7 @@ func parseSecurityAuditLog(eventId int, data string) string {
 	case SecurityAuditLogRecovered:
 		if len(data) > 0 {
 			extendedDataString = "Internal check of audit log resulted in a recovery action.\nReason: " +
-				[]string{"Unknown", "Migration failure", "Initialization failure"}[data[0]]
+				nameAt([]string{"Unknown", "Migration failure", "Initialization failure"}, int(data[0]))
 		}
 	}
 
@@ -806,7 +1023,7 @@ func parseNetworkTimeEvents(eventId int, data string) string {
 	case IntelMETimeSet:
 		extendedDataString = "Command received to set Intel AMT local time."
 
-		if len(data) > 0 {
+		if len(data) >= 4 {
 			extendedDataString += "\nTime: " + time.Unix(int64(common.ReadInt(data, 0)), 0).String()
 		}
 	}
@@ -839,7 +1056,7 @@ func parseNetworkAdministrationEvents(eventId int, data string) string {
 
 		if len(data) > 0 {
 			event := readNetworkAdministrationEventData(TCPIPParametersSet, []byte(data))
-			extendedDataString += "\nDHCP Enabled: " + []string{"Disabled", "Enabled"}[event.DHCPEnabled] +
+			extendedDataString += "\nDHCP Enabled: " + nameAt([]string{"Disabled", "Enabled"}, int(event.DHCPEnabled)) +
 				"\nStatic IP: " + convertUINT32ToIPv4(event.IPV4Address).String() +
 				"\nSubnet Mask: " + convertUINT32ToIPv4(event.SubnetMask).String() +
 				"\nGateway: " + convertUINT32ToIPv4(event.Gateway).String()
@@ -882,7 +1099,7 @@ func parseNetworkAdministrationEvents(eventId int, data string) string {
 
 		if len(data) > 0 {
 			event := readNetworkAdministrationEventData(IPv6ParametersSet, []byte(data))
-			extendedDataString += "\nIPv6: " + []string{"Disabled", "Enabled"}[event.IPV6Enabled] +
+			extendedDataString += "\nIPv6: " + nameAt([]string{"Disabled", "Enabled"}, int(event.IPV6Enabled)) +
 				"\nInterface Gen Type: " + InterfaceIDGenType[int(event.InterfaceIDGenType)]
 
 			if event.InterfaceIDGenType == 2 {
@@ -966,7 +1183,7 @@ func parseEventManagerEvents(eventId int, data string) string {
 	case EventLogFrozen:
 		if len(data) > 0 {
 			event := readEventManagerEventData(EventLogFrozen, []byte(data))
-			extendedDataString = "Event log was " + []string{"unfrozen", "frozen"}[event.Freeze]
+			extendedDataString = "Event log was " + nameAt([]string{"unfrozen", "frozen"}, int(event.Freeze))
 		}
 	}
 
@@ -1134,7 +1351,7 @@ func parseWirelessConfigurationEvents(eventId int, data string) string {
 
 		if len(data) > 0 {
 			event := readWirelessConfigurationEventData(WirelessProfileModified, []byte(data))
-			extendedDataString += "\nProfile sync " + []string{"is disabled", "user", "admin", "is unrestricted"}[event.ProfileSync]
+			extendedDataString += "\nProfile sync " + nameAt([]string{"is disabled", "user", "admin", "is unrestricted"}, int(event.ProfileSync))
 		}
 	case WirelessLinkPreferenceChanged:
 		extendedDataString = "An existing profile link preference was changed."
@@ -1142,10 +1359,12 @@ func parseWirelessConfigurationEvents(eventId int, data string) string {
 		if len(data) > 0 {
 			event := readWirelessConfigurationEventData(WirelessLinkPreferenceChanged, []byte(data))
 			extendedDataString += "\nTimeout: " + fmt.Sprint(event.Timeout)
-			extendedDataString += "\nLink Preference: " + []string{"none", "ME", "Host"}[int(event.LinkPreference)]
+			extendedDataString += "\nLink Preference: " + nameAt([]string{"none", "ME", "Host"}, int(event.LinkPreference))
 		}
 	case WirelessProfileShareWithUEFIEnabledSettingChanged:
-		extendedDataString = fmt.Sprintf("Wireless profile share with UEFI was set to %s.", []string{"Disabled", "Enabled"}[data[0]])
+		if len(data) > 0 {
+			extendedDataString = fmt.Sprintf("Wireless profile share with UEFI was set to %s.", nameAt([]string{"Disabled", "Enabled"}, int(data[0])))
+		}
 	}
 
 	return extendedDataString
@@ -1421,7 +1640,7 @@ func aclEntryModifiedToString(entry *ACLEntry) string {
 }
 
 func aclEntryEnabledToString(entry *ACLEntry) string {
-	s := fmt.Sprintf("\nEntry State: %s\nInitiator Type: %s", []string{"Disabled", "Enabled"}[int(entry.EntryState)], initiatorTypeToString[int(entry.InitiatorType)])
+	s := fmt.Sprintf("\nEntry State: %s\nInitiator Type: %s", nameAt([]string{"Disabled", "Enabled"}, int(entry.EntryState)), initiatorTypeToString[int(entry.InitiatorType)])
 	if entry.UsernameLength == 0 {
 		s += fmt.Sprintf("\nSID: %d", entry.SID)
 		if entry.DomainLength > 0 {
@@ -1668,7 +1887,7 @@ func eventManagerEventDataToString(event EventManagerEvent) string {
 		s += "\nSubscription Alert Type: SNMP"
 	}
 
-	s += "\nIP Address Type: " + []string{"IPv4", "IPv6"}[event.IPAddrType] +
+	s += "\nIP Address Type: " + nameAt([]string{"IPv4", "IPv6"}, int(event.IPAddrType)) +
 		"\nAlert Target IP Address: " + net.IP(event.AlertTargetIPAddress).String()
 
 	return s
@@ -1758,38 +1977,18 @@ func readUserOptInEventData(id int, data []byte) UserOptInEvent {
 	return event
 }
 
+// nameAt returns the name at index, or ValueNotFound when the device reported a value outside of names.
+func nameAt(names []string, index int) string {
+	if index < 0 || index >= len(names) {
+		return ValueNotFound
+	}
+
+	return names[index]
+}
+
 func convertUINT32ToIPv4(intIP uint32) net.IP {
 	ip := make(net.IP, 4)
 	binary.BigEndian.PutUint32(ip, intIP)
 
 	return ip
 }
-
-// [initiatorType: number, initiator: string, ptr: number] need to type it.
-func getInitiatorInfo(decodedEventRecord string) (initatorType byte, initiator string, ptr int) {
-	var userlen uint8
-
-	initiatorType := []byte(decodedEventRecord[4:5])[0]
-
-	switch initiatorType {
-	case HTTPDigest:
-		userlen = []byte(decodedEventRecord[5:6])[0]
-		initiator = decodedEventRecord[6 : 6+userlen]
-		ptr = 6 + int(userlen)
-	case Kerberos:
-		userlen = []byte(decodedEventRecord[9:10])[0]
-		initiator = common.GetSidString(decodedEventRecord[10 : 10+userlen])
-		ptr = 10 + int(userlen)
-	case Local:
-		initiator = "Local"
-		ptr = 5
-	case KvmDefaultPort:
-		initiator = "KVM Default Port"
-		ptr = 5
-	default:
-		initiator = ""
-		ptr = 0
-	}
-
-	return initiatorType, initiator, ptr
-}
@@ -48,6 +48,63 @@ func TestStoragePolicy_String(t *testing.T) {
 	}
 }
 
+func TestSigningMechanism_String(t *testing.T) {
+	tests := []struct {
+		state    SigningMechanism
+		expected string
+	}{
+		{SigningMechanismSHA1RSA, "SHA1RSA"},
+		{SigningMechanismSHA256RSA, "SHA256RSA"},
+		{SigningMechanismSHA384RSA, "SHA384RSA"},
+		{SigningMechanism(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestAuditLockFlag_String(t *testing.T) {
+	tests := []struct {
+		state    AuditLockFlag
+		expected string
+	}{
+		{AuditLockFlagLock, "Lock"},
+		{AuditLockFlagUnlock, "Unlock"},
+		{AuditLockFlagUnprovisioningLock, "UnprovisioningLock"},
+		{AuditLockFlag(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestReturnValue_String(t *testing.T) {
+	tests := []struct {
+		state    ReturnValue
+		expected string
+	}{
+		{ReturnValueSuccess, "Success"},
+		{ReturnValueNotPermitted, "NotPermitted"},
+		{ReturnValueAuditFail, "AuditFail"},
+		{ReturnValue(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
 func TestEnabledState_String(t *testing.T) {
 	tests := []struct {
 		state    EnabledState
@@ -135,6 +192,8 @@ func TestGetAuditLogExtendedDataString(t *testing.T) {
 		{"Security Admin - ACL State change with data ", SecurityAdmin, 6, "\x01\x01\x04test", "ACL entry state was changed.\nEntry State: Enabled\nInitiator Type: User\nUsername: test"},
 		{"Security Admin - TLS State change without data", SecurityAdmin, 7, "", "TLS state changed."},
 		{"Security Admin - TLS State change with data", SecurityAdmin, 7, "\x01\x00", "TLS state changed.\nRemote: Server Auth\nLocal: No Auth"},
+		{"Security Admin - TLS State change with truncated data", SecurityAdmin, 7, "\x01", "TLS state changed."},
+		{"Security Admin - TLS State change with unknown state", SecurityAdmin, 7, "\x01\x07", "TLS state changed.\nRemote: Server Auth\nLocal: Value not found in map"},
 		{"Security Admin - TLS Server Certificate Set without data", SecurityAdmin, 8, "", "TLS server certificate was defined."},
 		{"Security Admin - TLS Server Certificate Set with data", SecurityAdmin, 8, "M\xf5\xa0`\xe1\xe1>p\xc0S_e\xf23\b%\xa2\x831\x93", "TLS server certificate was defined.\nCertificate serial number: 4df5a060e1e13e70c0535f65f2330825a2833193"},
 		{"Security Admin - TLS Server Certificate Removed without data", SecurityAdmin, 9, "", "TLS server certificate was removed."},
@@ -152,6 +211,8 @@ func TestGetAuditLogExtendedDataString(t *testing.T) {
 		{"Security Admin - Power Package Modified with data", SecurityAdmin, 16, "\x01", "Active power package was set.\nPower policy: 1"},
 		{"Security Admin - Set Realm Authentication Mode without data", SecurityAdmin, 17, "", "Realm authentication mode changed."},
 		{"Security Admin - Set Realm Authentication Mode with data", SecurityAdmin, 17, "\x01\x00\x00\x00\x01", "Realm authentication mode changed.\nPT Administration, Auth"},
+		{"Security Admin - Set Realm Authentication Mode with truncated data", SecurityAdmin, 17, "\x01", "Realm authentication mode changed."},
+		{"Security Admin - Set Realm Authentication Mode with unknown realm", SecurityAdmin, 17, "\xff\x00\x00\x00\x01", "Realm authentication mode changed.\nValue not found in map, Auth"},
 		{"Security Admin - Upgrade Client to Admin", SecurityAdmin, 18, "", "The control mode of the Intel AMT was changed from Client control to Admin control."},
 		{"Security Admin - AMT UnProvisioning Started - BIOS", SecurityAdmin, 19, "\x00", "Intel AMT UnProvisioned Started.\nInitiator: BIOS"},
 		{"Security Admin - AMT UnProvisioning Started - MEBx", SecurityAdmin, 19, "\x01", "Intel AMT UnProvisioned Started.\nInitiator: MEBx"},
@@ -193,7 +254,9 @@ func TestGetAuditLogExtendedDataString(t *testing.T) {
 		{"Security Audit Log - Log recovery - Unknown", SecurityAuditLog, 5, "\x00", "Internal check of audit log resulted in a recovery action.\nReason: Unknown"},
 		{"Security Audit Log - Log recovery - Migration failure", SecurityAuditLog, 5, "\x01", "Internal check of audit log resulted in a recovery action.\nReason: Migration failure"},
 		{"Security Audit Log - Log recovery - Initialization failure", SecurityAuditLog, 5, "\x02", "Internal check of audit log resulted in a recovery action.\nReason: Initialization failure"},
+		{"Security Audit Log - Log recovery - Unknown reason", SecurityAuditLog, 5, "\x07", "Internal check of audit log resulted in a recovery action.\nReason: Value not found in map"},
 		{"Network Time", NetworkTime, 0, "", "Command received to set Intel AMT local time."},
+		{"Network Time - Truncated time", NetworkTime, 0, "\x01", "Command received to set Intel AMT local time."},
 		{"Network Administration - TCP/IP Parameters Set", NetworkAdministration, 0, "\x00\x00\x00\x00\x01\x02\x00\xA8\xC0\x00\xFF\xFF\xFF\x01\x00\xA8\xC0\x04\x04\x04\x02\x02\x02\x02\x01", "TCP/IP parameters were set.\nDHCP Enabled: Enabled\nStatic IP: 192.168.0.2\nSubnet Mask: 255.255.255.0\nGateway: 192.168.0.1"},
 		{"Network Administration - Host Name Set", NetworkAdministration, 1, "\x04test", "Host name was set to test"},
 		{"Network Administration - Domain Name Set", NetworkAdministration, 2, "\x04test", "Domain name was set to test"},
@@ -234,6 +297,7 @@ func TestGetAuditLogExtendedDataString(t *testing.T) {
 		{"Wireless Configuration - Wireless Link Preference Changed", WirelessConfiguration, 4, "\u0003\u0000\u0000\u0000\u0002\u0000\u0000\u0000", "An existing profile link preference was changed.\nTimeout: 3\nLink Preference: Host"},
 		{"Wireless Configuration - Wireless Profile Share with UEFI Enabled Setting Changed", WirelessConfiguration, 5, "\u0001", "Wireless profile share with UEFI was set to Enabled."},
 		{"Wireless Configuration - Wireless Profile Share with UEFI Enabled Setting Changed", WirelessConfiguration, 5, "\u0000", "Wireless profile share with UEFI was set to Disabled."},
+		{"Wireless Configuration - Wireless Profile Share with UEFI Enabled Setting Changed without data", WirelessConfiguration, 5, "", ""},
 		{"Endpoint Access Control - EAC Posture Signer Set", EndpointAccessControl, 0, "", "A certificate handle for signing EAC postures was either set or removed."},
 		{"Endpoint Access Control - EAC Enabled", EndpointAccessControl, 1, "", "EAC was set to enabled by WS-MAN interface."},
 		{"Endpoint Access Control - EAC Disabled", EndpointAccessControl, 2, "", "EAC was set to disabled by WS-MAN interface."},
@@ -0,0 +1,321 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditlog
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/common"
+)
+
+// minimumSIDLength is the length of a SID without sub authorities.
+const minimumSIDLength = 8
+
+var ErrMalformedRecord = errors.New("malformed audit log record")
+
+// Events decodes the records returned by ReadRecords, in the order they were returned.
+func (r ReadRecords_OUTPUT) Events() ([]Event, error) {
+	return DecodeEvents(r.EventRecords)
+}
+
+// DecodeEvents decodes base64 encoded audit log records, keeping their order.
+func DecodeEvents(records []string) ([]Event, error) {
+	events := make([]Event, 0, len(records))
+
+	for i, record := range records {
+		event, err := DecodeEvent(record)
+		if err != nil {
+			return events, fmt.Errorf("record %d: %w", i, err)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// DecodeEvent decodes a base64 encoded audit log record as returned by ReadRecords.
+func DecodeEvent(record string) (Event, error) {
+	data, err := base64.StdEncoding.DecodeString(record)
+	if err != nil {
+		return Event{}, fmt.Errorf("%w: %s", ErrMalformedRecord, err.Error())
+	}
+
+	return decodeEvent(data)
+}
+
+// Code returns the code identifying the event across all applications.
+func (e Event) Code() EventCode {
+	return EventCode(int(e.AppID)*100 + int(e.EventID))
+}
+
+// MarshalJSON encodes the event with named enumerations and the payload type, so it can be ingested without parsing text.
+func (e Event) MarshalJSON() ([]byte, error) {
+	var payloadType string
+	if e.Payload != nil {
+		payloadType = reflect.TypeOf(e.Payload).Name()
+	}
+
+	return json.Marshal(struct {
+		AuditAppID     int         `json:"AuditAppId"`
+		AuditApp       string      `json:"AuditApp"`
+		EventID        int         `json:"EventId"`
+		Event          string      `json:"Event"`
+		InitiatorType  uint8       `json:"InitiatorType"`
+		Initiator      string      `json:"Initiator"`
+		User           string      `json:"User,omitempty"`
+		SID            string      `json:"SID,omitempty"`
+		Time           time.Time   `json:"Time"`
+		MCLocationType uint8       `json:"MCLocationType"`
+		NetAddress     string      `json:"NetAddress"`
+		ExtendedData   string      `json:"ExtendedData,omitempty"`
+		PayloadType    string      `json:"PayloadType,omitempty"`
+		Payload        interface{} `json:"Payload,omitempty"`
+	}{
+		AuditAppID:     int(e.AppID),
+		AuditApp:       e.AppID.String(),
+		EventID:        int(e.EventID),
+		Event:          e.Code().String(),
+		InitiatorType:  uint8(e.Initiator.Type),
+		Initiator:      e.Initiator.Type.String(),
+		User:           e.Initiator.User,
+		SID:            e.Initiator.SID,
+		Time:           e.Time,
+		MCLocationType: e.MCLocationType,
+		NetAddress:     e.NetAddress,
+		ExtendedData:   hex.EncodeToString(e.ExtendedData),
+		PayloadType:    payloadType,
+		Payload:        e.Payload,
+	})
+}
+
+func decodeEvent(data []byte) (event Event, err error) {
+	reader := bytes.NewReader(data)
+
+	header := make([]byte, 5)
+	if _, err = io.ReadFull(reader, header); err != nil {
+		return event, malformed(err)
+	}
+
+	event.AppID = AppID(binary.BigEndian.Uint16(header[0:2]))
+	event.EventID = EventID(binary.BigEndian.Uint16(header[2:4]))
+	event.Initiator.Type = InitiatorType(header[4])
+
+	switch event.Initiator.Type {
+	case InitiatorTypeHTTPDigest:
+		user, err := readLengthPrefixed(reader)
+		if err != nil {
+			return event, err
+		}
+
+		event.Initiator.User = string(user)
+	case InitiatorTypeKerberos:
+		// Skip the user in domain flag preceding the SID.
+		if _, err = reader.Seek(4, io.SeekCurrent); err != nil {
+			return event, malformed(err)
+		}
+
+		sid, err := readLengthPrefixed(reader)
+		if err != nil {
+			return event, err
+		}
+
+		if len(sid) < minimumSIDLength {
+			return event, fmt.Errorf("%w: SID is %d bytes", ErrMalformedRecord, len(sid))
+		}
+
+		event.Initiator.SID = common.GetSidString(string(sid))
+	case InitiatorTypeLocal, InitiatorTypeKVMDefaultPort:
+	default:
+		return event, fmt.Errorf("%w: unknown initiator type %d", ErrMalformedRecord, event.Initiator.Type)
+	}
+
+	var timestamp uint32
+	if err = binary.Read(reader, binary.BigEndian, &timestamp); err != nil {
+		return event, malformed(err)
+	}
+
+	event.Time = time.Unix(int64(timestamp), 0).UTC()
+
+	if event.MCLocationType, err = reader.ReadByte(); err != nil {
+		return event, malformed(err)
+	}
+
+	address, err := readLengthPrefixed(reader)
+	if err != nil {
+		return event, err
+	}
+
+	event.NetAddress = strings.ReplaceAll(string(address), "0000:0000:0000:0000:0000:0000:0000:0001", "::1")
+
+	if event.ExtendedData, err = readLengthPrefixed(reader); err != nil {
+		return event, err
+	}
+
+	event.Payload = decodePayload(event.AppID, int(event.EventID), event.ExtendedData)
+
+	return event, nil
+}
+
+func readLengthPrefixed(reader *bytes.Reader) ([]byte, error) {
+	length, err := reader.ReadByte()
+	if err != nil {
+		return nil, malformed(err)
+	}
+
+	value := make([]byte, length)
+	if _, err = io.ReadFull(reader, value); err != nil {
+		return nil, malformed(err)
+	}
+
+	return value, nil
+}
+
+func malformed(err error) error {
+	return fmt.Errorf("%w: %s", ErrMalformedRecord, err.Error())
+}
+
+// decodePayload returns the typed extended data of an event, or nil when the event has none or it is too short.
+func decodePayload(appID AppID, eventID int, data []byte) interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+
+	switch appID {
+	case SecurityAdmin:
+		return decodeSecurityAdminPayload(eventID, data)
+	case RemoteControl:
+		switch eventID {
+		case 0, 2, 3, 4, 6:
+			return readBootOptionsData(data)
+		}
+	case FirmwareUpdateManager:
+		buf := bytes.NewBuffer(data)
+
+		switch eventID {
+		case 0:
+			event := FirmwareUpdateEvent{}
+
+			readFWVersion(buf, &event.OldVersion)
+			readFWVersion(buf, &event.NewVersion)
+
+			return event
+		case 1:
+			failure := FWUpdateFailure{}
+
+			_ = binary.Read(buf, binary.LittleEndian, &failure.Type)
+			_ = binary.Read(buf, binary.LittleEndian, &failure.Reason)
+
+			return failure
+		}
+	case SecurityAuditLog:
+		if eventID == 5 {
+			return AuditLogRecoveredEvent{Reason: data[0]}
+		}
+	case NetworkTime:
+		if eventID == 0 && len(data) >= 4 {
+			return NetworkTimeEvent{Time: time.Unix(int64(binary.BigEndian.Uint32(data)), 0).UTC()}
+		}
+	case NetworkAdministration:
+		if eventID <= 5 {
+			return readNetworkAdministrationEventData(eventID, data)
+		}
+	case StorageAdministration:
+		if eventID == 0 {
+			event := StorageAdministrationEvent{}
+			buf := bytes.NewBuffer(data)
+
+			_ = binary.Read(buf, binary.LittleEndian, &event.MaxPartnerStorage)
+			_ = binary.Read(buf, binary.LittleEndian, &event.MaxNonPartnerTotalAllocationSize)
+
+			return event
+		}
+	case EventManager:
+		switch eventID {
+		case 0, 1, 3:
+			return readEventManagerEventData(eventID, data)
+		}
+	case SystemDefenseManager:
+		switch eventID {
+		case 1, 3, 4, 5, 6:
+			return readSystemDefenseManagerEventData(eventID, data)
+		}
+	case AgentPresenceManager:
+		if eventID <= 2 {
+			return readAgentPresenceManagerEventData(eventID, data)
+		}
+	case WirelessConfiguration:
+		if eventID == 5 {
+			return WirelessConfigurationEvent{ProfileSharingWithUEFI: data[0]}
+		}
+
+		if eventID <= 4 {
+			return readWirelessConfigurationEventData(eventID, data)
+		}
+	case EndpointAccessControl:
+		if eventID == 4 {
+			event := EACOptionsEvent{}
+
+			_ = binary.Read(bytes.NewBuffer(data), binary.LittleEndian, &event.Vendors)
+
+			return event
+		}
+	case UserOptIn:
+		if eventID <= 1 {
+			return readUserOptInEventData(eventID, data)
+		}
+	case Watchdog:
+		if eventID == 1 {
+			return WatchdogActionPairingEvent{OperationStatus: data[0]}
+		}
+	}
+
+	return nil
+}
+
+func decodeSecurityAdminPayload(eventID int, data []byte) interface{} {
+	switch eventID {
+	case 1:
+		return readProvisioningCompletedEventData(data)
+	case 2, 3, 4, 6:
+		return readACLData(eventID, data)
+	case 5:
+		return InvalidCredentialsEvent{Interface: data[0]}
+	case 7:
+		if len(data) >= 2 {
+			return TLSStateEvent{RemoteAuthentication: data[0], LocalAuthentication: data[1]}
+		}
+	case 8, 9, 10, 11:
+		return CertificateEvent{SerialNumber: hex.EncodeToString(data)}
+	case 13:
+		return KerberosSettingsEvent{TimeTolerance: data[0]}
+	case 16:
+		return PowerPackageEvent{PowerPolicy: data[0]}
+	case 17:
+		if len(data) >= 5 {
+			event := RealmAuthenticationModeEvent{Realm: binary.LittleEndian.Uint32(data), Mode: data[4]}
+			if int(event.Realm) < len(RealmNames) {
+				event.RealmName = RealmNames[event.Realm]
+			}
+
+			return event
+		}
+	case 19:
+		return UnprovisioningEvent{Initiator: data[0]}
+	}
+
+	return nil
+}
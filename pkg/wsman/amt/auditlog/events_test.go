@@ -0,0 +1,187 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditlog
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func encodeRecord(parts ...[]byte) string {
+	var record []byte
+
+	for _, part := range parts {
+		record = append(record, part...)
+	}
+
+	return base64.StdEncoding.EncodeToString(record)
+}
+
+// aclEntryAddedRecord is an ACL Entry Added event by the digest user admin from 127.0.0.1, adding user "user".
+var aclEntryAddedRecord = encodeRecord(
+	[]byte{0x00, 0x10, 0x00, 0x02, 0x00, 0x05}, []byte("admin"),
+	[]byte{0x65, 0x94, 0xAD, 0x0C, 0x00, 0x09}, []byte("127.0.0.1"),
+	[]byte{0x06, 0x01, 0x04}, []byte("user"),
+)
+
+// kvmSessionStartedRecord is a KVM Session Started event by the Kerberos user S-1-5-21.
+var kvmSessionStartedRecord = encodeRecord(
+	[]byte{0x00, 0x12, 0x00, 0x08, 0x01, 0x00, 0x00, 0x00, 0x00, 0x0C},
+	[]byte{0x01, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x15, 0x00, 0x00, 0x00},
+	[]byte{0x65, 0x94, 0xAD, 0x0C, 0x00, 0x00, 0x00},
+)
+
+func TestDecodeEvent(t *testing.T) {
+	t.Run("should decode a firmware update by a local initiator", func(t *testing.T) {
+		event, err := DecodeEvent("ABMAAAI/9M1uAgAQAAwAAAAoBZkADAAAACQFlA==")
+		assert.NoError(t, err)
+		assert.Equal(t, AppID(FirmwareUpdateManager), event.AppID)
+		assert.Equal(t, EventID(0), event.EventID)
+		assert.Equal(t, EventFirmwareUpdateStarted, event.Code())
+		assert.Equal(t, Initiator{Type: InitiatorTypeLocal}, event.Initiator)
+		assert.Equal(t, time.Date(2004, time.January, 2, 1, 46, 22, 0, time.UTC), event.Time)
+		assert.Equal(t, uint8(2), event.MCLocationType)
+		assert.Equal(t, FirmwareUpdateEvent{
+			OldVersion: FWVersion{Major: 3072, Minor: 0, Hotfix: 10240, Build: 39173},
+			NewVersion: FWVersion{Major: 3072, Minor: 0, Hotfix: 9216, Build: 37893},
+		}, event.Payload)
+	})
+
+	t.Run("should decode an ACL entry added by a digest user", func(t *testing.T) {
+		event, err := DecodeEvent(aclEntryAddedRecord)
+		assert.NoError(t, err)
+		assert.Equal(t, EventACLEntryAdded, event.Code())
+		assert.Equal(t, Initiator{Type: InitiatorTypeHTTPDigest, User: "admin"}, event.Initiator)
+		assert.Equal(t, "127.0.0.1", event.NetAddress)
+		assert.Equal(t, ACLEntry{InitiatorType: 1, UsernameLength: 4, Username: "user"}, event.Payload)
+	})
+
+	t.Run("should decode a KVM session started by a Kerberos user", func(t *testing.T) {
+		event, err := DecodeEvent(kvmSessionStartedRecord)
+		assert.NoError(t, err)
+		assert.Equal(t, EventKVMSessionStarted, event.Code())
+		assert.Equal(t, Initiator{Type: InitiatorTypeKerberos, SID: "S-1-5-21"}, event.Initiator)
+		assert.Nil(t, event.Payload)
+	})
+
+	t.Run("should return an error for truncated records", func(t *testing.T) {
+		record, _ := base64.StdEncoding.DecodeString(aclEntryAddedRecord)
+
+		for _, length := range []int{0, 4, 8, 14, 20, len(record) - 1} {
+			_, err := DecodeEvent(base64.StdEncoding.EncodeToString(record[:length]))
+			assert.ErrorIs(t, err, ErrMalformedRecord)
+		}
+	})
+
+	t.Run("should return an error for an unknown initiator type", func(t *testing.T) {
+		_, err := DecodeEvent(encodeRecord([]byte{0x00, 0x10, 0x00, 0x00, 0x09}))
+		assert.ErrorIs(t, err, ErrMalformedRecord)
+	})
+
+	t.Run("should return an error for invalid base64", func(t *testing.T) {
+		_, err := DecodeEvent("not base64")
+		assert.ErrorIs(t, err, ErrMalformedRecord)
+	})
+}
+
+func TestDecodeEvents(t *testing.T) {
+	output := ReadRecords_OUTPUT{EventRecords: []string{aclEntryAddedRecord, kvmSessionStartedRecord}}
+
+	events, err := output.Events()
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, EventACLEntryAdded, events[0].Code())
+	assert.Equal(t, EventKVMSessionStarted, events[1].Code())
+
+	events, err = DecodeEvents([]string{aclEntryAddedRecord, "AA=="})
+	assert.ErrorIs(t, err, ErrMalformedRecord)
+	assert.Len(t, events, 1)
+}
+
+func TestConvertToAuditLogResult(t *testing.T) {
+	truncated, _ := base64.StdEncoding.DecodeString(aclEntryAddedRecord)
+
+	records := convertToAuditLogResult([]string{
+		aclEntryAddedRecord,
+		base64.StdEncoding.EncodeToString(truncated[:14]),
+		"not base64",
+		kvmSessionStartedRecord,
+	})
+
+	assert.Len(t, records, 4)
+	assert.Equal(t, "KVM Session Started", records[0].Event)
+	assert.Equal(t, "S-1-5-21", records[0].Initiator)
+	assert.Equal(t, uint8(InitiatorTypeKerberos), records[0].InitiatorType)
+	assert.Equal(t, "not base64", records[1].Ex)
+	assert.Contains(t, records[1].ExStr, ErrMalformedRecord.Error())
+	assert.Empty(t, records[1].Event)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(truncated[:14]), records[2].Ex)
+	assert.Contains(t, records[2].ExStr, ErrMalformedRecord.Error())
+	assert.Equal(t, "Security Admin Events", records[3].AuditApp)
+	assert.Equal(t, "ACL Entry Added", records[3].Event)
+	assert.Equal(t, "admin", records[3].Initiator)
+	assert.Equal(t, "127.0.0.1", records[3].NetAddress)
+	assert.Equal(t, "\x01\x04user", records[3].Ex)
+}
+
+func TestDecodePayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		appID    AppID
+		eventID  int
+		data     []byte
+		expected interface{}
+	}{
+		{"TLS state changed", SecurityAdmin, 7, []byte{1, 2}, TLSStateEvent{RemoteAuthentication: 1, LocalAuthentication: 2}},
+		{"truncated TLS state changed", SecurityAdmin, 7, []byte{1}, nil},
+		{"TLS server certificate set", SecurityAdmin, 8, []byte{0xAB, 0xCD}, CertificateEvent{SerialNumber: "abcd"}},
+		{"realm authentication mode", SecurityAdmin, 17, []byte{3, 0, 0, 0, 1}, RealmAuthenticationModeEvent{Realm: 3, Mode: 1, RealmName: "Remote Control"}},
+		{"unprovisioning started", SecurityAdmin, 19, []byte{4}, UnprovisioningEvent{Initiator: 4}},
+		{"boot options set", RemoteControl, 4, []byte{1, 0, 0, 0, 0, 0, 0}, RemoteControlEvent{SpecialCommand: 1}},
+		{"audit log recovered", SecurityAuditLog, 5, []byte{2}, AuditLogRecoveredEvent{Reason: 2}},
+		{"time set", NetworkTime, 0, []byte{0x65, 0x94, 0xAD, 0x0C}, NetworkTimeEvent{Time: time.Unix(0x6594AD0C, 0).UTC()}},
+		{"host name set", NetworkAdministration, 1, []byte{4, 'h', 'o', 's', 't'}, NetworkAdministrationEvent{HostNameLength: 4, HostName: "host"}},
+		{"event log frozen", EventManager, 3, []byte{1}, EventManagerEvent{Freeze: 1}},
+		{"filter removed", SystemDefenseManager, 1, []byte{7, 0, 0, 0}, SystemDefenseManagerEvent{FilterHandle: 7}},
+		{"profile shared with UEFI", WirelessConfiguration, 5, []byte{1}, WirelessConfigurationEvent{ProfileSharingWithUEFI: 1}},
+		{"EAC options", EndpointAccessControl, 4, []byte{3, 0, 0, 0}, EACOptionsEvent{Vendors: 3}},
+		{"opt-in policy changed", UserOptIn, 0, []byte{1, 255}, UserOptInEvent{PreviousOptInPolicy: 1, CurrentOptInPolicy: 255}},
+		{"watchdog action pairing", Watchdog, 1, []byte{1}, WatchdogActionPairingEvent{OperationStatus: 1}},
+		{"event without payload", RedirectionManager, 0, []byte{1}, nil},
+		{"unknown application", 99, 0, []byte{1}, nil},
+		{"no extended data", SecurityAdmin, 19, nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, decodePayload(test.appID, test.eventID, test.data))
+		})
+	}
+}
+
+func TestEventMarshalJSON(t *testing.T) {
+	event, err := DecodeEvent(aclEntryAddedRecord)
+	assert.NoError(t, err)
+
+	result, err := json.Marshal(event)
+	assert.NoError(t, err)
+
+	expected := `{"AuditAppId":16,"AuditApp":"Security Admin Events","EventId":2,"Event":"ACL Entry Added","InitiatorType":0,"Initiator":"HTTP Digest","User":"admin","Time":"2024-01-03T00:40:44Z","MCLocationType":0,"NetAddress":"127.0.0.1","ExtendedData":"010475736572","PayloadType":"ACLEntry","Payload":{"ParameterModified":0,"AccessType":0,"EntryState":0,"InitiatorType":1,"UsernameLength":4,"SID":0,"Username":"user","DomainLength":0,"Domain":""}}`
+	assert.JSONEq(t, expected, string(result))
+}
+
+func TestEventEnumerations(t *testing.T) {
+	assert.Equal(t, "Watchdog Events", AppID(Watchdog).String())
+	assert.Equal(t, ValueNotFound, AppID(99).String())
+	assert.Equal(t, "Performed Power Up", EventPerformedPowerUp.String())
+	assert.Equal(t, UnknownEventID, EventCode(1).String())
+	assert.Equal(t, "Kerberos", InitiatorTypeKerberos.String())
+	assert.Equal(t, ValueNotFound, InitiatorType(9).String())
+}
@@ -3,11 +3,13 @@
  * SPDX-License-Identifier: Apache-2.0
  **********************************************************************/
 
-// Package auditlog facilitates communication with Intel® AMT devices to read the audit log records
+// Package auditlog facilitates communication with Intel® AMT devices to read and manage the audit log records
 package auditlog
 
 import (
+	"encoding/base64"
 	"encoding/xml"
+	"errors"
 
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
@@ -115,3 +117,190 @@ func (service Service) ReadRecords(startIndex int) (response Response, err error
 
 	return
 }
+
+// SetAuditLogState enables or disables the audit log. Valid values for requestedState are RequestedStateEnabled and RequestedStateDisabled.
+func (service Service) SetAuditLogState(requestedState RequestedState) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.RequestStateChange(methods.GenerateAction(AMTAuditLog, RequestStateChange), int(requestedState)),
+		},
+	}
+
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	if response.Body.RequestStateChangeResponse.ReturnValue != ReturnValueSuccess {
+		err = errors.New("SetAuditLogState failed with return code " + response.Body.RequestStateChangeResponse.ReturnValue.String())
+	}
+
+	return
+}
+
+// ClearLog deletes all the records of the audit log. The log must not be locked by another auditor.
+func (service Service) ClearLog() (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuditLog, ClearLog), AMTAuditLog, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(ClearLog), AMTAuditLog, nil)
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	if response.Body.ClearLogResponse.ReturnValue != ReturnValueSuccess {
+		err = errors.New("ClearLog failed with return code " + response.Body.ClearLogResponse.ReturnValue.String())
+	}
+
+	return
+}
+
+// ExportAuditLogSignature returns the signature of the current audit log records, created with the signing key material set by SetSigningKeyMaterial.
+// The records themselves are read with ReadRecords and must be read while the log is locked to match the signature.
+func (service Service) ExportAuditLogSignature(signingMechanism SigningMechanism) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuditLog, ExportAuditLogSignature), AMTAuditLog, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(ExportAuditLogSignature), AMTAuditLog, &ExportAuditLogSignature_INPUT{SigningMechanism: signingMechanism})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	if response.Body.ExportAuditLogSignatureResponse.ReturnValue != ReturnValueSuccess {
+		err = errors.New("ExportAuditLogSignature failed with return code " + response.Body.ExportAuditLogSignatureResponse.ReturnValue.String())
+	}
+
+	return
+}
+
+// SetSigningKeyMaterial sets the RSA key and certificate chain used to sign exported audit logs.
+// signingKey is the base64 encoded key, certificates the DER encoded certificate chain with the leaf certificate first.
+func (service Service) SetSigningKeyMaterial(signingMechanism SigningMechanism, signingKey string, certificates [][]byte) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuditLog, SetSigningKeyMaterial), AMTAuditLog, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetSigningKeyMaterial), AMTAuditLog, newSetSigningKeyMaterialInput(signingMechanism, signingKey, certificates))
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	if response.Body.SetSigningKeyMaterialResponse.ReturnValue != ReturnValueSuccess {
+		err = errors.New("SetSigningKeyMaterial failed with return code " + response.Body.SetSigningKeyMaterialResponse.ReturnValue.String())
+	}
+
+	return
+}
+
+// SetStoragePolicy sets the behavior of the audit log when it is full.
+// daysToKeep is the minimum number of days records are kept and is only used with StoragePolicyRestrictedRollOver.
+func (service Service) SetStoragePolicy(storagePolicy StoragePolicy, daysToKeep int) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuditLog, SetStoragePolicy), AMTAuditLog, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetStoragePolicy), AMTAuditLog, &SetStoragePolicy_INPUT{StoragePolicy: storagePolicy, DaysToKeep: daysToKeep})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	if response.Body.SetStoragePolicyResponse.ReturnValue != ReturnValueSuccess {
+		err = errors.New("SetStoragePolicy failed with return code " + response.Body.SetStoragePolicyResponse.ReturnValue.String())
+	}
+
+	return
+}
+
+// SetAuditLock locks the audit log for exclusive use by an auditor, or unlocks it.
+// The lock is released automatically after lockTimeoutInSeconds. handle is the value returned by the lock call and is required to unlock.
+func (service Service) SetAuditLock(lockTimeoutInSeconds int, flag AuditLockFlag, handle int) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuditLog, SetAuditLock), AMTAuditLog, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetAuditLock), AMTAuditLog, &SetAuditLock_INPUT{LockTimeoutInSeconds: lockTimeoutInSeconds, Flag: flag, Handle: handle})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	if response.Body.SetAuditLockResponse.ReturnValue != ReturnValueSuccess {
+		err = errors.New("SetAuditLock failed with return code " + response.Body.SetAuditLockResponse.ReturnValue.String())
+	}
+
+	return
+}
+
+func newSetSigningKeyMaterialInput(signingMechanism SigningMechanism, signingKey string, certificates [][]byte) *SetSigningKeyMaterial_INPUT {
+	input := &SetSigningKeyMaterial_INPUT{
+		SigningMechanismType: signingMechanism,
+		SigningKey:           signingKey,
+	}
+
+	var chain []byte
+
+	for _, certificate := range certificates {
+		input.LengthOfCertificates = append(input.LengthOfCertificates, len(certificate))
+		chain = append(chain, certificate...)
+	}
+
+	input.Certificates = base64.StdEncoding.EncodeToString(chain)
+
+	return input
+}
@@ -7,6 +7,7 @@ package auditlog
 
 import (
 	"encoding/xml"
+	"fmt"
 	"testing"
 	"time"
 
@@ -23,7 +24,7 @@ func TestJson(t *testing.T) {
 			GetResponse: AuditLog{},
 		},
 	}
-	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"OverwritePolicy\":0,\"CurrentNumberOfRecords\":0,\"MaxNumberOfRecords\":0,\"ElementName\":\"\",\"EnabledState\":0,\"RequestedState\":0,\"PercentageFree\":0,\"Name\":\"\",\"TimeOfLastRecord\":{\"Datetime\":\"\"},\"AuditState\":0,\"MaxAllowedAuditors\":0,\"StoragePolicy\":0,\"MinDaysToKeep\":0},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"AuditLogItems\":null},\"ReadRecordsResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"TotalRecordCount\":0,\"RecordsReturned\":0,\"EventRecords\":null,\"ReturnValue\":0},\"DecodedRecordsResponse\":null}"
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"OverwritePolicy\":0,\"CurrentNumberOfRecords\":0,\"MaxNumberOfRecords\":0,\"ElementName\":\"\",\"EnabledState\":0,\"RequestedState\":0,\"PercentageFree\":0,\"Name\":\"\",\"TimeOfLastRecord\":{\"Datetime\":\"\"},\"AuditState\":0,\"MaxAllowedAuditors\":0,\"StoragePolicy\":0,\"MinDaysToKeep\":0},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"AuditLogItems\":null},\"ReadRecordsResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"TotalRecordCount\":0,\"RecordsReturned\":0,\"EventRecords\":null,\"ReturnValue\":0},\"DecodedRecordsResponse\":null,\"RequestStateChangeResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"ClearLogResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"ExportAuditLogSignatureResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"TotalRecordCount\":0,\"StartLogTime\":{\"Datetime\":\"\"},\"EndLogTime\":{\"Datetime\":\"\"},\"UUID\":\"\",\"FQDN\":\"\",\"SignatureMechanism\":0,\"Signature\":\"\",\"LengthOfCertificates\":null,\"Certificates\":\"\",\"ReturnValue\":0},\"SetSigningKeyMaterialResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"SetStoragePolicyResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"SetAuditLockResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Handle\":0,\"ReturnValue\":0}}"
 	result := response.JSON()
 	assert.Equal(t, expectedResult, result)
 }
@@ -34,7 +35,7 @@ func TestYaml(t *testing.T) {
 			GetResponse: AuditLog{},
 		},
 	}
-	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\nenumerateresponse:\n    enumerationcontext: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    overwritepolicy: 0\n    currentnumberofrecords: 0\n    maxnumberofrecords: 0\n    elementname: \"\"\n    enabledstate: 0\n    requestedstate: 0\n    percentagefree: 0\n    name: \"\"\n    timeoflastrecord:\n        datetime: \"\"\n    auditstate: 0\n    maxallowedauditors: 0\n    storagepolicy: 0\n    mindaystokeep: 0\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    auditlogitems: []\nreadrecordsresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    totalrecordcount: 0\n    recordsreturned: 0\n    eventrecords: []\n    returnvalue: 0\ndecodedrecordsresponse: []\n"
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\nenumerateresponse:\n    enumerationcontext: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    overwritepolicy: 0\n    currentnumberofrecords: 0\n    maxnumberofrecords: 0\n    elementname: \"\"\n    enabledstate: 0\n    requestedstate: 0\n    percentagefree: 0\n    name: \"\"\n    timeoflastrecord:\n        datetime: \"\"\n    auditstate: 0\n    maxallowedauditors: 0\n    storagepolicy: 0\n    mindaystokeep: 0\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    auditlogitems: []\nreadrecordsresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    totalrecordcount: 0\n    recordsreturned: 0\n    eventrecords: []\n    returnvalue: 0\ndecodedrecordsresponse: []\nrequeststatechangeresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nclearlogresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nexportauditlogsignatureresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    totalrecordcount: 0\n    startlogtime:\n        datetime: \"\"\n    endlogtime:\n        datetime: \"\"\n    uuid: \"\"\n    fqdn: \"\"\n    signaturemechanism: 0\n    signature: \"\"\n    lengthofcertificates: []\n    certificates: \"\"\n    returnvalue: 0\nsetsigningkeymaterialresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nsetstoragepolicyresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nsetauditlockresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    handle: 0\n    returnvalue: 0\n"
 	result := response.YAML()
 	assert.Equal(t, expectedResult, result)
 }
@@ -194,6 +195,124 @@ func TestPositiveAMT_AuditLog(t *testing.T) {
 					},
 				},
 			},
+			{
+				"should create a valid AMT_AuditLog SetAuditLogState wsman message",
+				AMTAuditLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog/RequestStateChange`,
+				`<h:RequestStateChange_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"><h:RequestedState>3</h:RequestedState></h:RequestStateChange_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "RequestStateChange"
+
+					return elementUnderTest.SetAuditLogState(RequestedStateDisabled)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					RequestStateChangeResponse: RequestStateChange_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog", Local: "RequestStateChange_OUTPUT"},
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			{
+				"should create a valid AMT_AuditLog ClearLog wsman message",
+				AMTAuditLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog/ClearLog`,
+				`<h:ClearLog_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"></h:ClearLog_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "ClearLog"
+
+					return elementUnderTest.ClearLog()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ClearLogResponse: ClearLog_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog", Local: "ClearLog_OUTPUT"},
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			{
+				"should create a valid AMT_AuditLog ExportAuditLogSignature wsman message",
+				AMTAuditLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog/ExportAuditLogSignature`,
+				`<h:ExportAuditLogSignature_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"><h:SigningMechanism>1</h:SigningMechanism></h:ExportAuditLogSignature_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "ExportAuditLogSignature"
+
+					return elementUnderTest.ExportAuditLogSignature(SigningMechanismSHA256RSA)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ExportAuditLogSignatureResponse: ExportAuditLogSignature_OUTPUT{
+						XMLName:              xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog", Local: "ExportAuditLogSignature_OUTPUT"},
+						TotalRecordCount:     2,
+						StartLogTime:         Datetime{Datetime: "2024-01-03T00:40:12Z"},
+						EndLogTime:           Datetime{Datetime: "2024-01-03T00:44:35Z"},
+						UUID:                 "AAECAwQFBgcICQoLDA0ODw==",
+						FQDN:                 "amt.example.com",
+						SignatureMechanism:   SigningMechanismSHA256RSA,
+						Signature:            "c2lnbmF0dXJl",
+						LengthOfCertificates: []int{4},
+						Certificates:         "Y2VydA==",
+						ReturnValue:          ReturnValueSuccess,
+					},
+				},
+			},
+			{
+				"should create a valid AMT_AuditLog SetSigningKeyMaterial wsman message",
+				AMTAuditLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog/SetSigningKeyMaterial`,
+				`<h:SetSigningKeyMaterial_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"><h:SigningMechanismType>1</h:SigningMechanismType><h:SigningKey>a2V5</h:SigningKey><h:LengthOfCertificates>4</h:LengthOfCertificates><h:LengthOfCertificates>2</h:LengthOfCertificates><h:Certificates>Y2VydGNh</h:Certificates></h:SetSigningKeyMaterial_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "SetSigningKeyMaterial"
+
+					return elementUnderTest.SetSigningKeyMaterial(SigningMechanismSHA256RSA, "a2V5", [][]byte{[]byte("cert"), []byte("ca")})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetSigningKeyMaterialResponse: SetSigningKeyMaterial_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog", Local: "SetSigningKeyMaterial_OUTPUT"},
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			{
+				"should create a valid AMT_AuditLog SetStoragePolicy wsman message",
+				AMTAuditLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog/SetStoragePolicy`,
+				`<h:SetStoragePolicy_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"><h:StoragePolicy>2</h:StoragePolicy><h:DaysToKeep>30</h:DaysToKeep></h:SetStoragePolicy_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "SetStoragePolicy"
+
+					return elementUnderTest.SetStoragePolicy(StoragePolicyRestrictedRollOver, 30)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetStoragePolicyResponse: SetStoragePolicy_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog", Local: "SetStoragePolicy_OUTPUT"},
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			{
+				"should create a valid AMT_AuditLog SetAuditLock wsman message",
+				AMTAuditLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog/SetAuditLock`,
+				`<h:SetAuditLock_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"><h:LockTimeoutInSeconds>60</h:LockTimeoutInSeconds><h:Flag>0</h:Flag><h:Handle>0</h:Handle></h:SetAuditLock_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "SetAuditLock"
+
+					return elementUnderTest.SetAuditLock(60, AuditLockFlagLock, 0)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetAuditLockResponse: SetAuditLock_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog", Local: "SetAuditLock_OUTPUT"},
+						Handle:      3,
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
 		}
 
 		for _, test := range tests {
@@ -336,6 +455,124 @@ func TestNegativeAMT_AuditLog(t *testing.T) {
 					},
 				},
 			},
+			{
+				"should create a valid AMT_AuditLog SetAuditLogState wsman message",
+				AMTAuditLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog/RequestStateChange`,
+				`<h:RequestStateChange_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"><h:RequestedState>3</h:RequestedState></h:RequestStateChange_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.SetAuditLogState(RequestedStateDisabled)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					RequestStateChangeResponse: RequestStateChange_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog", Local: "RequestStateChange_OUTPUT"},
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			{
+				"should create a valid AMT_AuditLog ClearLog wsman message",
+				AMTAuditLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog/ClearLog`,
+				`<h:ClearLog_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"></h:ClearLog_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.ClearLog()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ClearLogResponse: ClearLog_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog", Local: "ClearLog_OUTPUT"},
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			{
+				"should create a valid AMT_AuditLog ExportAuditLogSignature wsman message",
+				AMTAuditLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog/ExportAuditLogSignature`,
+				`<h:ExportAuditLogSignature_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"><h:SigningMechanism>1</h:SigningMechanism></h:ExportAuditLogSignature_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.ExportAuditLogSignature(SigningMechanismSHA256RSA)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ExportAuditLogSignatureResponse: ExportAuditLogSignature_OUTPUT{
+						XMLName:              xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog", Local: "ExportAuditLogSignature_OUTPUT"},
+						TotalRecordCount:     2,
+						StartLogTime:         Datetime{Datetime: "2024-01-03T00:40:12Z"},
+						EndLogTime:           Datetime{Datetime: "2024-01-03T00:44:35Z"},
+						UUID:                 "AAECAwQFBgcICQoLDA0ODw==",
+						FQDN:                 "amt.example.com",
+						SignatureMechanism:   SigningMechanismSHA256RSA,
+						Signature:            "c2lnbmF0dXJl",
+						LengthOfCertificates: []int{4},
+						Certificates:         "Y2VydA==",
+						ReturnValue:          ReturnValueSuccess,
+					},
+				},
+			},
+			{
+				"should create a valid AMT_AuditLog SetSigningKeyMaterial wsman message",
+				AMTAuditLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog/SetSigningKeyMaterial`,
+				`<h:SetSigningKeyMaterial_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"><h:SigningMechanismType>1</h:SigningMechanismType><h:SigningKey>a2V5</h:SigningKey><h:LengthOfCertificates>4</h:LengthOfCertificates><h:LengthOfCertificates>2</h:LengthOfCertificates><h:Certificates>Y2VydGNh</h:Certificates></h:SetSigningKeyMaterial_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.SetSigningKeyMaterial(SigningMechanismSHA256RSA, "a2V5", [][]byte{[]byte("cert"), []byte("ca")})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetSigningKeyMaterialResponse: SetSigningKeyMaterial_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog", Local: "SetSigningKeyMaterial_OUTPUT"},
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			{
+				"should create a valid AMT_AuditLog SetStoragePolicy wsman message",
+				AMTAuditLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog/SetStoragePolicy`,
+				`<h:SetStoragePolicy_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"><h:StoragePolicy>2</h:StoragePolicy><h:DaysToKeep>30</h:DaysToKeep></h:SetStoragePolicy_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.SetStoragePolicy(StoragePolicyRestrictedRollOver, 30)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetStoragePolicyResponse: SetStoragePolicy_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog", Local: "SetStoragePolicy_OUTPUT"},
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			{
+				"should create a valid AMT_AuditLog SetAuditLock wsman message",
+				AMTAuditLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog/SetAuditLock`,
+				`<h:SetAuditLock_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"><h:LockTimeoutInSeconds>60</h:LockTimeoutInSeconds><h:Flag>0</h:Flag><h:Handle>0</h:Handle></h:SetAuditLock_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.SetAuditLock(60, AuditLockFlagLock, 0)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetAuditLockResponse: SetAuditLock_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog", Local: "SetAuditLock_OUTPUT"},
+						Handle:      3,
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
 		}
 
 		for _, test := range tests {
@@ -350,3 +587,41 @@ func TestNegativeAMT_AuditLog(t *testing.T) {
 		}
 	})
 }
+
+// failingClient answers every request with the given method output and ReturnValue.
+type failingClient struct {
+	wsmantesting.MockClient
+	output      string
+	returnValue ReturnValue
+}
+
+func (c *failingClient) Post(_ string) ([]byte, error) {
+	return []byte(fmt.Sprintf(`<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:g="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditLog"><a:Body><g:%[1]s><g:ReturnValue>%[2]d</g:ReturnValue></g:%[1]s></a:Body></a:Envelope>`, c.output, c.returnValue)), nil
+}
+
+func TestAMT_AuditLogReturnValue(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+
+	tests := []struct {
+		output   string
+		call     func(Service) (Response, error)
+		expected string
+	}{
+		{"RequestStateChange_OUTPUT", func(s Service) (Response, error) { return s.SetAuditLogState(RequestedStateEnabled) }, "SetAuditLogState failed with return code NotPermitted"},
+		{"ClearLog_OUTPUT", func(s Service) (Response, error) { return s.ClearLog() }, "ClearLog failed with return code NotPermitted"},
+		{"ExportAuditLogSignature_OUTPUT", func(s Service) (Response, error) { return s.ExportAuditLogSignature(SigningMechanismSHA256RSA) }, "ExportAuditLogSignature failed with return code NotPermitted"},
+		{"SetSigningKeyMaterial_OUTPUT", func(s Service) (Response, error) { return s.SetSigningKeyMaterial(SigningMechanismSHA256RSA, "", nil) }, "SetSigningKeyMaterial failed with return code NotPermitted"},
+		{"SetStoragePolicy_OUTPUT", func(s Service) (Response, error) { return s.SetStoragePolicy(StoragePolicyRollOver, 0) }, "SetStoragePolicy failed with return code NotPermitted"},
+		{"SetAuditLock_OUTPUT", func(s Service) (Response, error) { return s.SetAuditLock(60, AuditLockFlagLock, 0) }, "SetAuditLock failed with return code NotPermitted"},
+	}
+
+	for _, test := range tests {
+		t.Run("should return an error when "+test.output+" is not successful", func(t *testing.T) {
+			service := NewAuditLogWithClient(wsmanMessageCreator, &failingClient{output: test.output, returnValue: ReturnValueNotPermitted})
+
+			response, err := test.call(service)
+			assert.EqualError(t, err, test.expected)
+			assert.NotEmpty(t, response.XMLOutput)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditlog
+
+import "fmt"
+
+// RecordReader is the subset of Service used by ReadAllRecords.
+type RecordReader interface {
+	ReadRecords(startIndex int) (Response, error)
+}
+
+// ReadAllRecords calls ReadRecords until the end of the log and returns the base64 encoded records from startIndex to
+// the end of the log, oldest first. A startIndex past the end of the log, as returned for an empty or cleared log,
+// returns no records.
+func ReadAllRecords(reader RecordReader, startIndex int) (records []string, err error) {
+	for index := startIndex; ; {
+		response, err := reader.ReadRecords(index)
+		if err != nil {
+			return nil, err
+		}
+
+		output := response.Body.ReadRecordsResponse
+		returnValue := ReturnValue(output.ReturnValue)
+
+		if returnValue == ReturnValueInvalidIndex && index == startIndex {
+			return nil, nil
+		}
+
+		if returnValue != ReturnValueSuccess {
+			return nil, fmt.Errorf("audit log ReadRecords returned %s", returnValue)
+		}
+
+		records = append(records, output.EventRecords...)
+		index += len(output.EventRecords)
+
+		if len(output.EventRecords) == 0 || index > output.TotalRecordCount {
+			return records, nil
+		}
+	}
+}
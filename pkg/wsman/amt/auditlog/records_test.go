@@ -0,0 +1,86 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditlog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errReadRecords = errors.New("read records failed")
+
+// pagedRecordReader returns its records in pages of pageSize.
+type pagedRecordReader struct {
+	records     []string
+	pageSize    int
+	returnValue ReturnValue
+	err         error
+	reads       []int
+}
+
+func (p *pagedRecordReader) ReadRecords(startIndex int) (response Response, err error) {
+	p.reads = append(p.reads, startIndex)
+	output := &response.Body.ReadRecordsResponse
+	output.TotalRecordCount = len(p.records)
+	output.ReturnValue = int(p.returnValue)
+
+	if startIndex > len(p.records) {
+		output.ReturnValue = int(ReturnValueInvalidIndex)
+
+		return response, p.err
+	}
+
+	end := startIndex - 1 + p.pageSize
+	if end > len(p.records) {
+		end = len(p.records)
+	}
+
+	output.EventRecords = p.records[startIndex-1 : end]
+	output.RecordsReturned = len(output.EventRecords)
+
+	return response, p.err
+}
+
+func TestReadAllRecords(t *testing.T) {
+	records := []string{"a", "b", "c", "d", "e"}
+
+	t.Run("should read all pages", func(t *testing.T) {
+		reader := &pagedRecordReader{records: records, pageSize: 2}
+
+		result, err := ReadAllRecords(reader, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, records, result)
+		assert.Equal(t, []int{1, 3, 5}, reader.reads)
+	})
+
+	t.Run("should read from the start index", func(t *testing.T) {
+		result, err := ReadAllRecords(&pagedRecordReader{records: records, pageSize: 2}, 4)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"d", "e"}, result)
+	})
+
+	t.Run("should return no records for a start index past the end of the log", func(t *testing.T) {
+		result, err := ReadAllRecords(&pagedRecordReader{pageSize: 2}, 1)
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+
+		result, err = ReadAllRecords(&pagedRecordReader{records: records, pageSize: 2}, 6)
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("should return the error of ReadRecords", func(t *testing.T) {
+		_, err := ReadAllRecords(&pagedRecordReader{records: records, pageSize: 2, err: errReadRecords}, 1)
+		assert.ErrorIs(t, err, errReadRecords)
+	})
+
+	t.Run("should return an error for a failed ReadRecords", func(t *testing.T) {
+		_, err := ReadAllRecords(&pagedRecordReader{records: records, pageSize: 2, returnValue: ReturnValueNotPermitted}, 1)
+		assert.EqualError(t, err, "audit log ReadRecords returned NotPermitted")
+	})
+}
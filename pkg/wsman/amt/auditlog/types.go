@@ -26,6 +26,36 @@ type ReadRecordsInput struct {
 	StartIndex int      `xml:"h:StartIndex" json:"StartIndex"`
 }
 
+type ExportAuditLogSignature_INPUT struct {
+	XMLName          xml.Name         `xml:"h:ExportAuditLogSignature_INPUT"`
+	H                string           `xml:"xmlns:h,attr"`
+	SigningMechanism SigningMechanism `xml:"h:SigningMechanism"` // Signing mechanism used to sign the exported log.
+}
+
+type SetSigningKeyMaterial_INPUT struct {
+	XMLName              xml.Name         `xml:"h:SetSigningKeyMaterial_INPUT"`
+	H                    string           `xml:"xmlns:h,attr"`
+	SigningMechanismType SigningMechanism `xml:"h:SigningMechanismType"`   // Signing mechanism of the key material.
+	SigningKey           string           `xml:"h:SigningKey"`             // Base64 encoded RSA private key, in the format produced by the Intel® AMT SDK.
+	LengthOfCertificates []int            `xml:"h:LengthOfCertificates"`   // Length in bytes of each certificate in the Certificates field.
+	Certificates         string           `xml:"h:Certificates,omitempty"` // Base64 encoded concatenation of the DER encoded certificate chain, leaf certificate first.
+}
+
+type SetStoragePolicy_INPUT struct {
+	XMLName       xml.Name      `xml:"h:SetStoragePolicy_INPUT"`
+	H             string        `xml:"xmlns:h,attr"`
+	StoragePolicy StoragePolicy `xml:"h:StoragePolicy"`        // New storage policy of the log.
+	DaysToKeep    int           `xml:"h:DaysToKeep,omitempty"` // Minimum number of days records are kept, used with the RestrictedRollOver policy.
+}
+
+type SetAuditLock_INPUT struct {
+	XMLName              xml.Name      `xml:"h:SetAuditLock_INPUT"`
+	H                    string        `xml:"xmlns:h,attr"`
+	LockTimeoutInSeconds int           `xml:"h:LockTimeoutInSeconds"` // Time after which the lock is released automatically.
+	Flag                 AuditLockFlag `xml:"h:Flag"`                 // Whether to lock or unlock the log.
+	Handle               int           `xml:"h:Handle"`               // Handle of the lock, returned by a previous lock call and required to unlock.
+}
+
 // OUTPUTS
 // Response Types.
 type (
@@ -42,6 +72,13 @@ type (
 		PullResponse           PullResponse
 		ReadRecordsResponse    ReadRecords_OUTPUT
 		DecodedRecordsResponse []AuditLogRecord
+
+		RequestStateChangeResponse      RequestStateChange_OUTPUT
+		ClearLogResponse                ClearLog_OUTPUT
+		ExportAuditLogSignatureResponse ExportAuditLogSignature_OUTPUT
+		SetSigningKeyMaterialResponse   SetSigningKeyMaterial_OUTPUT
+		SetStoragePolicyResponse        SetStoragePolicy_OUTPUT
+		SetAuditLockResponse            SetAuditLock_OUTPUT
 	}
 	PullResponse struct {
 		XMLName       xml.Name   `xml:"PullResponse"`
@@ -77,6 +114,46 @@ type (
 		ReturnValue      int      `xml:"ReturnValue,omitempty"`      // ValueMap={0, 1, 2, 35} Values={PT_STATUS_SUCCESS, PT_STATUS_INTERNAL_ERROR, PT_STATUS_NOT_READY, PT_STATUS_INVALID_INDEX}
 	}
 
+	RequestStateChange_OUTPUT struct {
+		XMLName     xml.Name    `xml:"RequestStateChange_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+
+	ClearLog_OUTPUT struct {
+		XMLName     xml.Name    `xml:"ClearLog_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+
+	ExportAuditLogSignature_OUTPUT struct {
+		XMLName              xml.Name         `xml:"ExportAuditLogSignature_OUTPUT"`
+		TotalRecordCount     int              `xml:"TotalRecordCount,omitempty"`     // Number of records in the log at the time of the export.
+		StartLogTime         Datetime         `xml:"StartLogTime"`                   // Time stamp of the first record in the log.
+		EndLogTime           Datetime         `xml:"EndLogTime"`                     // Time stamp of the last record in the log.
+		UUID                 string           `xml:"UUID,omitempty"`                 // Base64 encoded UUID of the Intel® AMT device.
+		FQDN                 string           `xml:"FQDN,omitempty"`                 // FQDN of the Intel® AMT device.
+		SignatureMechanism   SigningMechanism `xml:"SignatureMechanism"`             // Signing mechanism used to create the signature.
+		Signature            string           `xml:"Signature,omitempty"`            // Base64 encoded signature over the log records, the UUID and the start and end log times.
+		LengthOfCertificates []int            `xml:"LengthOfCertificates,omitempty"` // Length in bytes of each certificate in the Certificates field.
+		Certificates         string           `xml:"Certificates,omitempty"`         // Base64 encoded concatenation of the DER encoded signing certificate chain.
+		ReturnValue          ReturnValue      `xml:"ReturnValue"`
+	}
+
+	SetSigningKeyMaterial_OUTPUT struct {
+		XMLName     xml.Name    `xml:"SetSigningKeyMaterial_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+
+	SetStoragePolicy_OUTPUT struct {
+		XMLName     xml.Name    `xml:"SetStoragePolicy_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+
+	SetAuditLock_OUTPUT struct {
+		XMLName     xml.Name    `xml:"SetAuditLock_OUTPUT"`
+		Handle      int         `xml:"Handle,omitempty"` // Handle of the lock, required to unlock the log.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+
 	AuditLogRecord struct {
 		AuditAppID     int       `json:"AuditAppId" binding:"required" example:"0"`
 		EventID        int       `json:"EventId" binding:"required" example:"0"`
@@ -97,6 +174,15 @@ type (
 	// StoragePolicy is an integer enumeration that indicates the storage policy of the log.
 	StoragePolicy int
 
+	// SigningMechanism is an integer enumeration that indicates the mechanism used to sign the exported log.
+	SigningMechanism int
+
+	// AuditLockFlag is an integer enumeration that indicates whether SetAuditLock locks or unlocks the log.
+	AuditLockFlag int
+
+	// ReturnValue is an integer enumeration that indicates the completion status of a method.
+	ReturnValue int
+
 	// EnabledState is an integer enumeration that indicates the enabled and disabled states of an element.
 	EnabledState int
 
@@ -215,4 +301,87 @@ type (
 		CurrentOptInPolicy  uint8
 		OperationStatus     uint8
 	}
+
+	InvalidCredentialsEvent struct {
+		Interface uint8 // 0 for Intel® AMT, 1 for MEBx.
+	}
+
+	TLSStateEvent struct {
+		RemoteAuthentication uint8 // 0 for no authentication, 1 for server authentication, 2 for mutual authentication.
+		LocalAuthentication  uint8
+	}
+
+	CertificateEvent struct {
+		SerialNumber string // Hex encoded serial number of the certificate.
+	}
+
+	KerberosSettingsEvent struct {
+		TimeTolerance uint8
+	}
+
+	PowerPackageEvent struct {
+		PowerPolicy uint8
+	}
+
+	RealmAuthenticationModeEvent struct {
+		Realm     uint32 // Index into RealmNames.
+		Mode      uint8  // 0 for no authentication, 1 for authentication, 2 for disabled.
+		RealmName string
+	}
+
+	UnprovisioningEvent struct {
+		Initiator uint8 // 0 for BIOS, 1 for MEBx, 2 for local MEI, 3 for local WS-MAN, 4 for remote WS-MAN.
+	}
+
+	FirmwareUpdateEvent struct {
+		OldVersion FWVersion
+		NewVersion FWVersion
+	}
+
+	AuditLogRecoveredEvent struct {
+		Reason uint8 // 0 for unknown, 1 for migration failure, 2 for initialization failure.
+	}
+
+	NetworkTimeEvent struct {
+		Time time.Time
+	}
+
+	EACOptionsEvent struct {
+		Vendors uint32
+	}
+
+	WatchdogActionPairingEvent struct {
+		OperationStatus uint8
+	}
+
+	// AppID identifies the Intel® AMT application that recorded an audit event.
+	AppID int
+
+	// EventID identifies an audit event within its application.
+	EventID int
+
+	// EventCode identifies an audit event across all applications, it is AppID*100 + EventID.
+	EventCode int
+
+	// InitiatorType is an enumeration that indicates how the initiator of an audit event was authenticated.
+	InitiatorType uint8
+
+	// Initiator is the user or interface that caused an audit event.
+	Initiator struct {
+		Type InitiatorType
+		User string // User name, set for HTTP digest initiators.
+		SID  string // User SID, set for Kerberos initiators.
+	}
+
+	// Event is a decoded audit log record.
+	Event struct {
+		AppID          AppID
+		EventID        EventID
+		Initiator      Initiator
+		Time           time.Time
+		MCLocationType uint8
+		NetAddress     string
+		ExtendedData   []byte      // Raw event specific data.
+		Payload        interface{} // Typed event specific data, such as ACLEntry or NetworkAdministrationEvent; nil when the event has none.
+	}
 )
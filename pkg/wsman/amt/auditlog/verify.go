@@ -0,0 +1,212 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditlog
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rsa"
+	_ "crypto/sha1"   // registers crypto.SHA1
+	_ "crypto/sha256" // registers crypto.SHA256
+	_ "crypto/sha512" // registers crypto.SHA384
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// uuidLength is the length in bytes of the device UUID in the signed data.
+const uuidLength = 16
+
+var (
+	ErrUnsupportedSigningMechanism = errors.New("unsupported audit log signing mechanism")
+	ErrRecordCountMismatch         = errors.New("number of records does not match the exported record count")
+	ErrInvalidExport               = errors.New("invalid audit log signature export")
+	ErrNoSigningCertificate        = errors.New("audit log signature export does not contain a signing certificate")
+	ErrSignatureMismatch           = errors.New("audit log signature does not match the records")
+	ErrNoTrustedRoots              = errors.New("trusted roots are required to verify an audit log signature")
+)
+
+// VerificationReport is the result of verifying an exported audit log signature. It only holds values covered by the
+// signature: the records, the UUID and the log times are signed, the signing mechanism is bound by the digest algorithm
+// of the PKCS #1 v1.5 signature and the record count is checked against the signed records. The FQDN of the export is
+// not signed and is therefore not part of the report.
+type VerificationReport struct {
+	Valid              bool                // Whether the signature matches the records and the certificate chain is trusted by the roots.
+	SignatureMechanism SigningMechanism    // Signing mechanism used by Intel® AMT.
+	RecordCount        int                 // Number of verified records.
+	StartLogTime       time.Time           // Time stamp of the first record in the log.
+	EndLogTime         time.Time           // Time stamp of the last record in the log.
+	UUID               []byte              // UUID of the Intel® AMT device.
+	Certificates       []*x509.Certificate // Signing certificate chain, leaf certificate first.
+	Err                error               // Reason the verification failed, nil when Valid.
+}
+
+// SignedData rebuilds the byte stream signed by Intel® AMT for an export: the raw audit records in the order returned by
+// ReadRecords, followed by the 16 byte device UUID and the start and end of the log as big endian 32-bit Unix times.
+// records are the base64 encoded EventRecords returned by ReadRecords.
+//
+// This layout follows the description of the Intel® AMT SDK and has not yet been checked against a signature exported by
+// a device. A wrong layout fails closed: Verify then reports ErrSignatureMismatch for genuine exports.
+func SignedData(records []string, export ExportAuditLogSignature_OUTPUT) ([]byte, error) {
+	var data bytes.Buffer
+
+	for _, record := range records {
+		raw, err := base64.StdEncoding.DecodeString(record)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidExport, err.Error())
+		}
+
+		data.Write(raw)
+	}
+
+	uuid, err := base64.StdEncoding.DecodeString(export.UUID)
+	if err != nil || len(uuid) != uuidLength {
+		return nil, fmt.Errorf("%w: UUID must be %d base64 encoded bytes", ErrInvalidExport, uuidLength)
+	}
+
+	data.Write(uuid)
+
+	for _, datetime := range []Datetime{export.StartLogTime, export.EndLogTime} {
+		timestamp, err := parseDatetime(datetime)
+		if err != nil {
+			return nil, err
+		}
+
+		_ = binary.Write(&data, binary.BigEndian, uint32(timestamp.Unix()))
+	}
+
+	return data.Bytes(), nil
+}
+
+// Verify checks the signature of an ExportAuditLogSignature result against the records read with ReadRecords while the log
+// was locked, and that the signing certificate chains up to one of roots. The export carries its own certificates, so a
+// signature is only meaningful together with a trusted chain and roots must not be nil.
+// The returned report describes the export and is Valid only when err is nil.
+func Verify(records []string, export ExportAuditLogSignature_OUTPUT, roots *x509.CertPool) (report VerificationReport, err error) {
+	report = VerificationReport{
+		SignatureMechanism: export.SignatureMechanism,
+		RecordCount:        len(records),
+	}
+
+	defer func() {
+		report.Valid = err == nil
+		report.Err = err
+	}()
+
+	report.UUID, _ = base64.StdEncoding.DecodeString(export.UUID)
+	report.StartLogTime, _ = parseDatetime(export.StartLogTime)
+	report.EndLogTime, _ = parseDatetime(export.EndLogTime)
+
+	if roots == nil {
+		return report, ErrNoTrustedRoots
+	}
+
+	if len(records) != export.TotalRecordCount {
+		return report, fmt.Errorf("%w: read %d, exported %d", ErrRecordCountMismatch, len(records), export.TotalRecordCount)
+	}
+
+	hash, err := signingHash(export.SignatureMechanism)
+	if err != nil {
+		return report, err
+	}
+
+	if report.Certificates, err = parseCertificates(export.Certificates, export.LengthOfCertificates); err != nil {
+		return report, err
+	}
+
+	publicKey, ok := report.Certificates[0].PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return report, fmt.Errorf("%w: signing certificate does not hold an RSA key", ErrInvalidExport)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(export.Signature)
+	if err != nil {
+		return report, fmt.Errorf("%w: %s", ErrInvalidExport, err.Error())
+	}
+
+	data, err := SignedData(records, export)
+	if err != nil {
+		return report, err
+	}
+
+	digest := hash.New()
+	digest.Write(data)
+
+	if err = rsa.VerifyPKCS1v15(publicKey, hash, digest.Sum(nil), signature); err != nil {
+		return report, ErrSignatureMismatch
+	}
+
+	return report, verifyChain(report.Certificates, roots)
+}
+
+func signingHash(mechanism SigningMechanism) (crypto.Hash, error) {
+	switch mechanism {
+	case SigningMechanismSHA1RSA:
+		return crypto.SHA1, nil
+	case SigningMechanismSHA256RSA:
+		return crypto.SHA256, nil
+	case SigningMechanismSHA384RSA:
+		return crypto.SHA384, nil
+	}
+
+	return 0, fmt.Errorf("%w: %d", ErrUnsupportedSigningMechanism, mechanism)
+}
+
+func parseCertificates(certificates string, lengths []int) ([]*x509.Certificate, error) {
+	if len(lengths) == 0 {
+		return nil, ErrNoSigningCertificate
+	}
+
+	chain, err := base64.StdEncoding.DecodeString(certificates)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExport, err.Error())
+	}
+
+	parsed := make([]*x509.Certificate, 0, len(lengths))
+
+	for _, length := range lengths {
+		if length <= 0 || length > len(chain) {
+			return nil, fmt.Errorf("%w: certificate lengths do not match the certificate chain", ErrInvalidExport)
+		}
+
+		certificate, err := x509.ParseCertificate(chain[:length])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidExport, err.Error())
+		}
+
+		parsed = append(parsed, certificate)
+		chain = chain[length:]
+	}
+
+	return parsed, nil
+}
+
+func verifyChain(certificates []*x509.Certificate, roots *x509.CertPool) error {
+	intermediates := x509.NewCertPool()
+	for _, certificate := range certificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+
+	_, err := certificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+
+	return err
+}
+
+func parseDatetime(datetime Datetime) (time.Time, error) {
+	timestamp, err := time.Parse(time.RFC3339, datetime.Datetime)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrInvalidExport, err.Error())
+	}
+
+	return timestamp, nil
+}
@@ -0,0 +1,258 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditlog
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testRecords = []string{"ABMAAAI/9M1uAgAQAAwAAAAoBZkADAAAACQFlA==", "ABMAAAI/9M1vAgAQAAwAAAAoBZkADAAAACQFlA=="}
+
+// testSignedData is the byte stream signed for testRecords and the export of newTestExport, written out independently
+// of SignedData: the raw records, the UUID, then the start and end log times as big endian Unix times.
+func testSignedData(t *testing.T) []byte {
+	t.Helper()
+
+	var data []byte
+
+	for _, record := range testRecords {
+		raw, err := base64.StdEncoding.DecodeString(record)
+		assert.NoError(t, err)
+
+		data = append(data, raw...)
+	}
+
+	data = append(data, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15)
+
+	return append(data, 0x65, 0x94, 0xAC, 0xEC, 0x65, 0x94, 0xAD, 0xF3)
+}
+
+func newTestCertificate(t *testing.T, template, parent *x509.Certificate, key, parentKey *rsa.PrivateKey) *x509.Certificate {
+	t.Helper()
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	assert.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	return certificate
+}
+
+func newTestChain(t *testing.T) (leafKey *rsa.PrivateKey, leaf, root *x509.Certificate) {
+	t.Helper()
+
+	rootKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	leafKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	rootTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Audit Root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	root = newTestCertificate(t, rootTemplate, rootTemplate, rootKey, rootKey)
+
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "Audit Signer"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	leaf = newTestCertificate(t, leafTemplate, root, leafKey, rootKey)
+
+	return leafKey, leaf, root
+}
+
+func newTestExport(t *testing.T, mechanism SigningMechanism, key *rsa.PrivateKey, chain ...*x509.Certificate) ExportAuditLogSignature_OUTPUT {
+	t.Helper()
+
+	export := ExportAuditLogSignature_OUTPUT{
+		TotalRecordCount:   len(testRecords),
+		StartLogTime:       Datetime{Datetime: "2024-01-03T00:40:12Z"},
+		EndLogTime:         Datetime{Datetime: "2024-01-03T00:44:35Z"},
+		UUID:               base64.StdEncoding.EncodeToString([]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}),
+		FQDN:               "amt.example.com",
+		SignatureMechanism: mechanism,
+	}
+
+	var certificates []byte
+
+	for _, certificate := range chain {
+		export.LengthOfCertificates = append(export.LengthOfCertificates, len(certificate.Raw))
+		certificates = append(certificates, certificate.Raw...)
+	}
+
+	export.Certificates = base64.StdEncoding.EncodeToString(certificates)
+
+	hash, err := signingHash(mechanism)
+	assert.NoError(t, err)
+
+	digest := hash.New()
+	digest.Write(testSignedData(t))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, hash, digest.Sum(nil))
+	assert.NoError(t, err)
+
+	export.Signature = base64.StdEncoding.EncodeToString(signature)
+
+	return export
+}
+
+func TestSignedData(t *testing.T) {
+	export := ExportAuditLogSignature_OUTPUT{
+		StartLogTime: Datetime{Datetime: "1970-01-01T00:00:01Z"},
+		EndLogTime:   Datetime{Datetime: "1970-01-01T00:01:00Z"},
+		UUID:         base64.StdEncoding.EncodeToString(make([]byte, 16)),
+	}
+
+	data, err := SignedData([]string{base64.StdEncoding.EncodeToString([]byte{0xAA, 0xBB})}, export)
+	assert.NoError(t, err)
+	assert.Equal(t, append(append([]byte{0xAA, 0xBB}, make([]byte, 16)...), 0, 0, 0, 1, 0, 0, 0, 60), data)
+
+	export.UUID = "AAEC"
+
+	_, err = SignedData(nil, export)
+	assert.ErrorIs(t, err, ErrInvalidExport)
+}
+
+func TestSignedDataMatchesExport(t *testing.T) {
+	key, leaf, _ := newTestChain(t)
+
+	data, err := SignedData(testRecords, newTestExport(t, SigningMechanismSHA256RSA, key, leaf))
+	assert.NoError(t, err)
+	assert.Equal(t, testSignedData(t), data)
+}
+
+func TestVerify(t *testing.T) {
+	key, leaf, root := newTestChain(t)
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+
+	for _, mechanism := range []SigningMechanism{SigningMechanismSHA1RSA, SigningMechanismSHA256RSA, SigningMechanismSHA384RSA} {
+		t.Run(mechanism.String(), func(t *testing.T) {
+			export := newTestExport(t, mechanism, key, leaf, root)
+
+			report, err := Verify(testRecords, export, roots)
+			assert.NoError(t, err)
+			assert.True(t, report.Valid)
+			assert.NoError(t, report.Err)
+			assert.Equal(t, mechanism, report.SignatureMechanism)
+			assert.Equal(t, 2, report.RecordCount)
+			assert.Equal(t, time.Date(2024, time.January, 3, 0, 40, 12, 0, time.UTC), report.StartLogTime)
+			assert.Equal(t, time.Date(2024, time.January, 3, 0, 44, 35, 0, time.UTC), report.EndLogTime)
+			assert.Len(t, report.UUID, 16)
+			assert.Equal(t, []*x509.Certificate{leaf, root}, report.Certificates)
+		})
+	}
+}
+
+func TestVerifyFailures(t *testing.T) {
+	key, leaf, root := newTestChain(t)
+	otherKey, otherLeaf, _ := newTestChain(t)
+	export := newTestExport(t, SigningMechanismSHA256RSA, key, leaf)
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+
+	t.Run("should require trusted roots", func(t *testing.T) {
+		report, err := Verify(testRecords, export, nil)
+		assert.ErrorIs(t, err, ErrNoTrustedRoots)
+		assert.False(t, report.Valid)
+	})
+
+	t.Run("should detect tampered records", func(t *testing.T) {
+		tampered := []string{testRecords[0], "ABMAAAI/9M1wAgAQAAwAAAAoBZkADAAAACQFlA=="}
+
+		report, err := Verify(tampered, export, roots)
+		assert.ErrorIs(t, err, ErrSignatureMismatch)
+		assert.False(t, report.Valid)
+		assert.ErrorIs(t, report.Err, ErrSignatureMismatch)
+	})
+
+	t.Run("should detect a changed time window", func(t *testing.T) {
+		changed := export
+		changed.EndLogTime = Datetime{Datetime: "2024-01-03T00:44:36Z"}
+
+		_, err := Verify(testRecords, changed, roots)
+		assert.ErrorIs(t, err, ErrSignatureMismatch)
+	})
+
+	t.Run("should not depend on the unsigned FQDN", func(t *testing.T) {
+		changed := export
+		changed.FQDN = "other.example.com"
+
+		report, err := Verify(testRecords, changed, roots)
+		assert.NoError(t, err)
+		assert.True(t, report.Valid)
+	})
+
+	t.Run("should detect missing records", func(t *testing.T) {
+		_, err := Verify(testRecords[:1], export, roots)
+		assert.ErrorIs(t, err, ErrRecordCountMismatch)
+	})
+
+	t.Run("should reject a signature from another key", func(t *testing.T) {
+		forged := newTestExport(t, SigningMechanismSHA256RSA, otherKey, leaf)
+
+		_, err := Verify(testRecords, forged, roots)
+		assert.ErrorIs(t, err, ErrSignatureMismatch)
+	})
+
+	t.Run("should reject an untrusted chain", func(t *testing.T) {
+		untrusted := newTestExport(t, SigningMechanismSHA256RSA, otherKey, otherLeaf)
+
+		report, err := Verify(testRecords, untrusted, roots)
+		assert.Error(t, err)
+		assert.False(t, report.Valid)
+	})
+
+	t.Run("should reject an unsupported signing mechanism", func(t *testing.T) {
+		unsupported := export
+		unsupported.SignatureMechanism = 7
+
+		_, err := Verify(testRecords, unsupported, roots)
+		assert.ErrorIs(t, err, ErrUnsupportedSigningMechanism)
+	})
+
+	t.Run("should reject an export without certificates", func(t *testing.T) {
+		unsigned := export
+		unsigned.LengthOfCertificates = nil
+
+		_, err := Verify(testRecords, unsigned, roots)
+		assert.ErrorIs(t, err, ErrNoSigningCertificate)
+	})
+
+	t.Run("should reject inconsistent certificate lengths", func(t *testing.T) {
+		inconsistent := export
+		inconsistent.LengthOfCertificates = []int{len(leaf.Raw) + 1}
+
+		_, err := Verify(testRecords, inconsistent, roots)
+		assert.ErrorIs(t, err, ErrInvalidExport)
+	})
+}
+
+func TestSigningHash(t *testing.T) {
+	hash, err := signingHash(SigningMechanismSHA384RSA)
+	assert.NoError(t, err)
+	assert.Equal(t, crypto.SHA384, hash)
+}
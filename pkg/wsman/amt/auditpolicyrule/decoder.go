@@ -0,0 +1,81 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditpolicyrule
+
+const (
+	AMTAuditPolicyRule string = "AMT_AuditPolicyRule"
+	SetAuditPolicy     string = "SetAuditPolicy"
+	SetAuditPolicyBulk string = "SetAuditPolicyBulk"
+	ValueNotFound      string = "Value not found in map"
+)
+
+const (
+	PolicyTypeNone     PolicyType = 0
+	PolicyTypeCritical PolicyType = 1
+)
+
+// policyTypeToString is a map of PolicyType value to string.
+var policyTypeToString = map[PolicyType]string{
+	PolicyTypeNone:     "None",
+	PolicyTypeCritical: "Critical",
+}
+
+// String returns the string representation of the PolicyType value.
+func (p PolicyType) String() string {
+	if value, exists := policyTypeToString[p]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	ReturnValueSuccess          ReturnValue = 0
+	ReturnValueInternalError    ReturnValue = 1
+	ReturnValueNotPermitted     ReturnValue = 16
+	ReturnValueInvalidParameter ReturnValue = 36
+	ReturnValueAuditFail        ReturnValue = 2075
+)
+
+// returnValueToString is a map of ReturnValue value to string.
+var returnValueToString = map[ReturnValue]string{
+	ReturnValueSuccess:          "Success",
+	ReturnValueInternalError:    "InternalError",
+	ReturnValueNotPermitted:     "NotPermitted",
+	ReturnValueInvalidParameter: "InvalidParameter",
+	ReturnValueAuditFail:        "AuditFail",
+}
+
+// String returns the string representation of the ReturnValue value.
+func (r ReturnValue) String() string {
+	if value, exists := returnValueToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+// Policies decodes the audited events of the rule, which are all enabled. Each AuditApplicationEventID holds the application ID in the upper
+// 16 bits and the event ID in the lower 16 bits; PolicyType holds the policy of the event at the same index.
+func (r AuditPolicyRuleResponse) Policies() []AuditPolicy {
+	policies := make([]AuditPolicy, 0, len(r.AuditApplicationEventID))
+
+	for i, id := range r.AuditApplicationEventID {
+		policy := AuditPolicy{
+			AuditedAppID: id >> 16,
+			EventID:      id & 0xFFFF,
+			Enabled:      true,
+		}
+
+		if i < len(r.PolicyType) {
+			policy.Critical = r.PolicyType[i] == PolicyTypeCritical
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies
+}
@@ -0,0 +1,63 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditpolicyrule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyType_String(t *testing.T) {
+	tests := []struct {
+		state    PolicyType
+		expected string
+	}{
+		{PolicyTypeNone, "None"},
+		{PolicyTypeCritical, "Critical"},
+		{PolicyType(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestReturnValue_String(t *testing.T) {
+	tests := []struct {
+		state    ReturnValue
+		expected string
+	}{
+		{ReturnValueSuccess, "Success"},
+		{ReturnValueInternalError, "InternalError"},
+		{ReturnValueNotPermitted, "NotPermitted"},
+		{ReturnValueInvalidParameter, "InvalidParameter"},
+		{ReturnValueAuditFail, "AuditFail"},
+		{ReturnValue(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestPolicies(t *testing.T) {
+	rule := AuditPolicyRuleResponse{
+		AuditApplicationEventID: []int{16<<16 | 1, 18<<16 | 2},
+		PolicyType:              []PolicyType{PolicyTypeCritical},
+	}
+
+	assert.Equal(t, []AuditPolicy{
+		{AuditedAppID: 16, EventID: 1, Enabled: true, Critical: true},
+		{AuditedAppID: 18, EventID: 2, Enabled: true, Critical: false},
+	}, rule.Policies())
+}
@@ -0,0 +1,166 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package auditpolicyrule facilitates communication with Intel® AMT devices to enable or disable the auditing of individual audit log events.
+package auditpolicyrule
+
+import (
+	"encoding/xml"
+	"errors"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewAuditPolicyRuleWithClient instantiates a new Service.
+func NewAuditPolicyRuleWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Service {
+	return Service{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTAuditPolicyRule, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (service Service) Get() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Get(nil),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (service Service) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (service Service) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// SetAuditPolicy enables or disables the auditing of a single event, and sets whether it is a critical event.
+// Any return code other than 0 indicates an error condition.
+func (service Service) SetAuditPolicy(policy AuditPolicy) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuditPolicyRule, SetAuditPolicy), AMTAuditPolicyRule, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetAuditPolicy), AMTAuditPolicyRule, &SetAuditPolicy_INPUT{
+		Enable:       policy.Enabled,
+		AuditedAppID: policy.AuditedAppID,
+		EventID:      policy.EventID,
+		PolicyType:   policyType(policy),
+	})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	if response.Body.SetAuditPolicy_OUTPUT.ReturnValue != ReturnValueSuccess {
+		err = errors.New("SetAuditPolicy failed with return code " + response.Body.SetAuditPolicy_OUTPUT.ReturnValue.String())
+	}
+
+	return
+}
+
+// SetAuditPolicyBulk enables or disables the auditing of several events in a single call.
+// Any return code other than 0 indicates an error condition.
+func (service Service) SetAuditPolicyBulk(policies []AuditPolicy) (response Response, err error) {
+	input := &SetAuditPolicyBulk_INPUT{}
+
+	for _, policy := range policies {
+		input.Enable = append(input.Enable, policy.Enabled)
+		input.AuditedAppID = append(input.AuditedAppID, policy.AuditedAppID)
+		input.EventID = append(input.EventID, policy.EventID)
+		input.PolicyType = append(input.PolicyType, policyType(policy))
+	}
+
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTAuditPolicyRule, SetAuditPolicyBulk), AMTAuditPolicyRule, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(SetAuditPolicyBulk), AMTAuditPolicyRule, input)
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	if response.Body.SetAuditPolicyBulk_OUTPUT.ReturnValue != ReturnValueSuccess {
+		err = errors.New("SetAuditPolicyBulk failed with return code " + response.Body.SetAuditPolicyBulk_OUTPUT.ReturnValue.String())
+	}
+
+	return
+}
+
+func policyType(policy AuditPolicy) PolicyType {
+	if policy.Critical {
+		return PolicyTypeCritical
+	}
+
+	return PolicyTypeNone
+}
@@ -0,0 +1,334 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditpolicyrule
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func TestJson(t *testing.T) {
+	response := Response{
+		Body: Body{
+			GetResponse: AuditPolicyRuleResponse{},
+		},
+	}
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"ElementName\":\"\",\"PolicyRuleName\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"AuditApplicationEventID\":null,\"PolicyType\":null},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"AuditPolicyRuleItems\":null},\"SetAuditPolicy_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"SetAuditPolicyBulk_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0}}"
+	result := response.JSON()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestYaml(t *testing.T) {
+	response := Response{
+		Body: Body{
+			GetResponse: AuditPolicyRuleResponse{},
+		},
+	}
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    elementname: \"\"\n    policyrulename: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    auditapplicationeventid: []\n    policytype: []\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    auditpolicyruleitems: []\nsetauditpolicy_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nsetauditpolicybulk_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\n"
+	result := response.YAML()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestPositiveAMT_AuditPolicyRule(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/auditpolicyrule",
+	}
+	elementUnderTest := NewAuditPolicyRuleWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_AuditPolicyRule Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid AMT_AuditPolicyRule Get wsman message",
+				AMTAuditPolicyRule,
+				wsmantesting.Get,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetResponse: AuditPolicyRuleResponse{
+						XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAuditPolicyRule), Local: AMTAuditPolicyRule},
+						CreationClassName:       AMTAuditPolicyRule,
+						ElementName:             "Intel(r) AMT Audit Policy Rule",
+						PolicyRuleName:          "Audit Policy Rule",
+						SystemCreationClassName: "CIM_ComputerSystem",
+						SystemName:              "Intel(r) AMT",
+						AuditApplicationEventID: []int{1048576, 1179648},
+						PolicyType:              []PolicyType{PolicyTypeCritical, PolicyTypeNone},
+					},
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid AMT_AuditPolicyRule Enumerate wsman message",
+				AMTAuditPolicyRule,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "D3000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid AMT_AuditPolicyRule Pull wsman message",
+				AMTAuditPolicyRule,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						AuditPolicyRuleItems: []AuditPolicyRuleResponse{
+							{
+								XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAuditPolicyRule), Local: AMTAuditPolicyRule},
+								CreationClassName:       AMTAuditPolicyRule,
+								ElementName:             "Intel(r) AMT Audit Policy Rule",
+								PolicyRuleName:          "Audit Policy Rule",
+								SystemCreationClassName: "CIM_ComputerSystem",
+								SystemName:              "Intel(r) AMT",
+								AuditApplicationEventID: []int{1048576, 1179648},
+								PolicyType:              []PolicyType{PolicyTypeCritical, PolicyTypeNone},
+							},
+						},
+					},
+				},
+			},
+			// SET AUDIT POLICY
+			{
+				"should create a valid AMT_AuditPolicyRule SetAuditPolicy wsman message",
+				AMTAuditPolicyRule,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTAuditPolicyRule, SetAuditPolicy),
+				"<h:SetAuditPolicy_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditPolicyRule\"><h:Enable>true</h:Enable><h:AuditedAppID>18</h:AuditedAppID><h:EventID>0</h:EventID><h:PolicyType>1</h:PolicyType></h:SetAuditPolicy_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = SetAuditPolicy
+
+					return elementUnderTest.SetAuditPolicy(AuditPolicy{AuditedAppID: 18, EventID: 0, Enabled: true, Critical: true})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetAuditPolicy_OUTPUT: SetAuditPolicy_OUTPUT{
+						XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAuditPolicyRule), Local: "SetAuditPolicy_OUTPUT"},
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			// SET AUDIT POLICY BULK
+			{
+				"should create a valid AMT_AuditPolicyRule SetAuditPolicyBulk wsman message",
+				AMTAuditPolicyRule,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTAuditPolicyRule, SetAuditPolicyBulk),
+				"<h:SetAuditPolicyBulk_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditPolicyRule\"><h:Enable>true</h:Enable><h:Enable>false</h:Enable><h:AuditedAppID>16</h:AuditedAppID><h:AuditedAppID>18</h:AuditedAppID><h:EventID>0</h:EventID><h:EventID>1</h:EventID><h:PolicyType>1</h:PolicyType><h:PolicyType>0</h:PolicyType></h:SetAuditPolicyBulk_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = SetAuditPolicyBulk
+
+					return elementUnderTest.SetAuditPolicyBulk([]AuditPolicy{
+						{AuditedAppID: 16, EventID: 0, Enabled: true, Critical: true},
+						{AuditedAppID: 18, EventID: 1, Enabled: false},
+					})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetAuditPolicyBulk_OUTPUT: SetAuditPolicyBulk_OUTPUT{
+						XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAuditPolicyRule), Local: "SetAuditPolicyBulk_OUTPUT"},
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, "", test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_AuditPolicyRule(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/auditpolicyrule",
+	}
+	elementUnderTest := NewAuditPolicyRuleWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_AuditPolicyRule Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid AMT_AuditPolicyRule Get wsman message",
+				AMTAuditPolicyRule,
+				wsmantesting.Get,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetResponse: AuditPolicyRuleResponse{
+						XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAuditPolicyRule), Local: AMTAuditPolicyRule},
+						CreationClassName:       AMTAuditPolicyRule,
+						ElementName:             "Intel(r) AMT Audit Policy Rule",
+						PolicyRuleName:          "Audit Policy Rule",
+						SystemCreationClassName: "CIM_ComputerSystem",
+						SystemName:              "Intel(r) AMT",
+						AuditApplicationEventID: []int{1048576, 1179648},
+						PolicyType:              []PolicyType{PolicyTypeCritical, PolicyTypeNone},
+					},
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid AMT_AuditPolicyRule Enumerate wsman message",
+				AMTAuditPolicyRule,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "D3000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid AMT_AuditPolicyRule Pull wsman message",
+				AMTAuditPolicyRule,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						AuditPolicyRuleItems: []AuditPolicyRuleResponse{
+							{
+								XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAuditPolicyRule), Local: AMTAuditPolicyRule},
+								CreationClassName:       AMTAuditPolicyRule,
+								ElementName:             "Intel(r) AMT Audit Policy Rule",
+								PolicyRuleName:          "Audit Policy Rule",
+								SystemCreationClassName: "CIM_ComputerSystem",
+								SystemName:              "Intel(r) AMT",
+								AuditApplicationEventID: []int{1048576, 1179648},
+								PolicyType:              []PolicyType{PolicyTypeCritical, PolicyTypeNone},
+							},
+						},
+					},
+				},
+			},
+			// SET AUDIT POLICY
+			{
+				"should create a valid AMT_AuditPolicyRule SetAuditPolicy wsman message",
+				AMTAuditPolicyRule,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTAuditPolicyRule, SetAuditPolicy),
+				"<h:SetAuditPolicy_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditPolicyRule\"><h:Enable>true</h:Enable><h:AuditedAppID>18</h:AuditedAppID><h:EventID>0</h:EventID><h:PolicyType>1</h:PolicyType></h:SetAuditPolicy_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.SetAuditPolicy(AuditPolicy{AuditedAppID: 18, EventID: 0, Enabled: true, Critical: true})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetAuditPolicy_OUTPUT: SetAuditPolicy_OUTPUT{
+						XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAuditPolicyRule), Local: "SetAuditPolicy_OUTPUT"},
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+			// SET AUDIT POLICY BULK
+			{
+				"should create a valid AMT_AuditPolicyRule SetAuditPolicyBulk wsman message",
+				AMTAuditPolicyRule,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTAuditPolicyRule, SetAuditPolicyBulk),
+				"<h:SetAuditPolicyBulk_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_AuditPolicyRule\"><h:Enable>true</h:Enable><h:Enable>false</h:Enable><h:AuditedAppID>16</h:AuditedAppID><h:AuditedAppID>18</h:AuditedAppID><h:EventID>0</h:EventID><h:EventID>1</h:EventID><h:PolicyType>1</h:PolicyType><h:PolicyType>0</h:PolicyType></h:SetAuditPolicyBulk_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.SetAuditPolicyBulk([]AuditPolicy{
+						{AuditedAppID: 16, EventID: 0, Enabled: true, Critical: true},
+						{AuditedAppID: 18, EventID: 1, Enabled: false},
+					})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SetAuditPolicyBulk_OUTPUT: SetAuditPolicyBulk_OUTPUT{
+						XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTAuditPolicyRule), Local: "SetAuditPolicyBulk_OUTPUT"},
+						ReturnValue: ReturnValueSuccess,
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, "", test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.NotEqual(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
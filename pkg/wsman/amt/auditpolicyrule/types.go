@@ -0,0 +1,98 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package auditpolicyrule
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type Service struct {
+	base message.Base
+}
+
+// PolicyType indicates how Intel® AMT treats an audited event. When recording a critical event fails, the operation that generated it fails as well.
+//
+// ValueMap={0, 1}
+//
+// Values={None, Critical}.
+type PolicyType int
+
+// ReturnValue is an integer enumeration that indicates the completion status of the method.
+type ReturnValue int
+
+// AuditPolicy is the audit policy of a single application and event ID.
+type AuditPolicy struct {
+	AuditedAppID int  // ID of the application that generates the event, such as 16 for Security Admin.
+	EventID      int  // ID of the event within the application.
+	Enabled      bool // Whether the event is audited.
+	Critical     bool // Whether the event is a critical event.
+}
+
+// OUTPUTS
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName                   xml.Name `xml:"Body"`
+		GetResponse               AuditPolicyRuleResponse
+		EnumerateResponse         common.EnumerateResponse
+		PullResponse              PullResponse
+		SetAuditPolicy_OUTPUT     SetAuditPolicy_OUTPUT     `xml:"SetAuditPolicy_OUTPUT"`
+		SetAuditPolicyBulk_OUTPUT SetAuditPolicyBulk_OUTPUT `xml:"SetAuditPolicyBulk_OUTPUT"`
+	}
+	AuditPolicyRuleResponse struct {
+		XMLName                 xml.Name     `xml:"AMT_AuditPolicyRule"`
+		CreationClassName       string       `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		ElementName             string       `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		PolicyRuleName          string       `xml:"PolicyRuleName,omitempty"`          // A user-friendly name of this PolicyRule.
+		SystemCreationClassName string       `xml:"SystemCreationClassName,omitempty"` // The scoping System's CreationClassName.
+		SystemName              string       `xml:"SystemName,omitempty"`              // The scoping System's Name.
+		AuditApplicationEventID []int        `xml:"AuditApplicationEventID,omitempty"` // The audited events. The upper 16 bits hold the application ID and the lower 16 bits the event ID.
+		PolicyType              []PolicyType `xml:"PolicyType,omitempty"`              // The policy of the event at the same index in AuditApplicationEventID.
+	}
+	PullResponse struct {
+		XMLName              xml.Name                  `xml:"PullResponse"`
+		AuditPolicyRuleItems []AuditPolicyRuleResponse `xml:"Items>AMT_AuditPolicyRule"`
+	}
+	SetAuditPolicy_OUTPUT struct {
+		XMLName     xml.Name    `xml:"SetAuditPolicy_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	SetAuditPolicyBulk_OUTPUT struct {
+		XMLName     xml.Name    `xml:"SetAuditPolicyBulk_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+)
+
+// INPUTS
+// Request Types.
+type (
+	SetAuditPolicy_INPUT struct {
+		XMLName      xml.Name   `xml:"h:SetAuditPolicy_INPUT"`
+		H            string     `xml:"xmlns:h,attr"`
+		Enable       bool       `xml:"h:Enable"`       // Whether auditing of the event is enabled.
+		AuditedAppID int        `xml:"h:AuditedAppID"` // ID of the application that generates the event.
+		EventID      int        `xml:"h:EventID"`      // ID of the event within the application.
+		PolicyType   PolicyType `xml:"h:PolicyType"`   // Policy of the event.
+	}
+	SetAuditPolicyBulk_INPUT struct {
+		XMLName      xml.Name     `xml:"h:SetAuditPolicyBulk_INPUT"`
+		H            string       `xml:"xmlns:h,attr"`
+		Enable       []bool       `xml:"h:Enable"`       // Whether auditing of each event is enabled.
+		AuditedAppID []int        `xml:"h:AuditedAppID"` // ID of the application that generates each event.
+		EventID      []int        `xml:"h:EventID"`      // ID of each event within its application.
+		PolicyType   []PolicyType `xml:"h:PolicyType"`   // Policy of each event.
+	}
+)
@@ -55,3 +55,93 @@ func (i IDERBootDevice) String() string {
 
 	return ValueNotFound
 }
+
+// UEFIBootParameterVendorIntel is the vendor of the UEFI boot parameters defined by Intel.
+const UEFIBootParameterVendorIntel uint16 = 0x8086
+
+const (
+	OCREFINetworkDevicePath UEFIBootParameterType = 1  // The URL of the UEFI HTTPS boot image, as ASCII.
+	OCREFIFileDevicePath    UEFIBootParameterType = 2  // The EFI device path of the file of a local boot option.
+	OCREFIDevicePathLength  UEFIBootParameterType = 3  // The length of the EFI device path, as a 16-bit integer.
+	OCRHTTPSCertSyncRootCA  UEFIBootParameterType = 20 // Whether the BIOS verifies the HTTPS server with the root certificates of Intel AMT, as a byte.
+	OCRHTTPSRequestTimeout  UEFIBootParameterType = 30 // The timeout of the HTTPS requests in seconds, as a 16-bit integer; 0 uses the BIOS default.
+	OCRHTTPSUserName        UEFIBootParameterType = 40 // The username of the HTTPS server, as ASCII.
+	OCRHTTPSPassword        UEFIBootParameterType = 41 // The password of the HTTPS server, as ASCII.
+)
+
+// uefiBootParameterTypeToString is a map of UEFIBootParameterType values to their string representations.
+var uefiBootParameterTypeToString = map[UEFIBootParameterType]string{
+	OCREFINetworkDevicePath: "OCREFINetworkDevicePath",
+	OCREFIFileDevicePath:    "OCREFIFileDevicePath",
+	OCREFIDevicePathLength:  "OCREFIDevicePathLength",
+	OCRHTTPSCertSyncRootCA:  "OCRHTTPSCertSyncRootCA",
+	OCRHTTPSRequestTimeout:  "OCRHTTPSRequestTimeout",
+	OCRHTTPSUserName:        "OCRHTTPSUserName",
+	OCRHTTPSPassword:        "OCRHTTPSPassword",
+}
+
+// String returns the string representation of the UEFIBootParameterType value.
+func (u UEFIBootParameterType) String() string {
+	if value, exists := uefiBootParameterTypeToString[u]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+const (
+	// RPEEraseDevices is the UEFI boot parameter of Secure Remote Platform Erase holding the devices to erase, as a
+	// 32-bit PlatformEraseDevices. The type shares its value with OCREFINetworkDevicePath; the BIOS reads the parameters
+	// of the boot that it performs.
+	RPEEraseDevices UEFIBootParameterType = 1
+)
+
+const (
+	PlatformEraseSecureEraseAllSSDs            PlatformEraseDevices = 1 << 2
+	PlatformEraseTPMClear                      PlatformEraseDevices = 1 << 6
+	PlatformEraseClearBIOSNVMVariables         PlatformEraseDevices = 1 << 25
+	PlatformEraseBIOSReloadGoldenConfiguration PlatformEraseDevices = 1 << 26
+	PlatformEraseCSMEUnconfigure               PlatformEraseDevices = 1 << 31
+	platformEraseDevicesAll                    PlatformEraseDevices = PlatformEraseSecureEraseAllSSDs | PlatformEraseTPMClear | PlatformEraseClearBIOSNVMVariables | PlatformEraseBIOSReloadGoldenConfiguration | PlatformEraseCSMEUnconfigure
+)
+
+// platformEraseDevicesToString is a map of the PlatformEraseDevices bits to their string representations.
+var platformEraseDevicesToString = map[PlatformEraseDevices]string{
+	PlatformEraseSecureEraseAllSSDs:            "SecureEraseAllSSDs",
+	PlatformEraseTPMClear:                      "TPMClear",
+	PlatformEraseClearBIOSNVMVariables:         "ClearBIOSNVMVariables",
+	PlatformEraseBIOSReloadGoldenConfiguration: "BIOSReloadGoldenConfiguration",
+	PlatformEraseCSMEUnconfigure:               "CSMEUnconfigure",
+}
+
+// String returns the string representation of the PlatformEraseDevices bits, separated by commas.
+func (p PlatformEraseDevices) String() string {
+	if p == 0 {
+		return "None"
+	}
+
+	if p&^platformEraseDevicesAll != 0 {
+		return ValueNotFound
+	}
+
+	s := ""
+
+	for bit := PlatformEraseDevices(1); bit != 0; bit <<= 1 {
+		if p&bit == 0 {
+			continue
+		}
+
+		if s != "" {
+			s += ", "
+		}
+
+		s += platformEraseDevicesToString[bit]
+	}
+
+	return s
+}
+
+// Contains returns whether all the devices are set.
+func (p PlatformEraseDevices) Contains(devices PlatformEraseDevices) bool {
+	return p&devices == devices
+}
@@ -44,3 +44,61 @@ func TestIDERBootDevice_String(t *testing.T) {
 		}
 	}
 }
+
+func TestUEFIBootParameterType_String(t *testing.T) {
+	tests := []struct {
+		state    UEFIBootParameterType
+		expected string
+	}{
+		{OCREFINetworkDevicePath, "OCREFINetworkDevicePath"},
+		{OCREFIFileDevicePath, "OCREFIFileDevicePath"},
+		{OCREFIDevicePathLength, "OCREFIDevicePathLength"},
+		{OCRHTTPSCertSyncRootCA, "OCRHTTPSCertSyncRootCA"},
+		{OCRHTTPSRequestTimeout, "OCRHTTPSRequestTimeout"},
+		{OCRHTTPSUserName, "OCRHTTPSUserName"},
+		{OCRHTTPSPassword, "OCRHTTPSPassword"},
+		{UEFIBootParameterType(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestPlatformEraseDevices_String(t *testing.T) {
+	tests := []struct {
+		state    PlatformEraseDevices
+		expected string
+	}{
+		{0, "None"},
+		{PlatformEraseSecureEraseAllSSDs, "SecureEraseAllSSDs"},
+		{PlatformEraseTPMClear, "TPMClear"},
+		{PlatformEraseClearBIOSNVMVariables, "ClearBIOSNVMVariables"},
+		{PlatformEraseBIOSReloadGoldenConfiguration, "BIOSReloadGoldenConfiguration"},
+		{PlatformEraseCSMEUnconfigure, "CSMEUnconfigure"},
+		{PlatformEraseSecureEraseAllSSDs | PlatformEraseTPMClear | PlatformEraseCSMEUnconfigure, "SecureEraseAllSSDs, TPMClear, CSMEUnconfigure"},
+		{PlatformEraseDevices(1), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestPlatformEraseDevices_Contains(t *testing.T) {
+	supported := PlatformEraseSecureEraseAllSSDs | PlatformEraseTPMClear
+
+	if !supported.Contains(PlatformEraseTPMClear) || !supported.Contains(supported) {
+		t.Error("Expected the devices to be contained")
+	}
+
+	if supported.Contains(PlatformEraseTPMClear | PlatformEraseCSMEUnconfigure) {
+		t.Error("Expected CSMEUnconfigure not to be contained")
+	}
+}
@@ -77,7 +77,8 @@ func TestPositiveAMT_BootSettingData(t *testing.T) {
 						SecureErase:              false,
 						UEFIHTTPSBootEnabled:     true,
 						UEFILocalPBABootEnabled:  true,
-						UEFIBootNumberOfParams:   0,
+						UEFIBootParametersArray:  httpsBootParametersArray,
+						UEFIBootNumberOfParams:   5,
 						UseIDER:                  false,
 						UseSOL:                   false,
 						UseSafeMode:              false,
@@ -236,7 +237,7 @@ func TestNegativeAMT_BootSettingData(t *testing.T) {
 						SecureBootControlEnabled: false,
 						BootguardStatus:          127,
 						BIOSLastStatus:           []int{0, 0},
-						UEFIBootParametersArray:  []int{0},
+						UEFIBootParametersArray:  "",
 						UEFIBootNumberOfParams:   0,
 						RPEEnabled:               false,
 						PlatformErase:            false,
@@ -308,7 +309,7 @@ func TestNegativeAMT_BootSettingData(t *testing.T) {
 								SecureBootControlEnabled: false,
 								BootguardStatus:          127,
 								BIOSLastStatus:           []int{0, 0},
-								UEFIBootParametersArray:  []int{0},
+								UEFIBootParametersArray:  "",
 								UEFIBootNumberOfParams:   0,
 								RPEEnabled:               false,
 								PlatformErase:            false,
@@ -0,0 +1,135 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package boot
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+)
+
+// uefiBootParameterHeaderLength is the length of the vendor, type and length of a UEFI boot parameter.
+const uefiBootParameterHeaderLength = 8
+
+// ErrMalformedUEFIBootParameters is returned when UefiBootParametersArray cannot be decoded.
+var ErrMalformedUEFIBootParameters = errors.New("malformed UEFI boot parameters")
+
+// UEFIBootParameter is a parameter of the UEFI boot used by One-Click Recovery and Secure Remote Platform Erase. It is
+// encoded as a TLV: the vendor and the type as 16-bit integers, the length of the value as a 32-bit integer, all little
+// endian, followed by the value.
+type UEFIBootParameter struct {
+	Vendor uint16
+	Type   UEFIBootParameterType
+	Value  []byte
+}
+
+// HTTPSBoot is a One-Click Recovery boot of a UEFI image downloaded over HTTPS.
+type HTTPSBoot struct {
+	URL            string // The https URL of the boot image.
+	Username       string // The username of the HTTPS server, if it requires one.
+	Password       string // The password of the HTTPS server, if it requires one.
+	SyncRootCA     bool   // Whether the BIOS verifies the HTTPS server with the root certificates of Intel AMT.
+	RequestTimeout uint16 // The timeout of the HTTPS requests in seconds; 0 uses the BIOS default.
+}
+
+// NewUEFIBootParameter returns an Intel UEFI boot parameter.
+func NewUEFIBootParameter(parameterType UEFIBootParameterType, value []byte) UEFIBootParameter {
+	return UEFIBootParameter{
+		Vendor: UEFIBootParameterVendorIntel,
+		Type:   parameterType,
+		Value:  value,
+	}
+}
+
+// UEFIBootParameters returns the UEFI boot parameters of the HTTPS boot. The username and the password are only added
+// when they are set.
+func (h HTTPSBoot) UEFIBootParameters() []UEFIBootParameter {
+	syncRootCA := []byte{0}
+	if h.SyncRootCA {
+		syncRootCA[0] = 1
+	}
+
+	requestTimeout := make([]byte, 2)
+	binary.LittleEndian.PutUint16(requestTimeout, h.RequestTimeout)
+
+	parameters := []UEFIBootParameter{
+		NewUEFIBootParameter(OCREFINetworkDevicePath, []byte(h.URL)),
+		NewUEFIBootParameter(OCRHTTPSCertSyncRootCA, syncRootCA),
+		NewUEFIBootParameter(OCRHTTPSRequestTimeout, requestTimeout),
+	}
+
+	if h.Username != "" {
+		parameters = append(parameters, NewUEFIBootParameter(OCRHTTPSUserName, []byte(h.Username)))
+	}
+
+	if h.Password != "" {
+		parameters = append(parameters, NewUEFIBootParameter(OCRHTTPSPassword, []byte(h.Password)))
+	}
+
+	return parameters
+}
+
+// PlatformEraseUEFIBootParameters returns the UEFI boot parameters of a Secure Remote Platform Erase of the devices.
+func PlatformEraseUEFIBootParameters(devices PlatformEraseDevices) []UEFIBootParameter {
+	value := make([]byte, 4)
+	binary.LittleEndian.PutUint32(value, uint32(devices))
+
+	return []UEFIBootParameter{NewUEFIBootParameter(RPEEraseDevices, value)}
+}
+
+// EncodeUEFIBootParameters returns the base64 encoded TLV array of the parameters for UefiBootParametersArray, and the
+// number of parameters for UefiBootNumberOfParams.
+func EncodeUEFIBootParameters(parameters []UEFIBootParameter) (array string, numberOfParams int) {
+	var encoded []byte
+
+	for _, parameter := range parameters {
+		header := make([]byte, uefiBootParameterHeaderLength)
+		binary.LittleEndian.PutUint16(header[0:2], parameter.Vendor)
+		binary.LittleEndian.PutUint16(header[2:4], uint16(parameter.Type))
+		binary.LittleEndian.PutUint32(header[4:8], uint32(len(parameter.Value)))
+
+		encoded = append(encoded, header...)
+		encoded = append(encoded, parameter.Value...)
+	}
+
+	return base64.StdEncoding.EncodeToString(encoded), len(parameters)
+}
+
+// DecodeUEFIBootParameters decodes a base64 encoded TLV array of UefiBootParametersArray.
+func DecodeUEFIBootParameters(array string) ([]UEFIBootParameter, error) {
+	encoded, err := base64.StdEncoding.DecodeString(array)
+	if err != nil {
+		return nil, err
+	}
+
+	var parameters []UEFIBootParameter
+
+	for len(encoded) > 0 {
+		if len(encoded) < uefiBootParameterHeaderLength {
+			return nil, ErrMalformedUEFIBootParameters
+		}
+
+		length := binary.LittleEndian.Uint32(encoded[4:8])
+		if uint64(length) > uint64(len(encoded)-uefiBootParameterHeaderLength) {
+			return nil, ErrMalformedUEFIBootParameters
+		}
+
+		parameters = append(parameters, UEFIBootParameter{
+			Vendor: binary.LittleEndian.Uint16(encoded[0:2]),
+			Type:   UEFIBootParameterType(binary.LittleEndian.Uint16(encoded[2:4])),
+			Value:  encoded[uefiBootParameterHeaderLength : uefiBootParameterHeaderLength+length],
+		})
+
+		encoded = encoded[uefiBootParameterHeaderLength+length:]
+	}
+
+	return parameters, nil
+}
+
+// SetUEFIBootParameters sets UEFIBootParametersArray and UEFIBootNumberOfParams to the parameters.
+func (request *BootSettingDataRequest) SetUEFIBootParameters(parameters []UEFIBootParameter) {
+	request.UEFIBootParametersArray, request.UEFIBootNumberOfParams = EncodeUEFIBootParameters(parameters)
+}
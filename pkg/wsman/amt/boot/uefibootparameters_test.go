@@ -0,0 +1,97 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package boot
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const httpsBootParametersArray = "hoABACAAAABodHRwczovL2V4YW1wbGUuY29tL3JlY292ZXJ5Lmlzb4aAFAABAAAAAYaAHgACAAAAPACGgCgABQAAAGFkbWluhoApAAYAAABzZWNyZXQ="
+
+func httpsBoot() HTTPSBoot {
+	return HTTPSBoot{
+		URL:            "https://example.com/recovery.iso",
+		Username:       "admin",
+		Password:       "secret",
+		SyncRootCA:     true,
+		RequestTimeout: 60,
+	}
+}
+
+func TestHTTPSBoot_UEFIBootParameters(t *testing.T) {
+	assert.Equal(t, []UEFIBootParameter{
+		{Vendor: UEFIBootParameterVendorIntel, Type: OCREFINetworkDevicePath, Value: []byte("https://example.com/recovery.iso")},
+		{Vendor: UEFIBootParameterVendorIntel, Type: OCRHTTPSCertSyncRootCA, Value: []byte{1}},
+		{Vendor: UEFIBootParameterVendorIntel, Type: OCRHTTPSRequestTimeout, Value: []byte{60, 0}},
+		{Vendor: UEFIBootParameterVendorIntel, Type: OCRHTTPSUserName, Value: []byte("admin")},
+		{Vendor: UEFIBootParameterVendorIntel, Type: OCRHTTPSPassword, Value: []byte("secret")},
+	}, httpsBoot().UEFIBootParameters())
+
+	parameters := HTTPSBoot{URL: "https://example.com/recovery.iso"}.UEFIBootParameters()
+	assert.Len(t, parameters, 3)
+	assert.Equal(t, []byte{0}, parameters[1].Value)
+}
+
+func TestPlatformEraseUEFIBootParameters(t *testing.T) {
+	parameters := PlatformEraseUEFIBootParameters(PlatformEraseSecureEraseAllSSDs | PlatformEraseCSMEUnconfigure)
+	assert.Equal(t, []UEFIBootParameter{
+		{Vendor: UEFIBootParameterVendorIntel, Type: RPEEraseDevices, Value: []byte{4, 0, 0, 128}},
+	}, parameters)
+
+	array, numberOfParams := EncodeUEFIBootParameters(parameters)
+	assert.Equal(t, "hoABAAQAAAAEAACA", array)
+	assert.Equal(t, 1, numberOfParams)
+}
+
+func TestEncodeUEFIBootParameters(t *testing.T) {
+	array, numberOfParams := EncodeUEFIBootParameters(httpsBoot().UEFIBootParameters())
+	assert.Equal(t, httpsBootParametersArray, array)
+	assert.Equal(t, 5, numberOfParams)
+
+	array, numberOfParams = EncodeUEFIBootParameters(nil)
+	assert.Equal(t, "", array)
+	assert.Equal(t, 0, numberOfParams)
+}
+
+func TestDecodeUEFIBootParameters(t *testing.T) {
+	parameters, err := DecodeUEFIBootParameters(httpsBootParametersArray)
+	assert.NoError(t, err)
+	assert.Equal(t, httpsBoot().UEFIBootParameters(), parameters)
+
+	parameters, err = DecodeUEFIBootParameters("")
+	assert.NoError(t, err)
+	assert.Empty(t, parameters)
+
+	_, err = DecodeUEFIBootParameters("hoABAAEAAAB4")
+	assert.NoError(t, err)
+
+	_, err = DecodeUEFIBootParameters("hoABAAEAAA==")
+	assert.ErrorIs(t, err, ErrMalformedUEFIBootParameters)
+
+	_, err = DecodeUEFIBootParameters("hoABAAIAAAB4")
+	assert.ErrorIs(t, err, ErrMalformedUEFIBootParameters)
+
+	_, err = DecodeUEFIBootParameters("not base64")
+	assert.Error(t, err)
+}
+
+func TestBootSettingDataRequest_SetUEFIBootParameters(t *testing.T) {
+	request := BootSettingDataRequest{}
+	request.SetUEFIBootParameters(httpsBoot().UEFIBootParameters())
+	assert.Equal(t, httpsBootParametersArray, request.UEFIBootParametersArray)
+	assert.Equal(t, 5, request.UEFIBootNumberOfParams)
+
+	body, err := xml.Marshal(request)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), "<h:UefiBootParametersArray>"+httpsBootParametersArray+"</h:UefiBootParametersArray><h:UefiBootNumberOfParams>5</h:UefiBootNumberOfParams>")
+
+	body, err = xml.Marshal(BootSettingDataRequest{})
+	assert.NoError(t, err)
+	assert.NotContains(t, string(body), "UefiBoot")
+}
@@ -0,0 +1,48 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package eventmanager
+
+// INPUTS Constants.
+const (
+	AMTEventManagerService string = "AMT_EventManagerService"
+	// DefaultFilterCollection is the filter collection holding all the alerts of Intel® AMT.
+	DefaultFilterCollection string = "Intel(r) AMT:All"
+)
+
+// WS-Eventing constants of the Subscribe request.
+const (
+	SubscribeAction      string = "http://schemas.xmlsoap.org/ws/2004/08/eventing/Subscribe"
+	SubscribeResourceURI string = "http://schemas.dmtf.org/wbem/wscim/1/*"
+	eventingNamespace    string = "http://schemas.xmlsoap.org/ws/2004/08/eventing"
+	digestAuthProfile    string = "http://schemas.dmtf.org/wbem/wsman/1/wsman/secprofile/http/digest"
+	userNameTokenType    string = "http://schemas.dmtf.org/wbem/wsman/1/wsman/token/userNameToken"
+	passwordTextType     string = "http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordText"
+)
+
+// Delivery modes of WS-Eventing subscriptions.
+const (
+	DeliveryModePush        DeliveryMode = "http://schemas.xmlsoap.org/ws/2004/08/eventing/DeliveryModes/Push"
+	DeliveryModePushWithAck DeliveryMode = "http://schemas.dmtf.org/wbem/wsman/1/wsman/PushWithAck"
+	DeliveryModeEvents      DeliveryMode = "http://schemas.dmtf.org/wbem/wsman/1/wsman/Events"
+	DeliveryModePull        DeliveryMode = "http://schemas.dmtf.org/wbem/wsman/1/wsman/Pull"
+)
+
+// deliveryModeToString is a map of the DeliveryMode URIs to their short names.
+var deliveryModeToString = map[DeliveryMode]string{
+	DeliveryModePush:        "Push",
+	DeliveryModePushWithAck: "PushWithAck",
+	DeliveryModeEvents:      "Events",
+	DeliveryModePull:        "Pull",
+}
+
+// String returns the short name of the DeliveryMode.
+func (d DeliveryMode) String() string {
+	if value, exists := deliveryModeToString[d]; exists {
+		return value
+	}
+
+	return "Value not found in map"
+}
@@ -0,0 +1,28 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package eventmanager
+
+import "testing"
+
+func TestDeliveryMode_String(t *testing.T) {
+	tests := []struct {
+		state    DeliveryMode
+		expected string
+	}{
+		{DeliveryModePush, "Push"},
+		{DeliveryModePushWithAck, "PushWithAck"},
+		{DeliveryModeEvents, "Events"},
+		{DeliveryModePull, "Pull"},
+		{DeliveryMode("http://example.com/unknown"), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
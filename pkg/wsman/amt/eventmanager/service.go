@@ -0,0 +1,160 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package eventmanager facilitates communication with Intel® AMT devices to access the event manager service and to subscribe event listeners to the alerts of AMT with WS-Eventing.
+package eventmanager
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewEventManagerServiceWithClient instantiates a new Event Manager service.
+func NewEventManagerServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Service {
+	return Service{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTEventManagerService, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (service Service) Get() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Get(nil),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (service Service) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Enumerate(),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (service Service) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Pull(enumerationContext),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Subscribe subscribes an event listener to the alerts of a filter collection with WS-Eventing.
+// AMT sends the alerts to options.Destination until the subscription expires or its CIM_IndicationSubscription is deleted.
+func (service Service) Subscribe(options SubscribeOptions) (response Response, err error) {
+	collection := options.FilterCollection
+	if collection == "" {
+		collection = DefaultFilterCollection
+	}
+
+	deliveryMode := options.DeliveryMode
+	if deliveryMode == "" {
+		deliveryMode = DeliveryModePush
+	}
+
+	credentials := options.Username != "" && options.Password != ""
+
+	issuedTokens := ""
+	if credentials {
+		issuedTokens = fmt.Sprintf(`<t:IssuedTokens xmlns:t="http://schemas.xmlsoap.org/ws/2005/02/trust" xmlns:se="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd"><t:RequestSecurityTokenResponse><t:TokenType>%s</t:TokenType><t:RequestedSecurityToken><se:UsernameToken><se:Username>%s</se:Username><se:Password Type="%s">%s</se:Password></se:UsernameToken></t:RequestedSecurityToken></t:RequestSecurityTokenResponse></t:IssuedTokens>`, userNameTokenType, escape(options.Username), passwordTextType, escape(options.Password))
+	}
+
+	var body strings.Builder
+
+	body.WriteString(fmt.Sprintf(`<Body><e:Subscribe xmlns:e="%s"><e:Delivery Mode="%s"><e:NotifyTo><a:Address>%s</a:Address></e:NotifyTo>`, eventingNamespace, escape(string(deliveryMode)), escape(options.Destination)))
+
+	if credentials {
+		body.WriteString(fmt.Sprintf(`<w:Auth Profile="%s"/>`, digestAuthProfile))
+	}
+
+	body.WriteString(`</e:Delivery>`)
+
+	if options.Expires > 0 {
+		body.WriteString(fmt.Sprintf(`<e:Expires>PT%dS</e:Expires>`, int64(options.Expires.Seconds())))
+	}
+
+	body.WriteString(`</e:Subscribe></Body>`)
+
+	selector := message.Selector{Name: "InstanceID", Value: escape(collection)}
+	header := service.base.WSManMessageCreator.CreateHeaderWithResourceURI(SubscribeAction, SubscribeResourceURI, &selector, issuedTokens)
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body.String()),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// escape returns value with the XML special characters escaped.
+func escape(value string) string {
+	var escaped strings.Builder
+
+	_ = xml.EscapeText(&escaped, []byte(value))
+
+	return escaped.String()
+}
@@ -0,0 +1,299 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package eventmanager
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/models"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	subscribeResourceURIBase = "http://schemas.dmtf.org/wbem/wscim/1/"
+	allAlertsSelector        = `<w:SelectorSet><w:Selector Name="InstanceID">Intel(r) AMT:All</w:Selector></w:SelectorSet>`
+	issuedTokens             = `<t:IssuedTokens xmlns:t="http://schemas.xmlsoap.org/ws/2005/02/trust" xmlns:se="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd"><t:RequestSecurityTokenResponse><t:TokenType>http://schemas.dmtf.org/wbem/wsman/1/wsman/token/userNameToken</t:TokenType><t:RequestedSecurityToken><se:UsernameToken><se:Username>admin</se:Username><se:Password Type="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordText">P@ss&amp;word</se:Password></se:UsernameToken></t:RequestedSecurityToken></t:RequestSecurityTokenResponse></t:IssuedTokens>`
+)
+
+func TestJson(t *testing.T) {
+	response := Response{
+		Body: Body{
+			GetResponse: EventManagerService{},
+		},
+	}
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Name\":\"\",\"CreationClassName\":\"\",\"SystemName\":\"\",\"SystemCreationClassName\":\"\",\"ElementName\":\"\",\"EnabledState\":0},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"EventManagerServiceItems\":null},\"SubscribeResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"SubscriptionManager\":{\"Address\":\"\",\"ReferenceParameters\":{\"ResourceURI\":\"\",\"SelectorSet\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Selector\":null}}},\"Expires\":\"\"}}"
+	result := response.JSON()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestYaml(t *testing.T) {
+	response := Response{
+		Body: Body{
+			GetResponse: EventManagerService{},
+		},
+	}
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    name: \"\"\n    creationclassname: \"\"\n    systemname: \"\"\n    systemcreationclassname: \"\"\n    elementname: \"\"\n    enabledstate: 0\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    eventmanagerserviceitems: []\nsubscriberesponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    subscriptionmanager:\n        address: \"\"\n        referenceparameters:\n            resourceuri: \"\"\n            selectorset:\n                xmlname:\n                    space: \"\"\n                    local: \"\"\n                selector: []\n    expires: \"\"\n"
+	result := response.YAML()
+	assert.Equal(t, expectedResult, result)
+}
+
+func eventManagerService() EventManagerService {
+	return EventManagerService{
+		XMLName:                 xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_EventManagerService", Local: "AMT_EventManagerService"},
+		Name:                    "Intel(r) AMT Event Manager Service",
+		CreationClassName:       AMTEventManagerService,
+		SystemName:              "Intel(r) AMT",
+		SystemCreationClassName: "CIM_ComputerSystem",
+		ElementName:             "Intel(r) AMT Event Manager Service",
+		EnabledState:            5,
+	}
+}
+
+func TestPositiveAMT_EventManagerService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/eventmanager",
+	}
+	elementUnderTest := NewEventManagerServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_EventManagerService Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			resourceURIBase  string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create and parse valid AMT_EventManagerService Get call",
+				resourceURIBase,
+				AMTEventManagerService,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:     xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetResponse: eventManagerService(),
+				},
+			},
+			// ENUMERATES
+			{
+				"should create and parse valid AMT_EventManagerService Enumerate call",
+				resourceURIBase,
+				AMTEventManagerService,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create and parse valid AMT_EventManagerService Pull call",
+				resourceURIBase,
+				AMTEventManagerService,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:                  xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						EventManagerServiceItems: []EventManagerService{eventManagerService()},
+					},
+				},
+			},
+			// SUBSCRIBE
+			{
+				"should create and parse valid Subscribe call",
+				subscribeResourceURIBase,
+				"*",
+				SubscribeAction,
+				allAlertsSelector,
+				`<e:Subscribe xmlns:e="http://schemas.xmlsoap.org/ws/2004/08/eventing"><e:Delivery Mode="http://schemas.xmlsoap.org/ws/2004/08/eventing/DeliveryModes/Push"><e:NotifyTo><a:Address>http://192.168.0.10:8080/events</a:Address></e:NotifyTo></e:Delivery></e:Subscribe>`,
+				func() (Response, error) {
+					client.CurrentMessage = "Subscribe"
+
+					return elementUnderTest.Subscribe(SubscribeOptions{Destination: "http://192.168.0.10:8080/events"})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SubscribeResponse: SubscribeResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/08/eventing", Local: "SubscribeResponse"},
+						SubscriptionManager: SubscriptionManager{
+							Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+							ReferenceParameters: models.ReferenceParameters_OUTPUT{
+								ResourceURI: "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_FilterCollectionSubscription",
+								SelectorSet: models.SelectorSet_OUTPUT{
+									XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "SelectorSet"},
+									Selector: []message.Selector_OUTPUT{
+										{
+											XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"},
+											Name:    "InstanceID",
+											Value:   "Intel(r) AMT:Subscription 1",
+										},
+									},
+								},
+							},
+						},
+						Expires: "PT3600S",
+					},
+				},
+			},
+			{
+				"should create a Subscribe call with credentials, delivery mode, filter collection and expiration",
+				subscribeResourceURIBase,
+				"*",
+				SubscribeAction,
+				`<w:SelectorSet><w:Selector Name="InstanceID">Intel(r) AMT:Power</w:Selector></w:SelectorSet>` + issuedTokens,
+				`<e:Subscribe xmlns:e="http://schemas.xmlsoap.org/ws/2004/08/eventing"><e:Delivery Mode="http://schemas.dmtf.org/wbem/wsman/1/wsman/PushWithAck"><e:NotifyTo><a:Address>http://192.168.0.10:8080/events?a=1&amp;b=2</a:Address></e:NotifyTo><w:Auth Profile="http://schemas.dmtf.org/wbem/wsman/1/wsman/secprofile/http/digest"/></e:Delivery><e:Expires>PT3600S</e:Expires></e:Subscribe>`,
+				func() (Response, error) {
+					client.CurrentMessage = "Subscribe"
+
+					return elementUnderTest.Subscribe(SubscribeOptions{
+						Destination:      "http://192.168.0.10:8080/events?a=1&b=2",
+						DeliveryMode:     DeliveryModePushWithAck,
+						Username:         "admin",
+						Password:         "P@ss&word",
+						FilterCollection: "Intel(r) AMT:Power",
+						Expires:          time.Hour,
+					})
+				},
+				nil,
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, test.resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+
+				if test.expectedResponse != nil {
+					assert.Equal(t, test.expectedResponse, response.Body)
+				}
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_EventManagerService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/eventmanager",
+	}
+	elementUnderTest := NewEventManagerServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_EventManagerService Tests", func(t *testing.T) {
+		tests := []struct {
+			name            string
+			resourceURIBase string
+			method          string
+			action          string
+			extraHeader     string
+			body            string
+			responseFunc    func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_EventManagerService Get call",
+				resourceURIBase,
+				AMTEventManagerService,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+			},
+			{
+				"should handle error when AMT_EventManagerService Enumerate call",
+				resourceURIBase,
+				AMTEventManagerService,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_EventManagerService Pull call",
+				resourceURIBase,
+				AMTEventManagerService,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when Subscribe call",
+				subscribeResourceURIBase,
+				"*",
+				SubscribeAction,
+				allAlertsSelector,
+				`<e:Subscribe xmlns:e="http://schemas.xmlsoap.org/ws/2004/08/eventing"><e:Delivery Mode="http://schemas.dmtf.org/wbem/wsman/1/wsman/Events"><e:NotifyTo><a:Address>http://192.168.0.10:8080/events</a:Address></e:NotifyTo></e:Delivery></e:Subscribe>`,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Subscribe(SubscribeOptions{Destination: "http://192.168.0.10:8080/events", DeliveryMode: DeliveryModeEvents})
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, test.resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,74 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package eventmanager
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/models"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type Service struct {
+	base message.Base
+}
+
+// INPUTS
+// SubscribeOptions describes a WS-Eventing subscription to the alerts of Intel® AMT.
+type SubscribeOptions struct {
+	Destination      string        // Destination is the URL of the event listener, such as http://192.168.0.10:8080/events.
+	DeliveryMode     DeliveryMode  // DeliveryMode of the events, DeliveryModePush if empty.
+	Username         string        // Username, when set with Password, is sent to the listener with HTTP digest authentication.
+	Password         string        // Password of Username.
+	FilterCollection string        // FilterCollection is the InstanceID of the CIM_FilterCollection to subscribe to, DefaultFilterCollection if empty.
+	Expires          time.Duration // Expires is the duration of the subscription, which does not expire if zero.
+}
+
+// OUTPUTS
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName           xml.Name            `xml:"Body"`
+		GetResponse       EventManagerService `xml:"AMT_EventManagerService"`
+		EnumerateResponse common.EnumerateResponse
+		PullResponse      PullResponse
+		SubscribeResponse SubscribeResponse `xml:"SubscribeResponse"`
+	}
+	PullResponse struct {
+		XMLName                  xml.Name              `xml:"PullResponse"`
+		EventManagerServiceItems []EventManagerService `xml:"Items>AMT_EventManagerService"`
+	}
+	EventManagerService struct {
+		XMLName                 xml.Name `xml:"AMT_EventManagerService"`
+		Name                    string   // The Name property uniquely identifies the Service and provides an indication of the functionality that is managed
+		CreationClassName       string   // CreationClassName indicates the name of the class or the subclass that is used in the creation of an instance
+		SystemName              string   // The Name of the scoping System.
+		SystemCreationClassName string   // The CreationClassName of the scoping System.
+		ElementName             string   // A user-friendly name for the object
+		EnabledState            int      // EnabledState is an integer enumeration that indicates the enabled and disabled states of an element.
+	}
+	SubscribeResponse struct {
+		XMLName             xml.Name            `xml:"SubscribeResponse"`
+		SubscriptionManager SubscriptionManager // SubscriptionManager is the endpoint reference of the created subscription.
+		Expires             string              // Expires is the duration of the subscription, empty if it does not expire.
+	}
+	SubscriptionManager struct {
+		Address             string
+		ReferenceParameters models.ReferenceParameters_OUTPUT
+	}
+)
+
+// DeliveryMode is the URI of a WS-Eventing delivery mode.
+type DeliveryMode string
@@ -0,0 +1,56 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package heuristicpacketfilter
+
+// INPUTS Constants.
+const (
+	AMTHeuristicPacketFilterSettings        string = "AMT_HeuristicPacketFilterSettings"
+	AMTHeuristicPacketFilterInterfacePolicy string = "AMT_HeuristicPacketFilterInterfacePolicy"
+	AMTHeuristicPacketFilterStatistics      string = "AMT_HeuristicPacketFilterStatistics"
+	ValueNotFound                           string = "Value not found in map"
+)
+
+const (
+	InterfaceTypeWired InterfaceType = iota
+	InterfaceTypeWireless
+)
+
+// interfaceTypeToString is a map of the InterfaceType enumeration.
+var interfaceTypeToString = map[InterfaceType]string{
+	InterfaceTypeWired:    "Wired",
+	InterfaceTypeWireless: "Wireless",
+}
+
+// String returns a human-readable string representation of the InterfaceType enumeration.
+func (e InterfaceType) String() string {
+	if s, ok := interfaceTypeToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	FilterStateNormal FilterState = iota
+	FilterStateLimited
+	FilterStateBlocked
+)
+
+// filterStateToString is a map of the FilterState enumeration.
+var filterStateToString = map[FilterState]string{
+	FilterStateNormal:  "Normal",
+	FilterStateLimited: "Limited",
+	FilterStateBlocked: "Blocked",
+}
+
+// String returns a human-readable string representation of the FilterState enumeration.
+func (e FilterState) String() string {
+	if s, ok := filterStateToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
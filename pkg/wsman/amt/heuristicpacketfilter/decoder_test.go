@@ -0,0 +1,45 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package heuristicpacketfilter
+
+import "testing"
+
+func TestInterfaceType_String(t *testing.T) {
+	tests := []struct {
+		state    InterfaceType
+		expected string
+	}{
+		{InterfaceTypeWired, "Wired"},
+		{InterfaceTypeWireless, "Wireless"},
+		{InterfaceType(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestFilterState_String(t *testing.T) {
+	tests := []struct {
+		state    FilterState
+		expected string
+	}{
+		{FilterStateNormal, "Normal"},
+		{FilterStateLimited, "Limited"},
+		{FilterStateBlocked, "Blocked"},
+		{FilterState(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
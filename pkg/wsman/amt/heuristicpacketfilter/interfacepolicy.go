@@ -0,0 +1,114 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package heuristicpacketfilter
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewHeuristicPacketFilterInterfacePolicyWithClient instantiates a new heuristic packet filter InterfacePolicy.
+func NewHeuristicPacketFilterInterfacePolicyWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) InterfacePolicy {
+	return InterfacePolicy{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTHeuristicPacketFilterInterfacePolicy, client),
+	}
+}
+
+// Get retrieves the representation of the interface policy with the given InstanceID.
+func (policy InterfacePolicy) Get(instanceID string) (response Response, err error) {
+	selector := message.Selector{Name: "InstanceID", Value: instanceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.Get(&selector),
+		},
+	}
+
+	// send the message to AMT
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (policy InterfacePolicy) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.Enumerate(),
+		},
+	}
+
+	// send the message to AMT
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (policy InterfacePolicy) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.Pull(enumerationContext),
+		},
+	}
+
+	// send the message to AMT
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Put changes the properties of the interface policy with the given InstanceID.
+func (policy InterfacePolicy) Put(instanceID string, request InterfacePolicyRequest) (response Response, err error) {
+	selector := message.Selector{Name: "InstanceID", Value: instanceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.Put(&request, true, &selector),
+		},
+	}
+
+	// send the message to AMT
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,229 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package heuristicpacketfilter
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const wiredPolicy = "Intel(r) AMT Heuristic Packet Filter Wired Policy"
+
+func wiredPolicyRequest() InterfacePolicyRequest {
+	return InterfacePolicyRequest{
+		InstanceID:         wiredPolicy,
+		ElementName:        wiredPolicy,
+		InterfaceType:      InterfaceTypeWired,
+		BlockOffensivePort: true,
+		BlockThreshold:     20,
+		LimitThreshold:     10,
+		EncounterTimeout:   60,
+		BlockTimeout:       300,
+	}
+}
+
+func wiredPolicyResponse() InterfacePolicyResponse {
+	return InterfacePolicyResponse{
+		XMLName:            xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_HeuristicPacketFilterInterfacePolicy", Local: "AMT_HeuristicPacketFilterInterfacePolicy"},
+		InstanceID:         wiredPolicy,
+		ElementName:        wiredPolicy,
+		InterfaceType:      InterfaceTypeWired,
+		BlockOffensivePort: true,
+		BlockThreshold:     20,
+		LimitThreshold:     10,
+		EncounterTimeout:   60,
+		BlockTimeout:       300,
+	}
+}
+
+func TestPositiveHeuristicPacketFilterInterfacePolicy(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/heuristicpacketfilter/interfacepolicy",
+	}
+	elementUnderTest := NewHeuristicPacketFilterInterfacePolicyWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_heuristicpacketfilterinterfacepolicy Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should create and parse a valid AMT_HeuristicPacketFilterInterfacePolicy Get call",
+				AMTHeuristicPacketFilterInterfacePolicy,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT Heuristic Packet Filter Wired Policy</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(wiredPolicy)
+				},
+				Body{
+					XMLName:                    xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					InterfacePolicyGetResponse: wiredPolicyResponse(),
+				},
+			},
+			{
+				"should create and parse a valid AMT_HeuristicPacketFilterInterfacePolicy Enumerate call",
+				AMTHeuristicPacketFilterInterfacePolicy,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_HeuristicPacketFilterInterfacePolicy Pull call",
+				AMTHeuristicPacketFilterInterfacePolicy,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:              xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						InterfacePolicyItems: []InterfacePolicyResponse{wiredPolicyResponse()},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_HeuristicPacketFilterInterfacePolicy Put call",
+				AMTHeuristicPacketFilterInterfacePolicy,
+				wsmantesting.Put,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT Heuristic Packet Filter Wired Policy</w:Selector></w:SelectorSet>",
+				"<h:AMT_HeuristicPacketFilterInterfacePolicy xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_HeuristicPacketFilterInterfacePolicy\"><h:InstanceID>Intel(r) AMT Heuristic Packet Filter Wired Policy</h:InstanceID><h:ElementName>Intel(r) AMT Heuristic Packet Filter Wired Policy</h:ElementName><h:InterfaceType>0</h:InterfaceType><h:BlockAll>false</h:BlockAll><h:BlockOffensivePort>true</h:BlockOffensivePort><h:BlockThreshold>20</h:BlockThreshold><h:LimitThreshold>10</h:LimitThreshold><h:EncounterTimeout>60</h:EncounterTimeout><h:BlockTimeout>300</h:BlockTimeout></h:AMT_HeuristicPacketFilterInterfacePolicy>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePut
+
+					return elementUnderTest.Put(wiredPolicy, wiredPolicyRequest())
+				},
+				Body{
+					XMLName:                    xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					InterfacePolicyGetResponse: wiredPolicyResponse(),
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeHeuristicPacketFilterInterfacePolicy(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/heuristicpacketfilter/interfacepolicy",
+	}
+	elementUnderTest := NewHeuristicPacketFilterInterfacePolicyWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_heuristicpacketfilterinterfacepolicy Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_HeuristicPacketFilterInterfacePolicy Get call",
+				AMTHeuristicPacketFilterInterfacePolicy,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT Heuristic Packet Filter Wired Policy</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(wiredPolicy)
+				},
+			},
+			{
+				"should handle error when AMT_HeuristicPacketFilterInterfacePolicy Enumerate call",
+				AMTHeuristicPacketFilterInterfacePolicy,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_HeuristicPacketFilterInterfacePolicy Pull call",
+				AMTHeuristicPacketFilterInterfacePolicy,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_HeuristicPacketFilterInterfacePolicy Put call",
+				AMTHeuristicPacketFilterInterfacePolicy,
+				wsmantesting.Put,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT Heuristic Packet Filter Wired Policy</w:Selector></w:SelectorSet>",
+				"<h:AMT_HeuristicPacketFilterInterfacePolicy xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_HeuristicPacketFilterInterfacePolicy\"><h:InstanceID>Intel(r) AMT Heuristic Packet Filter Wired Policy</h:InstanceID><h:ElementName>Intel(r) AMT Heuristic Packet Filter Wired Policy</h:ElementName><h:InterfaceType>0</h:InterfaceType><h:BlockAll>false</h:BlockAll><h:BlockOffensivePort>true</h:BlockOffensivePort><h:BlockThreshold>20</h:BlockThreshold><h:LimitThreshold>10</h:LimitThreshold><h:EncounterTimeout>60</h:EncounterTimeout><h:BlockTimeout>300</h:BlockTimeout></h:AMT_HeuristicPacketFilterInterfacePolicy>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Put(wiredPolicy, wiredPolicyRequest())
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,122 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package heuristicpacketfilter facilitates communication with Intel® AMT devices to configure the heuristic packet filter, which detects anomalous outbound traffic of the host, such as the scanning of a worm, and contains it by rate limiting or blocking the traffic.
+//
+// HeuristicPacketFilterSettings:
+// Enables the heuristic packet filter and the alerts it sends.
+//
+// HeuristicPacketFilterInterfacePolicy:
+// The thresholds, timeouts and containment actions of a network interface.
+//
+// HeuristicPacketFilterStatistics:
+// The containment state and the block and limit events of a network interface.
+package heuristicpacketfilter
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewHeuristicPacketFilterSettingsWithClient instantiates a new heuristic packet filter Settings.
+func NewHeuristicPacketFilterSettingsWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Settings {
+	return Settings{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTHeuristicPacketFilterSettings, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (settings Settings) Get() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: settings.base.Get(nil),
+		},
+	}
+
+	// send the message to AMT
+	err = settings.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (settings Settings) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: settings.base.Enumerate(),
+		},
+	}
+
+	// send the message to AMT
+	err = settings.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (settings Settings) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: settings.base.Pull(enumerationContext),
+		},
+	}
+
+	// send the message to AMT
+	err = settings.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Put changes the properties of the heuristic packet filter settings.
+func (settings Settings) Put(request SettingsRequest) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: settings.base.Put(&request, false, nil),
+		},
+	}
+
+	// send the message to AMT
+	err = settings.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,241 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package heuristicpacketfilter
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func TestJson(t *testing.T) {
+	response := Response{
+		Body: Body{
+			PullResponse: PullResponse{},
+		},
+	}
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"SettingsGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"Enabled\":false,\"SendAlert\":false},\"InterfacePolicyGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"InterfaceType\":0,\"BlockAll\":false,\"BlockOffensivePort\":false,\"BlockThreshold\":0,\"LimitThreshold\":0,\"EncounterTimeout\":0,\"BlockTimeout\":0},\"StatisticsGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"InterfaceType\":0,\"FilterState\":0,\"BlockEvents\":0,\"LimitEvents\":0,\"LastEventTime\":{\"Datetime\":\"\"}},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"SettingsItems\":null,\"InterfacePolicyItems\":null,\"StatisticsItems\":null}}"
+	result := response.JSON()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestYaml(t *testing.T) {
+	response := Response{
+		Body: Body{
+			PullResponse: PullResponse{},
+		},
+	}
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\nsettingsgetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    instanceid: \"\"\n    elementname: \"\"\n    enabled: false\n    sendalert: false\ninterfacepolicygetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    instanceid: \"\"\n    elementname: \"\"\n    interfacetype: 0\n    blockall: false\n    blockoffensiveport: false\n    blockthreshold: 0\n    limitthreshold: 0\n    encountertimeout: 0\n    blocktimeout: 0\nstatisticsgetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    instanceid: \"\"\n    elementname: \"\"\n    interfacetype: 0\n    filterstate: 0\n    blockevents: 0\n    limitevents: 0\n    lasteventtime:\n        datetime: \"\"\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    settingsitems: []\n    interfacepolicyitems: []\n    statisticsitems: []\n"
+	result := response.YAML()
+	assert.Equal(t, expectedResult, result)
+}
+
+func settingsRequest() SettingsRequest {
+	return SettingsRequest{
+		InstanceID:  "Intel(r) AMT Heuristic Packet Filter Settings",
+		ElementName: "Intel(r) AMT Heuristic Packet Filter Settings",
+		Enabled:     true,
+		SendAlert:   true,
+	}
+}
+
+func settingsResponse() SettingsResponse {
+	return SettingsResponse{
+		XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_HeuristicPacketFilterSettings", Local: "AMT_HeuristicPacketFilterSettings"},
+		InstanceID:  "Intel(r) AMT Heuristic Packet Filter Settings",
+		ElementName: "Intel(r) AMT Heuristic Packet Filter Settings",
+		Enabled:     true,
+		SendAlert:   true,
+	}
+}
+
+func TestPositiveHeuristicPacketFilterSettings(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/heuristicpacketfilter/settings",
+	}
+	elementUnderTest := NewHeuristicPacketFilterSettingsWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_heuristicpacketfiltersettings Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should create and parse a valid AMT_HeuristicPacketFilterSettings Get call",
+				AMTHeuristicPacketFilterSettings,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:             xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SettingsGetResponse: settingsResponse(),
+				},
+			},
+			{
+				"should create and parse a valid AMT_HeuristicPacketFilterSettings Enumerate call",
+				AMTHeuristicPacketFilterSettings,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_HeuristicPacketFilterSettings Pull call",
+				AMTHeuristicPacketFilterSettings,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:       xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						SettingsItems: []SettingsResponse{settingsResponse()},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_HeuristicPacketFilterSettings Put call",
+				AMTHeuristicPacketFilterSettings,
+				wsmantesting.Put,
+				"",
+				"<h:AMT_HeuristicPacketFilterSettings xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_HeuristicPacketFilterSettings\"><h:InstanceID>Intel(r) AMT Heuristic Packet Filter Settings</h:InstanceID><h:ElementName>Intel(r) AMT Heuristic Packet Filter Settings</h:ElementName><h:Enabled>true</h:Enabled><h:SendAlert>true</h:SendAlert></h:AMT_HeuristicPacketFilterSettings>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePut
+
+					return elementUnderTest.Put(settingsRequest())
+				},
+				Body{
+					XMLName:             xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					SettingsGetResponse: settingsResponse(),
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeHeuristicPacketFilterSettings(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/heuristicpacketfilter/settings",
+	}
+	elementUnderTest := NewHeuristicPacketFilterSettingsWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_heuristicpacketfiltersettings Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_HeuristicPacketFilterSettings Get call",
+				AMTHeuristicPacketFilterSettings,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+			},
+			{
+				"should handle error when AMT_HeuristicPacketFilterSettings Enumerate call",
+				AMTHeuristicPacketFilterSettings,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_HeuristicPacketFilterSettings Pull call",
+				AMTHeuristicPacketFilterSettings,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_HeuristicPacketFilterSettings Put call",
+				AMTHeuristicPacketFilterSettings,
+				wsmantesting.Put,
+				"",
+				"<h:AMT_HeuristicPacketFilterSettings xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_HeuristicPacketFilterSettings\"><h:InstanceID>Intel(r) AMT Heuristic Packet Filter Settings</h:InstanceID><h:ElementName>Intel(r) AMT Heuristic Packet Filter Settings</h:ElementName><h:Enabled>true</h:Enabled><h:SendAlert>true</h:SendAlert></h:AMT_HeuristicPacketFilterSettings>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Put(settingsRequest())
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
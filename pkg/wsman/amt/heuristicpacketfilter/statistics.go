@@ -0,0 +1,105 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package heuristicpacketfilter
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewHeuristicPacketFilterStatisticsWithClient instantiates a new heuristic packet filter Statistics.
+func NewHeuristicPacketFilterStatisticsWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Statistics {
+	return Statistics{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTHeuristicPacketFilterStatistics, client),
+	}
+}
+
+// Get retrieves the representation of the interface statistics with the given InstanceID.
+func (statistics Statistics) Get(instanceID string) (response Response, err error) {
+	selector := message.Selector{Name: "InstanceID", Value: instanceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: statistics.base.Get(&selector),
+		},
+	}
+
+	// send the message to AMT
+	err = statistics.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (statistics Statistics) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: statistics.base.Enumerate(),
+		},
+	}
+
+	// send the message to AMT
+	err = statistics.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (statistics Statistics) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: statistics.base.Pull(enumerationContext),
+		},
+	}
+
+	// send the message to AMT
+	err = statistics.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Read enumerates the statistics of all the network interfaces, reporting their containment state and block and limit events.
+func (statistics Statistics) Read() ([]StatisticsResponse, error) {
+	response, err := statistics.Enumerate()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err = statistics.Pull(response.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Body.PullResponse.StatisticsItems, nil
+}
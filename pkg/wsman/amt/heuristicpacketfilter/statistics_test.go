@@ -0,0 +1,205 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package heuristicpacketfilter
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const wiredStatistics = "Intel(r) AMT Heuristic Packet Filter Wired Statistics"
+
+func wiredStatisticsResponse() StatisticsResponse {
+	return StatisticsResponse{
+		XMLName:       xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_HeuristicPacketFilterStatistics", Local: "AMT_HeuristicPacketFilterStatistics"},
+		InstanceID:    wiredStatistics,
+		ElementName:   wiredStatistics,
+		InterfaceType: InterfaceTypeWired,
+		FilterState:   FilterStateBlocked,
+		BlockEvents:   2,
+		LimitEvents:   3,
+		LastEventTime: Datetime{Datetime: "2024-03-05T10:15:00Z"},
+	}
+}
+
+func TestPositiveHeuristicPacketFilterStatistics(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/heuristicpacketfilter/statistics",
+	}
+	elementUnderTest := NewHeuristicPacketFilterStatisticsWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_heuristicpacketfilterstatistics Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should create and parse a valid AMT_HeuristicPacketFilterStatistics Get call",
+				AMTHeuristicPacketFilterStatistics,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT Heuristic Packet Filter Wired Statistics</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(wiredStatistics)
+				},
+				Body{
+					XMLName:               xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					StatisticsGetResponse: wiredStatisticsResponse(),
+				},
+			},
+			{
+				"should create and parse a valid AMT_HeuristicPacketFilterStatistics Enumerate call",
+				AMTHeuristicPacketFilterStatistics,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_HeuristicPacketFilterStatistics Pull call",
+				AMTHeuristicPacketFilterStatistics,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:         xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						StatisticsItems: []StatisticsResponse{wiredStatisticsResponse()},
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeHeuristicPacketFilterStatistics(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/heuristicpacketfilter/statistics",
+	}
+	elementUnderTest := NewHeuristicPacketFilterStatisticsWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_heuristicpacketfilterstatistics Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_HeuristicPacketFilterStatistics Get call",
+				AMTHeuristicPacketFilterStatistics,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT Heuristic Packet Filter Wired Statistics</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(wiredStatistics)
+				},
+			},
+			{
+				"should handle error when AMT_HeuristicPacketFilterStatistics Enumerate call",
+				AMTHeuristicPacketFilterStatistics,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_HeuristicPacketFilterStatistics Pull call",
+				AMTHeuristicPacketFilterStatistics,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
+
+func TestReadStatistics(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/heuristicpacketfilter/statistics",
+	}
+	elementUnderTest := NewHeuristicPacketFilterStatisticsWithClient(wsmanMessageCreator, &client)
+
+	client.CurrentMessage = wsmantesting.CurrentMessagePull
+	statistics, err := elementUnderTest.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, []StatisticsResponse{wiredStatisticsResponse()}, statistics)
+	assert.Equal(t, "Blocked", statistics[0].FilterState.String())
+
+	client.CurrentMessage = wsmantesting.CurrentMessageError
+	_, err = elementUnderTest.Read()
+	assert.Error(t, err)
+}
@@ -0,0 +1,114 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package heuristicpacketfilter
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type (
+	Settings struct {
+		base message.Base
+	}
+	InterfacePolicy struct {
+		base message.Base
+	}
+	Statistics struct {
+		base message.Base
+	}
+)
+
+// OUTPUT
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName                    xml.Name                `xml:"Body"`
+		SettingsGetResponse        SettingsResponse        `xml:"AMT_HeuristicPacketFilterSettings"`
+		InterfacePolicyGetResponse InterfacePolicyResponse `xml:"AMT_HeuristicPacketFilterInterfacePolicy"`
+		StatisticsGetResponse      StatisticsResponse      `xml:"AMT_HeuristicPacketFilterStatistics"`
+		EnumerateResponse          common.EnumerateResponse
+		PullResponse               PullResponse
+	}
+	PullResponse struct {
+		XMLName              xml.Name                  `xml:"PullResponse"`
+		SettingsItems        []SettingsResponse        `xml:"Items>AMT_HeuristicPacketFilterSettings"`
+		InterfacePolicyItems []InterfacePolicyResponse `xml:"Items>AMT_HeuristicPacketFilterInterfacePolicy"`
+		StatisticsItems      []StatisticsResponse      `xml:"Items>AMT_HeuristicPacketFilterStatistics"`
+	}
+	SettingsResponse struct {
+		XMLName     xml.Name `xml:"AMT_HeuristicPacketFilterSettings"`
+		InstanceID  string   `xml:"InstanceID,omitempty"`  // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName string   `xml:"ElementName,omitempty"` // A user-friendly name for the object.
+		Enabled     bool     `xml:"Enabled"`               // Whether the heuristic packet filter inspects the outbound traffic of the host.
+		SendAlert   bool     `xml:"SendAlert"`             // Whether AMT sends an alert when an interface is limited or blocked.
+	}
+	InterfacePolicyResponse struct {
+		XMLName            xml.Name      `xml:"AMT_HeuristicPacketFilterInterfacePolicy"`
+		InstanceID         string        `xml:"InstanceID,omitempty"`  // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName        string        `xml:"ElementName,omitempty"` // A user-friendly name for the object.
+		InterfaceType      InterfaceType `xml:"InterfaceType"`         // The network interface that the policy applies to.
+		BlockAll           bool          `xml:"BlockAll"`              // Block all the outbound traffic of the host, rather than the traffic to the offending port only, when the block threshold is reached.
+		BlockOffensivePort bool          `xml:"BlockOffensivePort"`    // Block the outbound traffic to the offending port when the block threshold is reached.
+		BlockThreshold     int           `xml:"BlockThreshold"`        // The number of anomalous connections within the encounter timeout that blocks the traffic.
+		LimitThreshold     int           `xml:"LimitThreshold"`        // The number of anomalous connections within the encounter timeout that rate limits the traffic.
+		EncounterTimeout   int           `xml:"EncounterTimeout"`      // The time, in seconds, during which anomalous connections are counted.
+		BlockTimeout       int           `xml:"BlockTimeout"`          // The time, in seconds, for which the traffic stays blocked or limited; 0 keeps it blocked until the policy is reset.
+	}
+	StatisticsResponse struct {
+		XMLName       xml.Name      `xml:"AMT_HeuristicPacketFilterStatistics"`
+		InstanceID    string        `xml:"InstanceID,omitempty"`    // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName   string        `xml:"ElementName,omitempty"`   // A user-friendly name for the object.
+		InterfaceType InterfaceType `xml:"InterfaceType"`           // The network interface that the statistics belong to.
+		FilterState   FilterState   `xml:"FilterState"`             // The current containment state of the interface.
+		BlockEvents   int           `xml:"BlockEvents"`             // The number of times the traffic of the interface was blocked.
+		LimitEvents   int           `xml:"LimitEvents"`             // The number of times the traffic of the interface was rate limited.
+		LastEventTime Datetime      `xml:"LastEventTime,omitempty"` // The time of the last block or limit event.
+	}
+	Datetime struct {
+		Datetime string `xml:"Datetime,omitempty"`
+	}
+)
+
+// INPUT
+// Request Types.
+type (
+	SettingsRequest struct {
+		XMLName     xml.Name `xml:"h:AMT_HeuristicPacketFilterSettings"`
+		H           string   `xml:"xmlns:h,attr"`
+		InstanceID  string   `xml:"h:InstanceID,omitempty"`  // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName string   `xml:"h:ElementName,omitempty"` // A user-friendly name for the object.
+		Enabled     bool     `xml:"h:Enabled"`               // Whether the heuristic packet filter inspects the outbound traffic of the host.
+		SendAlert   bool     `xml:"h:SendAlert"`             // Whether AMT sends an alert when an interface is limited or blocked.
+	}
+	InterfacePolicyRequest struct {
+		XMLName            xml.Name      `xml:"h:AMT_HeuristicPacketFilterInterfacePolicy"`
+		H                  string        `xml:"xmlns:h,attr"`
+		InstanceID         string        `xml:"h:InstanceID,omitempty"`  // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName        string        `xml:"h:ElementName,omitempty"` // A user-friendly name for the object.
+		InterfaceType      InterfaceType `xml:"h:InterfaceType"`         // The network interface that the policy applies to.
+		BlockAll           bool          `xml:"h:BlockAll"`              // Block all the outbound traffic of the host, rather than the traffic to the offending port only, when the block threshold is reached.
+		BlockOffensivePort bool          `xml:"h:BlockOffensivePort"`    // Block the outbound traffic to the offending port when the block threshold is reached.
+		BlockThreshold     int           `xml:"h:BlockThreshold"`        // The number of anomalous connections within the encounter timeout that blocks the traffic.
+		LimitThreshold     int           `xml:"h:LimitThreshold"`        // The number of anomalous connections within the encounter timeout that rate limits the traffic.
+		EncounterTimeout   int           `xml:"h:EncounterTimeout"`      // The time, in seconds, during which anomalous connections are counted.
+		BlockTimeout       int           `xml:"h:BlockTimeout"`          // The time, in seconds, for which the traffic stays blocked or limited; 0 keeps it blocked until the policy is reset.
+	}
+)
+
+type (
+	InterfaceType int
+	FilterState   int
+)
@@ -17,6 +17,9 @@ const (
 	AMTMessageLog         string = "AMT_MessageLog"
 	GetRecords            string = "GetRecords"
 	PositionToFirstRecord string = "PositionToFirstRecord"
+	ClearLog              string = "ClearLog"
+	FreezeLog             string = "FreezeLog"
+	RequestStateChange    string = "RequestStateChange"
 	ValueNotFound         string = "Value not found in map"
 )
 
@@ -418,6 +421,203 @@ func (p PositionToFirstRecordReturnValue) String() string {
 	return ValueNotFound
 }
 
+const (
+	ReturnValueCompletedWithNoError    ReturnValue = 0
+	ReturnValueNotSupported            ReturnValue = 1
+	ReturnValueUnknownError            ReturnValue = 2
+	ReturnValueTimeout                 ReturnValue = 3
+	ReturnValueFailed                  ReturnValue = 4
+	ReturnValueInvalidParameter        ReturnValue = 5
+	ReturnValueInUse                   ReturnValue = 6
+	ReturnValueMethodParametersChecked ReturnValue = 4096
+	ReturnValueInvalidStateTransition  ReturnValue = 4097
+)
+
+// returnValueString is a map of the ReturnValue to their string representation.
+var returnValueString = map[ReturnValue]string{
+	ReturnValueCompletedWithNoError:    "CompletedWithNoError",
+	ReturnValueNotSupported:            "NotSupported",
+	ReturnValueUnknownError:            "UnknownError",
+	ReturnValueTimeout:                 "Timeout",
+	ReturnValueFailed:                  "Failed",
+	ReturnValueInvalidParameter:        "InvalidParameter",
+	ReturnValueInUse:                   "InUse",
+	ReturnValueMethodParametersChecked: "MethodParametersChecked",
+	ReturnValueInvalidStateTransition:  "InvalidStateTransition",
+}
+
+// String returns the string representation of the ReturnValue value.
+func (r ReturnValue) String() string {
+	if value, exists := returnValueString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+// Sensor types defined by the IPMI specification.
+const (
+	SensorTypeTemperature                      SensorType = 0x01
+	SensorTypeVoltage                          SensorType = 0x02
+	SensorTypeCurrent                          SensorType = 0x03
+	SensorTypeFan                              SensorType = 0x04
+	SensorTypePhysicalSecurity                 SensorType = 0x05
+	SensorTypePlatformSecurityViolationAttempt SensorType = 0x06
+	SensorTypeProcessor                        SensorType = 0x07
+	SensorTypePowerSupply                      SensorType = 0x08
+	SensorTypePowerUnit                        SensorType = 0x09
+	SensorTypeCoolingDevice                    SensorType = 0x0A
+	SensorTypeOtherUnitsBasedSensor            SensorType = 0x0B
+	SensorTypeMemory                           SensorType = 0x0C
+	SensorTypeDriveSlot                        SensorType = 0x0D
+	SensorTypePOSTMemoryResize                 SensorType = 0x0E
+	SensorTypeSystemFirmwareProgress           SensorType = 0x0F
+	SensorTypeEventLoggingDisabled             SensorType = 0x10
+	SensorTypeWatchdog1                        SensorType = 0x11
+	SensorTypeSystemEvent                      SensorType = 0x12
+	SensorTypeCriticalInterrupt                SensorType = 0x13
+	SensorTypeButtonSwitch                     SensorType = 0x14
+	SensorTypeModuleBoard                      SensorType = 0x15
+	SensorTypeMicrocontrollerCoprocessor       SensorType = 0x16
+	SensorTypeAddInCard                        SensorType = 0x17
+	SensorTypeChassis                          SensorType = 0x18
+	SensorTypeChipSet                          SensorType = 0x19
+	SensorTypeOtherFRU                         SensorType = 0x1A
+	SensorTypeCableInterconnect                SensorType = 0x1B
+	SensorTypeTerminator                       SensorType = 0x1C
+	SensorTypeSystemBootRestartInitiated       SensorType = 0x1D
+	SensorTypeBootError                        SensorType = 0x1E
+	SensorTypeBaseOSBootInstallationStatus     SensorType = 0x1F
+	SensorTypeOSStopShutdown                   SensorType = 0x20
+	SensorTypeSlotConnector                    SensorType = 0x21
+	SensorTypeSystemACPIPowerState             SensorType = 0x22
+	SensorTypeWatchdog2                        SensorType = 0x23
+	SensorTypePlatformAlert                    SensorType = 0x24
+	SensorTypeEntityPresence                   SensorType = 0x25
+	SensorTypeMonitorASICIC                    SensorType = 0x26
+	SensorTypeLAN                              SensorType = 0x27
+	SensorTypeManagementSubsystemHealth        SensorType = 0x28
+	SensorTypeBattery                          SensorType = 0x29
+	SensorTypeSessionAudit                     SensorType = 0x2A
+	SensorTypeVersionChange                    SensorType = 0x2B
+	SensorTypeFRUState                         SensorType = 0x2C
+)
+
+// sensorTypeString is a map of the SensorType to their string representation.
+var sensorTypeString = map[SensorType]string{
+	SensorTypeTemperature:                      "Temperature",
+	SensorTypeVoltage:                          "Voltage",
+	SensorTypeCurrent:                          "Current",
+	SensorTypeFan:                              "Fan",
+	SensorTypePhysicalSecurity:                 "PhysicalSecurity",
+	SensorTypePlatformSecurityViolationAttempt: "PlatformSecurityViolationAttempt",
+	SensorTypeProcessor:                        "Processor",
+	SensorTypePowerSupply:                      "PowerSupply",
+	SensorTypePowerUnit:                        "PowerUnit",
+	SensorTypeCoolingDevice:                    "CoolingDevice",
+	SensorTypeOtherUnitsBasedSensor:            "OtherUnitsBasedSensor",
+	SensorTypeMemory:                           "Memory",
+	SensorTypeDriveSlot:                        "DriveSlot",
+	SensorTypePOSTMemoryResize:                 "POSTMemoryResize",
+	SensorTypeSystemFirmwareProgress:           "SystemFirmwareProgress",
+	SensorTypeEventLoggingDisabled:             "EventLoggingDisabled",
+	SensorTypeWatchdog1:                        "Watchdog1",
+	SensorTypeSystemEvent:                      "SystemEvent",
+	SensorTypeCriticalInterrupt:                "CriticalInterrupt",
+	SensorTypeButtonSwitch:                     "ButtonSwitch",
+	SensorTypeModuleBoard:                      "ModuleBoard",
+	SensorTypeMicrocontrollerCoprocessor:       "MicrocontrollerCoprocessor",
+	SensorTypeAddInCard:                        "AddInCard",
+	SensorTypeChassis:                          "Chassis",
+	SensorTypeChipSet:                          "ChipSet",
+	SensorTypeOtherFRU:                         "OtherFRU",
+	SensorTypeCableInterconnect:                "CableInterconnect",
+	SensorTypeTerminator:                       "Terminator",
+	SensorTypeSystemBootRestartInitiated:       "SystemBootRestartInitiated",
+	SensorTypeBootError:                        "BootError",
+	SensorTypeBaseOSBootInstallationStatus:     "BaseOSBootInstallationStatus",
+	SensorTypeOSStopShutdown:                   "OSStopShutdown",
+	SensorTypeSlotConnector:                    "SlotConnector",
+	SensorTypeSystemACPIPowerState:             "SystemACPIPowerState",
+	SensorTypeWatchdog2:                        "Watchdog2",
+	SensorTypePlatformAlert:                    "PlatformAlert",
+	SensorTypeEntityPresence:                   "EntityPresence",
+	SensorTypeMonitorASICIC:                    "MonitorASICIC",
+	SensorTypeLAN:                              "LAN",
+	SensorTypeManagementSubsystemHealth:        "ManagementSubsystemHealth",
+	SensorTypeBattery:                          "Battery",
+	SensorTypeSessionAudit:                     "SessionAudit",
+	SensorTypeVersionChange:                    "VersionChange",
+	SensorTypeFRUState:                         "FRUState",
+}
+
+// String returns the string representation of the SensorType value.
+func (s SensorType) String() string {
+	if value, exists := sensorTypeString[s]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+// Event/reading type codes defined by the IPMI specification. Codes 0x02 to 0x0C are generic discrete types and codes
+// 0x70 to 0x7F are OEM types.
+const (
+	EventTypeUnspecified          EventType = 0x00
+	EventTypeThreshold            EventType = 0x01
+	EventTypeGenericDiscreteFirst EventType = 0x02
+	EventTypeGenericDiscreteLast  EventType = 0x0C
+	EventTypeSensorSpecific       EventType = 0x6F
+	EventTypeOEMFirst             EventType = 0x70
+	EventTypeOEMLast              EventType = 0x7F
+)
+
+// String returns the string representation of the EventType value.
+func (e EventType) String() string {
+	switch {
+	case e == EventTypeUnspecified:
+		return "Unspecified"
+	case e == EventTypeThreshold:
+		return "Threshold"
+	case e >= EventTypeGenericDiscreteFirst && e <= EventTypeGenericDiscreteLast:
+		return "GenericDiscrete"
+	case e == EventTypeSensorSpecific:
+		return "SensorSpecific"
+	case e >= EventTypeOEMFirst && e <= EventTypeOEMLast:
+		return "OEM"
+	default:
+		return ValueNotFound
+	}
+}
+
+const (
+	SeverityUnspecified    Severity = 0
+	SeverityMonitor        Severity = 1
+	SeverityInformation    Severity = 2
+	SeverityOK             Severity = 4
+	SeverityNonCritical    Severity = 8
+	SeverityCritical       Severity = 16
+	SeverityNonRecoverable Severity = 32
+)
+
+// String returns the string representation of the Severity value.
+func (s Severity) String() string {
+	if value, exists := EventSeverity[int(s)]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
+// String returns the string representation of the EntityType value.
+func (e EntityType) String() string {
+	if value, exists := SystemEntityTypes[int(e)]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
+
 func parseEventLogResult(eventlogdata []string) (records []RawEventData, err error) {
 	records = make([]RawEventData, len(eventlogdata))
 
@@ -480,6 +680,34 @@ func decodeEventRecord(eventLog []RawEventData) []RefinedEventData {
 	return refinedEventData
 }
 
+func decodePlatformEvents(eventLog []RawEventData) []PlatformEvent {
+	platformEvents := make([]PlatformEvent, len(eventLog))
+
+	for idx, event := range eventLog {
+		platformEvents[idx] = event.PlatformEvent()
+	}
+
+	return platformEvents
+}
+
+// PlatformEvent returns the record with typed Platform Event Trap fields.
+func (event RawEventData) PlatformEvent() PlatformEvent {
+	return PlatformEvent{
+		TimeStamp:       time.Unix(int64(event.TimeStamp), 0),
+		DeviceAddress:   event.DeviceAddress,
+		SensorType:      SensorType(event.EventSensorType),
+		EventType:       EventType(event.EventType),
+		EventOffset:     event.EventOffset,
+		EventSourceType: event.EventSourceType,
+		Severity:        Severity(event.EventSeverity),
+		SensorNumber:    event.SensorNumber,
+		Entity:          EntityType(event.Entity),
+		EntityInstance:  event.EntityInstance,
+		EventData:       event.EventData,
+		Description:     decodeEventDetailString(event.EventSensorType, event.EventOffset, event.EventData),
+	}
+}
+
 func decodeEventDetailString(eventSensorType, eventOffset uint8, eventDataField []uint8) string {
 	switch eventSensorType {
 	case 6:
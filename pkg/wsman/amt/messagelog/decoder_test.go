@@ -353,3 +353,65 @@ func TestDecodeEventDetailString(t *testing.T) {
 		})
 	}
 }
+
+func TestReturnValue_String(t *testing.T) {
+	tests := []struct {
+		state    ReturnValue
+		expected string
+	}{
+		{ReturnValueCompletedWithNoError, "CompletedWithNoError"},
+		{ReturnValueNotSupported, "NotSupported"},
+		{ReturnValueInUse, "InUse"},
+		{ReturnValueInvalidStateTransition, "InvalidStateTransition"},
+		{ReturnValue(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestPlatformEventTypes_String(t *testing.T) {
+	assert.Equal(t, "PlatformSecurityViolationAttempt", SensorTypePlatformSecurityViolationAttempt.String())
+	assert.Equal(t, "FRUState", SensorTypeFRUState.String())
+	assert.Equal(t, ValueNotFound, SensorType(0).String())
+
+	assert.Equal(t, "Unspecified", EventTypeUnspecified.String())
+	assert.Equal(t, "Threshold", EventTypeThreshold.String())
+	assert.Equal(t, "GenericDiscrete", EventType(0x07).String())
+	assert.Equal(t, "SensorSpecific", EventTypeSensorSpecific.String())
+	assert.Equal(t, "OEM", EventType(0x75).String())
+	assert.Equal(t, ValueNotFound, EventType(0x20).String())
+
+	assert.Equal(t, "Critical condition", SeverityCritical.String())
+	assert.Equal(t, ValueNotFound, Severity(3).String())
+
+	assert.Equal(t, "BIOS", EntityType(34).String())
+	assert.Equal(t, ValueNotFound, EntityType(200).String())
+}
+
+func TestRawEventData_PlatformEvent(t *testing.T) {
+	records, err := parseEventLogResult([]string{"Y8iYZf8GbwVoEP8mYaoKAAAAAAAA"})
+	assert.NoError(t, err)
+
+	expected := PlatformEvent{
+		TimeStamp:       time.Unix(int64(0x6598c863), 0),
+		DeviceAddress:   0xff,
+		SensorType:      SensorTypePlatformSecurityViolationAttempt,
+		EventType:       EventTypeSensorSpecific,
+		EventOffset:     5,
+		EventSourceType: 0x68,
+		Severity:        SeverityCritical,
+		SensorNumber:    0xff,
+		Entity:          38,
+		EntityInstance:  0x61,
+		EventData:       []uint8{0xaa, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+		Description:     "Authentication failed 10 times. The system may be under attack.",
+	}
+
+	assert.Equal(t, expected, records[0].PlatformEvent())
+	assert.Equal(t, []PlatformEvent{expected}, decodePlatformEvents(records))
+}
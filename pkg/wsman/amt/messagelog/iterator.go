@@ -0,0 +1,156 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package messagelog
+
+import "fmt"
+
+// RecordSource is the subset of Service used by a RecordIterator.
+type RecordSource interface {
+	PositionToFirstRecord() (Response, error)
+	GetRecords(identifier int) (Response, error)
+}
+
+// RecordIterator reads all the records of the event log, one GetRecords page at a time. It positions to the first
+// record and follows the IterationIdentifier until NoMoreRecords is returned.
+//
+//	records := messageLog.Records()
+//	for records.Next() {
+//		event := records.Event()
+//	}
+//
+//	if err := records.Err(); err != nil {
+//	}
+type RecordIterator struct {
+	source     RecordSource
+	identifier int
+	started    bool
+	done       bool
+	page       GetRecordsResponse
+	index      int
+	err        error
+}
+
+// NewRecordIterator returns an iterator over the records of source.
+func NewRecordIterator(source RecordSource) *RecordIterator {
+	return &RecordIterator{source: source, index: -1}
+}
+
+// Records returns an iterator over all the records of the event log.
+func (messageLog Service) Records() *RecordIterator {
+	return NewRecordIterator(messageLog)
+}
+
+// Next advances to the next record and reports whether there is one. It returns false at the end of the log or when a
+// call failed, which is reported by Err.
+func (it *RecordIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.index++
+
+	for it.index >= len(it.page.RecordArray) {
+		if it.done || !it.readPage() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Raw returns the Base64 encoded current record.
+func (it *RecordIterator) Raw() string {
+	if it.valid(len(it.page.RecordArray)) {
+		return it.page.RecordArray[it.index]
+	}
+
+	return ""
+}
+
+// Record returns the current record with its descriptive fields decoded.
+func (it *RecordIterator) Record() RefinedEventData {
+	if it.valid(len(it.page.RefinedEventData)) {
+		return it.page.RefinedEventData[it.index]
+	}
+
+	return RefinedEventData{}
+}
+
+// Event returns the current record with typed Platform Event Trap fields.
+func (it *RecordIterator) Event() PlatformEvent {
+	if it.valid(len(it.page.PlatformEvents)) {
+		return it.page.PlatformEvents[it.index]
+	}
+
+	return PlatformEvent{}
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *RecordIterator) Err() error {
+	return it.err
+}
+
+// valid reports whether the current record is in a page slice of length n.
+func (it *RecordIterator) valid(n int) bool {
+	return it.index >= 0 && it.index < n
+}
+
+// readPage reads the next page of records and reports whether the iteration can continue.
+func (it *RecordIterator) readPage() bool {
+	if !it.started {
+		it.started = true
+
+		response, err := it.source.PositionToFirstRecord()
+		if err != nil {
+			it.err = err
+
+			return false
+		}
+
+		position := response.Body.PositionToFirstRecordResponse
+
+		switch position.ReturnValue {
+		case PositionToFirstRecordReturnValueCompletedWithNoError:
+			it.identifier = position.IterationIdentifier
+		case PositionToFirstRecordReturnValueNoRecordExists:
+			it.done = true
+
+			return false
+		default:
+			it.err = fmt.Errorf("PositionToFirstRecord returned %s", position.ReturnValue)
+
+			return false
+		}
+	}
+
+	response, err := it.source.GetRecords(it.identifier)
+	if err != nil {
+		it.err = err
+
+		return false
+	}
+
+	output := response.Body.GetRecordsResponse
+
+	switch output.ReturnValue {
+	case GetRecordsReturnValueCompletedWithNoError:
+	case GetRecordsReturnValueNoRecordExistsInLog:
+		it.done = true
+
+		return false
+	default:
+		it.err = fmt.Errorf("GetRecords returned %s", output.ReturnValue)
+
+		return false
+	}
+
+	it.page = output
+	it.index = 0
+	it.identifier = output.IterationIdentifier
+	it.done = output.NoMoreRecords || len(output.RecordArray) == 0
+
+	return true
+}
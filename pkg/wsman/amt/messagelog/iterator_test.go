@@ -0,0 +1,130 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package messagelog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+var errGetRecords = errors.New("get records failed")
+
+type fakeRecordSource struct {
+	records     []string
+	pageSize    int
+	position    PositionToFirstRecordReturnValue
+	returnValue GetRecordsReturnValue
+	err         error
+	identifiers []int
+}
+
+func (f *fakeRecordSource) PositionToFirstRecord() (response Response, err error) {
+	response.Body.PositionToFirstRecordResponse.IterationIdentifier = 1
+	response.Body.PositionToFirstRecordResponse.ReturnValue = f.position
+
+	return response, nil
+}
+
+func (f *fakeRecordSource) GetRecords(identifier int) (response Response, err error) {
+	f.identifiers = append(f.identifiers, identifier)
+	output := &response.Body.GetRecordsResponse
+	output.ReturnValue = f.returnValue
+
+	end := identifier - 1 + f.pageSize
+	if end >= len(f.records) {
+		end = len(f.records)
+		output.NoMoreRecords = true
+	}
+
+	output.RecordArray = f.records[identifier-1 : end]
+	output.IterationIdentifier = end + 1
+	output.RawEventData, _ = parseEventLogResult(output.RecordArray)
+	output.RefinedEventData = decodeEventRecord(output.RawEventData)
+	output.PlatformEvents = decodePlatformEvents(output.RawEventData)
+
+	return response, f.err
+}
+
+func TestRecordIterator(t *testing.T) {
+	records := []string{"Y8iYZf8GbwVoEP8mYaoKAAAAAAAA", "IgYBZf8PbwJoAf8iAEAHAAAAAAAA", "IgYBZf8PbwJoAf8iAEAHAAAAAAAA"}
+
+	t.Run("should read all pages", func(t *testing.T) {
+		source := &fakeRecordSource{records: records, pageSize: 2}
+		iterator := NewRecordIterator(source)
+		raw := []string{}
+		sensorTypes := []SensorType{}
+
+		for iterator.Next() {
+			raw = append(raw, iterator.Raw())
+			sensorTypes = append(sensorTypes, iterator.Event().SensorType)
+		}
+
+		assert.NoError(t, iterator.Err())
+		assert.Equal(t, records, raw)
+		assert.Equal(t, []SensorType{SensorTypePlatformSecurityViolationAttempt, SensorTypeSystemFirmwareProgress, SensorTypeSystemFirmwareProgress}, sensorTypes)
+		assert.Equal(t, []int{1, 3}, source.identifiers)
+		assert.False(t, iterator.Next())
+		assert.Equal(t, "", iterator.Raw())
+	})
+
+	t.Run("should decode the current record", func(t *testing.T) {
+		iterator := NewRecordIterator(&fakeRecordSource{records: records, pageSize: 10})
+
+		assert.True(t, iterator.Next())
+		assert.Equal(t, "Authentication failed 10 times. The system may be under attack.", iterator.Record().Description)
+		assert.Equal(t, SeverityCritical, iterator.Event().Severity)
+	})
+
+	t.Run("should stop on an empty log", func(t *testing.T) {
+		source := &fakeRecordSource{position: PositionToFirstRecordReturnValueNoRecordExists}
+		iterator := NewRecordIterator(source)
+
+		assert.False(t, iterator.Next())
+		assert.NoError(t, iterator.Err())
+		assert.Empty(t, source.identifiers)
+
+		iterator = NewRecordIterator(&fakeRecordSource{returnValue: GetRecordsReturnValueNoRecordExistsInLog})
+
+		assert.False(t, iterator.Next())
+		assert.NoError(t, iterator.Err())
+	})
+
+	t.Run("should return errors", func(t *testing.T) {
+		iterator := NewRecordIterator(&fakeRecordSource{records: records, pageSize: 10, err: errGetRecords})
+
+		assert.False(t, iterator.Next())
+		assert.ErrorIs(t, iterator.Err(), errGetRecords)
+
+		iterator = NewRecordIterator(&fakeRecordSource{position: PositionToFirstRecordReturnValueNotSupported})
+
+		assert.False(t, iterator.Next())
+		assert.EqualError(t, iterator.Err(), "PositionToFirstRecord returned NotSupported")
+
+		iterator = NewRecordIterator(&fakeRecordSource{records: records, pageSize: 10, returnValue: GetRecordsReturnValueInvalidRecordPointed})
+
+		assert.False(t, iterator.Next())
+		assert.EqualError(t, iterator.Err(), "GetRecords returned InvalidRecordPointed")
+	})
+}
+
+func TestServiceRecords(t *testing.T) {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/messagelog",
+	}
+	elementUnderTest := NewMessageLogWithClient(wsmanMessageCreator, &client)
+
+	client.CurrentMessage = wsmantesting.CurrentMessageError
+	iterator := elementUnderTest.Records()
+
+	assert.False(t, iterator.Next())
+	assert.Error(t, iterator.Err())
+}
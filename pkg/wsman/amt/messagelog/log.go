@@ -138,6 +138,7 @@ func (messageLog Service) GetRecords(identifier int) (response Response, err err
 	}
 
 	response.Body.GetRecordsResponse.RefinedEventData = decodeEventRecord(response.Body.GetRecordsResponse.RawEventData)
+	response.Body.GetRecordsResponse.PlatformEvents = decodePlatformEvents(response.Body.GetRecordsResponse.RawEventData)
 
 	return response, err
 }
@@ -166,3 +167,72 @@ func (messageLog Service) PositionToFirstRecord() (response Response, err error)
 
 	return
 }
+
+// ClearLog deletes all the records of the event log.
+func (messageLog Service) ClearLog() (response Response, err error) {
+	header := messageLog.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTMessageLog, ClearLog), AMTMessageLog, nil, "", "")
+	body := messageLog.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(ClearLog), AMTMessageLog, nil)
+	response = Response{
+		Message: &client.Message{
+			XMLInput: messageLog.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+	// send the message to AMT
+	err = messageLog.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// FreezeLog stops (freeze is true) or resumes (freeze is false) the recording of new events in the event log. The IsFrozen property of the log reflects the current setting.
+func (messageLog Service) FreezeLog(freeze bool) (response Response, err error) {
+	header := messageLog.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTMessageLog, FreezeLog), AMTMessageLog, nil, "", "")
+	body := messageLog.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(FreezeLog), AMTMessageLog, &FreezeLog_INPUT{
+		Freeze: freeze,
+	})
+	response = Response{
+		Message: &client.Message{
+			XMLInput: messageLog.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+	// send the message to AMT
+	err = messageLog.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// RequestStateChange requests that the state of the event log be changed to the value specified in the requestedState parameter. Valid values are RequestedStateEnabled and RequestedStateDisabled.
+func (messageLog Service) RequestStateChange(requestedState RequestedState) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: messageLog.base.RequestStateChange(methods.GenerateAction(AMTMessageLog, RequestStateChange), int(requestedState)),
+		},
+	}
+	// send the message to AMT
+	err = messageLog.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -23,7 +23,7 @@ func TestJson(t *testing.T) {
 			GetResponse: MessageLogResponse{},
 		},
 	}
-	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Capabilities\":null,\"CharacterSet\":0,\"CreationClassName\":\"\",\"CurrentNumberOfRecords\":0,\"ElementName\":\"\",\"EnabledDefault\":0,\"EnabledState\":0,\"HealthState\":0,\"IsFrozen\":false,\"LastChange\":0,\"LogState\":0,\"MaxLogSize\":0,\"MaxNumberOfRecords\":0,\"MaxRecordSize\":0,\"Name\":\"\",\"OperationalStatus\":null,\"OverwritePolicy\":0,\"PercentageNearFull\":0,\"RequestedState\":0,\"SizeOfHeader\":0,\"SizeOfRecordHeader\":0,\"Status\":\"\"},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"MessageLogItems\":null},\"GetRecordsResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"IterationIdentifier\":0,\"NoMoreRecords\":false,\"RecordArray\":null,\"RawEventData\":null,\"RefinedEventData\":null,\"ReturnValue\":0},\"PositionToFirstRecordResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"IterationIdentifier\":0,\"ReturnValue\":0}}"
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Capabilities\":null,\"CharacterSet\":0,\"CreationClassName\":\"\",\"CurrentNumberOfRecords\":0,\"ElementName\":\"\",\"EnabledDefault\":0,\"EnabledState\":0,\"HealthState\":0,\"IsFrozen\":false,\"LastChange\":0,\"LogState\":0,\"MaxLogSize\":0,\"MaxNumberOfRecords\":0,\"MaxRecordSize\":0,\"Name\":\"\",\"OperationalStatus\":null,\"OverwritePolicy\":0,\"PercentageNearFull\":0,\"RequestedState\":0,\"SizeOfHeader\":0,\"SizeOfRecordHeader\":0,\"Status\":\"\"},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"MessageLogItems\":null},\"GetRecordsResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"IterationIdentifier\":0,\"NoMoreRecords\":false,\"RecordArray\":null,\"RawEventData\":null,\"RefinedEventData\":null,\"PlatformEvents\":null,\"ReturnValue\":0},\"PositionToFirstRecordResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"IterationIdentifier\":0,\"ReturnValue\":0},\"ClearLogResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"FreezeLogResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"RequestStateChangeResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0}}"
 	result := response.JSON()
 	assert.Equal(t, expectedResult, result)
 }
@@ -34,7 +34,7 @@ func TestYaml(t *testing.T) {
 			GetResponse: MessageLogResponse{},
 		},
 	}
-	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    capabilities: []\n    characterset: 0\n    creationclassname: \"\"\n    currentnumberofrecords: 0\n    elementname: \"\"\n    enableddefault: 0\n    enabledstate: 0\n    healthstate: 0\n    isfrozen: false\n    lastchange: 0\n    logstate: 0\n    maxlogsize: 0\n    maxnumberofrecords: 0\n    maxrecordsize: 0\n    name: \"\"\n    operationalstatus: []\n    overwritepolicy: 0\n    percentagenearfull: 0\n    requestedstate: 0\n    sizeofheader: 0\n    sizeofrecordheader: 0\n    status: \"\"\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    messagelogitems: []\ngetrecordsresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    iterationidentifier: 0\n    nomorerecords: false\n    recordarray: []\n    raweventdata: []\n    refinedeventdata: []\n    returnvalue: 0\npositiontofirstrecordresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    iterationidentifier: 0\n    returnvalue: 0\n"
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    capabilities: []\n    characterset: 0\n    creationclassname: \"\"\n    currentnumberofrecords: 0\n    elementname: \"\"\n    enableddefault: 0\n    enabledstate: 0\n    healthstate: 0\n    isfrozen: false\n    lastchange: 0\n    logstate: 0\n    maxlogsize: 0\n    maxnumberofrecords: 0\n    maxrecordsize: 0\n    name: \"\"\n    operationalstatus: []\n    overwritepolicy: 0\n    percentagenearfull: 0\n    requestedstate: 0\n    sizeofheader: 0\n    sizeofrecordheader: 0\n    status: \"\"\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    messagelogitems: []\ngetrecordsresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    iterationidentifier: 0\n    nomorerecords: false\n    recordarray: []\n    raweventdata: []\n    refinedeventdata: []\n    platformevents: []\n    returnvalue: 0\npositiontofirstrecordresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    iterationidentifier: 0\n    returnvalue: 0\nclearlogresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nfreezelogresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nrequeststatechangeresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\n"
 	result := response.YAML()
 	assert.Equal(t, expectedResult, result)
 }
@@ -282,10 +282,111 @@ func TestPositiveAMT_MessageLog(t *testing.T) {
 								SensorNumber:    255,
 							},
 						},
+						PlatformEvents: []PlatformEvent{
+							{
+								TimeStamp:       time.Unix(int64(0x6598c863), 0),
+								DeviceAddress:   255,
+								SensorType:      SensorTypePlatformSecurityViolationAttempt,
+								EventType:       EventTypeSensorSpecific,
+								EventOffset:     5,
+								EventSourceType: 104,
+								Severity:        SeverityCritical,
+								SensorNumber:    255,
+								Entity:          38,
+								EntityInstance:  97,
+								EventData:       []uint8{0xaa, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+								Description:     "Authentication failed 10 times. The system may be under attack.",
+							},
+							{
+								TimeStamp:       time.Unix(int64(0x65010622), 0),
+								DeviceAddress:   255,
+								SensorType:      SensorTypeSystemFirmwareProgress,
+								EventType:       EventTypeSensorSpecific,
+								EventOffset:     2,
+								EventSourceType: 104,
+								Severity:        SeverityMonitor,
+								SensorNumber:    255,
+								Entity:          34,
+								EntityInstance:  0,
+								EventData:       []uint8{0x40, 0x7, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+								Description:     "PCI resource configuration",
+							},
+							{
+								TimeStamp:       time.Unix(int64(0x65010622), 0),
+								DeviceAddress:   255,
+								SensorType:      SensorTypeSystemFirmwareProgress,
+								EventType:       EventTypeSensorSpecific,
+								EventOffset:     2,
+								EventSourceType: 104,
+								Severity:        SeverityMonitor,
+								SensorNumber:    255,
+								Entity:          34,
+								EntityInstance:  0,
+								EventData:       []uint8{0x40, 0x7, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+								Description:     "PCI resource configuration",
+							},
+						},
 						ReturnValue: 0,
 					},
 				},
 			},
+			// CLEAR LOG
+			{
+				"should return a valid amt_MessageLog ClearLog wsman message",
+				AMTMessageLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog/ClearLog`,
+				`<h:ClearLog_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog"></h:ClearLog_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "ClearLog"
+
+					return elementUnderTest.ClearLog()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ClearLogResponse: ClearLog_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog", Local: "ClearLog_OUTPUT"},
+						ReturnValue: ReturnValueCompletedWithNoError,
+					},
+				},
+			},
+			// FREEZE LOG
+			{
+				"should return a valid amt_MessageLog FreezeLog wsman message",
+				AMTMessageLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog/FreezeLog`,
+				`<h:FreezeLog_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog"><h:Freeze>true</h:Freeze></h:FreezeLog_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "FreezeLog"
+
+					return elementUnderTest.FreezeLog(true)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					FreezeLogResponse: FreezeLog_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog", Local: "FreezeLog_OUTPUT"},
+						ReturnValue: ReturnValueCompletedWithNoError,
+					},
+				},
+			},
+			// REQUEST STATE CHANGE
+			{
+				"should return a valid amt_MessageLog RequestStateChange wsman message",
+				AMTMessageLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog/RequestStateChange`,
+				`<h:RequestStateChange_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog"><h:RequestedState>3</h:RequestedState></h:RequestStateChange_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = "RequestStateChange"
+
+					return elementUnderTest.RequestStateChange(RequestedStateDisabled)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					RequestStateChangeResponse: RequestStateChange_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog", Local: "RequestStateChange_OUTPUT"},
+						ReturnValue: ReturnValueCompletedWithNoError,
+					},
+				},
+			},
 		}
 
 		for _, test := range tests {
@@ -463,6 +564,63 @@ func TestNegativeAMT_MessageLog(t *testing.T) {
 					},
 				},
 			},
+			// CLEAR LOG
+			{
+				"should return a valid amt_MessageLog ClearLog wsman message",
+				AMTMessageLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog/ClearLog`,
+				`<h:ClearLog_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog"></h:ClearLog_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.ClearLog()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ClearLogResponse: ClearLog_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog", Local: "ClearLog_OUTPUT"},
+						ReturnValue: ReturnValueCompletedWithNoError,
+					},
+				},
+			},
+			// FREEZE LOG
+			{
+				"should return a valid amt_MessageLog FreezeLog wsman message",
+				AMTMessageLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog/FreezeLog`,
+				`<h:FreezeLog_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog"><h:Freeze>true</h:Freeze></h:FreezeLog_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.FreezeLog(true)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					FreezeLogResponse: FreezeLog_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog", Local: "FreezeLog_OUTPUT"},
+						ReturnValue: ReturnValueCompletedWithNoError,
+					},
+				},
+			},
+			// REQUEST STATE CHANGE
+			{
+				"should return a valid amt_MessageLog RequestStateChange wsman message",
+				AMTMessageLog,
+				`http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog/RequestStateChange`,
+				`<h:RequestStateChange_INPUT xmlns:h="http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog"><h:RequestedState>3</h:RequestedState></h:RequestStateChange_INPUT>`,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.RequestStateChange(RequestedStateDisabled)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					RequestStateChangeResponse: RequestStateChange_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_MessageLog", Local: "RequestStateChange_OUTPUT"},
+						ReturnValue: ReturnValueCompletedWithNoError,
+					},
+				},
+			},
 		}
 
 		for _, test := range tests {
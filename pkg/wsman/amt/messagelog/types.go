@@ -35,6 +35,9 @@ type (
 		PullResponse                  PullResponse
 		GetRecordsResponse            GetRecordsResponse
 		PositionToFirstRecordResponse PositionToFirstRecordResponse
+		ClearLogResponse              ClearLog_OUTPUT
+		FreezeLogResponse             FreezeLog_OUTPUT
+		RequestStateChangeResponse    RequestStateChange_OUTPUT
 	}
 
 	PullResponse struct {
@@ -75,6 +78,7 @@ type (
 		RecordArray         []string              `xml:"RecordArray"`         // Array of records encoded as Base64
 		RawEventData        []RawEventData        `xml:"RawEventData"`        // Slice of raw event data
 		RefinedEventData    []RefinedEventData    `xml:"RefinedEventData"`    // Slice of refined event data
+		PlatformEvents      []PlatformEvent       `xml:"PlatformEvents"`      // Slice of event data with typed Platform Event Trap fields
 		ReturnValue         GetRecordsReturnValue `xml:"ReturnValue"`         // ValueMap={0, 1, 2, 3} Values={Completed with No Error, Not Supported, Invalid record pointed, No record exists in log}
 	}
 
@@ -84,6 +88,21 @@ type (
 		ReturnValue         PositionToFirstRecordReturnValue `xml:"ReturnValue"`         // ValueMap={0, 1, 2} Values={Completed with No Error, Not Supported, No record exists}
 	}
 
+	ClearLog_OUTPUT struct {
+		XMLName     xml.Name    `xml:"ClearLog_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"` // ValueMap={0, 1, 2, 3, 4, 5, 6, .., 0x8000..} Values={Completed with no error, Not supported, Unknown/Unspecified Error, Timeout, Failed, Invalid Parameter, In Use, DMTF Reserved, Vendor Specific}
+	}
+
+	FreezeLog_OUTPUT struct {
+		XMLName     xml.Name    `xml:"FreezeLog_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"` // ValueMap={0, 1, 2, 3, 4, 5, 6, .., 0x8000..} Values={Completed with no error, Not supported, Unknown/Unspecified Error, Timeout, Failed, Invalid Parameter, In Use, DMTF Reserved, Vendor Specific}
+	}
+
+	RequestStateChange_OUTPUT struct {
+		XMLName     xml.Name    `xml:"RequestStateChange_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"` // ValueMap={0, 1, 2, 3, 4, 5, 6, .., 4096, 4097, 4098, 4099, 4100..32767, 32768..65535} Values={Completed with No Error, Not Supported, Unknown or Unspecified Error, Cannot complete within Timeout Period, Failed, Invalid Parameter, In Use, DMTF Reserved, Method Parameters Checked - Job Started, Invalid State Transition, Use of Timeout Parameter Not Supported, Busy, Method Reserved, Vendor Specific}
+	}
+
 	RawEventData struct {
 		TimeStamp       uint32
 		DeviceAddress   uint8
@@ -113,6 +132,22 @@ type (
 		SensorNumber    uint8
 	}
 
+	// PlatformEvent is an event log record with the Platform Event Trap fields as typed values.
+	PlatformEvent struct {
+		TimeStamp       time.Time
+		DeviceAddress   uint8
+		SensorType      SensorType
+		EventType       EventType
+		EventOffset     uint8
+		EventSourceType uint8
+		Severity        Severity
+		SensorNumber    uint8
+		Entity          EntityType
+		EntityInstance  uint8
+		EventData       []uint8
+		Description     string
+	}
+
 	// Capabilities is an array of integers indicating the Log capabilities.
 	Capabilities int
 
@@ -148,6 +183,21 @@ type (
 
 	// PositionToFirstRecordReturnValue is an integer indicating the return value of the PositionToFirstRecord operation.
 	PositionToFirstRecordReturnValue int
+
+	// ReturnValue is an integer indicating the return value of the ClearLog, FreezeLog and RequestStateChange operations.
+	ReturnValue int
+
+	// SensorType is the IPMI sensor type of the sensor that generated an event.
+	SensorType uint8
+
+	// EventType is the IPMI event/reading type code of an event, which defines how the event offset is interpreted.
+	EventType uint8
+
+	// Severity is the severity of an event.
+	Severity uint8
+
+	// EntityType is the IPMI entity ID of the platform entity that generated an event.
+	EntityType uint8
 )
 
 // INPUTS.
@@ -157,3 +207,9 @@ type GetRecords_INPUT struct {
 	IterationIdentifier int      `xml:"h:IterationIdentifier"` // An identifier for the iterator.
 	MaxReadRecords      int      `xml:"h:MaxReadRecords"`      // Maximum number of records to read
 }
+
+type FreezeLog_INPUT struct {
+	XMLName xml.Name `xml:"h:FreezeLog_INPUT"`
+	H       string   `xml:"xmlns:h,attr"`
+	Freeze  bool     `xml:"h:Freeze"` // If set to true, the log is frozen and no new records are added; if set to false, logging resumes.
+}
@@ -8,13 +8,17 @@ package amt
 
 import (
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/agentpresence"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/alarmclock"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditpolicyrule"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/authorization"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/environmentdetection"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/ethernetport"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/eventmanager"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/general"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/heuristicpacketfilter"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/ieee8021x"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/kerberos"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/managementpresence"
@@ -25,44 +29,65 @@ import (
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/remoteaccess"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/setupandconfiguration"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/systemdefense"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/thirdpartydatastorage"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/timesynchronization"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/tls"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/userinitiatedconnection"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/webui"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/wifiportconfiguration"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
 )
 
 // Messages contains the supported AMT classes.
 type Messages struct {
-	wsmanMessageCreator             *message.WSManMessageCreator
-	AlarmClockService               alarmclock.Service
-	AuditLog                        auditlog.Service
-	AuthorizationService            authorization.Service
-	BootCapabilities                boot.Capabilities
-	BootSettingData                 boot.SettingData
-	EnvironmentDetectionSettingData environmentdetection.SettingData
-	EthernetPortSettings            ethernetport.Settings
-	GeneralSettings                 general.Settings
-	IEEE8021xCredentialContext      ieee8021x.CredentialContext
-	IEEE8021xProfile                ieee8021x.Profile
-	KerberosSettingData             kerberos.SettingData
-	ManagementPresenceRemoteSAP     managementpresence.RemoteSAP
-	MessageLog                      messagelog.Service
-	MPSUsernamePassword             mps.UsernamePassword
-	PublicKeyCertificate            publickey.Certificate
-	PublicKeyManagementService      publickey.ManagementService
-	PublicPrivateKeyPair            publicprivate.KeyPair
-	RedirectionService              redirection.Service
-	RemoteAccessPolicyAppliesToMPS  remoteaccess.PolicyAppliesToMPS
-	RemoteAccessPolicyRule          remoteaccess.PolicyRule
-	RemoteAccessService             remoteaccess.Service
-	SetupAndConfigurationService    setupandconfiguration.Service
-	TimeSynchronizationService      timesynchronization.Service
-	TLSCredentialContext            tls.CredentialContext
-	TLSProtocolEndpointCollection   tls.ProtocolEndpointCollection
-	TLSSettingData                  tls.SettingData
-	UserInitiatedConnectionService  userinitiatedconnection.Service
-	WiFiPortConfigurationService    wifiportconfiguration.Service
+	wsmanMessageCreator                        *message.WSManMessageCreator
+	ActiveFilterStatistics                     systemdefense.ActiveFilterStatistics
+	AgentPresenceService                       agentpresence.Service
+	AgentPresenceWatchdog                      agentpresence.Watchdog
+	AgentPresenceWatchdogAction                agentpresence.WatchdogAction
+	AlarmClockService                          alarmclock.Service
+	AuditLog                                   auditlog.Service
+	AuditPolicyRule                            auditpolicyrule.Service
+	AuthorizationService                       authorization.Service
+	BootCapabilities                           boot.Capabilities
+	BootSettingData                            boot.SettingData
+	EnvironmentDetectionSettingData            environmentdetection.SettingData
+	EthernetPortSettings                       ethernetport.Settings
+	EventManagerService                        eventmanager.Service
+	GeneralSettings                            general.Settings
+	GeneralSystemDefenseCapabilities           systemdefense.Capabilities
+	Hdr8021Filter                              systemdefense.Hdr8021Filter
+	HeuristicPacketFilterInterfacePolicy       heuristicpacketfilter.InterfacePolicy
+	HeuristicPacketFilterSettings              heuristicpacketfilter.Settings
+	HeuristicPacketFilterStatistics            heuristicpacketfilter.Statistics
+	IEEE8021xCredentialContext                 ieee8021x.CredentialContext
+	IEEE8021xProfile                           ieee8021x.Profile
+	IPHeadersFilter                            systemdefense.IPHeadersFilter
+	KerberosSettingData                        kerberos.SettingData
+	ManagementPresenceRemoteSAP                managementpresence.RemoteSAP
+	MessageLog                                 messagelog.Service
+	MPSUsernamePassword                        mps.UsernamePassword
+	NetworkFilter                              systemdefense.NetworkFilter
+	NetworkPortSystemDefensePolicy             systemdefense.NetworkPortPolicy
+	PublicKeyCertificate                       publickey.Certificate
+	PublicKeyManagementService                 publickey.ManagementService
+	PublicPrivateKeyPair                       publicprivate.KeyPair
+	RedirectionService                         redirection.Service
+	RemoteAccessPolicyAppliesToMPS             remoteaccess.PolicyAppliesToMPS
+	RemoteAccessPolicyRule                     remoteaccess.PolicyRule
+	RemoteAccessService                        remoteaccess.Service
+	SetupAndConfigurationService               setupandconfiguration.Service
+	SystemDefensePolicy                        systemdefense.Policy
+	ThirdPartyDataStorageAdministrationService thirdpartydatastorage.AdministrationService
+	ThirdPartyDataStorageService               thirdpartydatastorage.Service
+	TimeSynchronizationService                 timesynchronization.Service
+	TLSCredentialContext                       tls.CredentialContext
+	TLSProtocolEndpointCollection              tls.ProtocolEndpointCollection
+	TLSSettingData                             tls.SettingData
+	UserInitiatedConnectionService             userinitiatedconnection.Service
+	WebUIService                               webui.Service
+	WiFiPortConfigurationService               wifiportconfiguration.Service
 }
 
 // NewMessages instantiates a new instance of amt Messages.
@@ -72,20 +97,34 @@ func NewMessages(client client.WSMan) Messages {
 	m := Messages{
 		wsmanMessageCreator: wsmanMessageCreator,
 	}
+	m.ActiveFilterStatistics = systemdefense.NewActiveFilterStatisticsWithClient(wsmanMessageCreator, client)
+	m.AgentPresenceService = agentpresence.NewAgentPresenceServiceWithClient(wsmanMessageCreator, client)
+	m.AgentPresenceWatchdog = agentpresence.NewAgentPresenceWatchdogWithClient(wsmanMessageCreator, client)
+	m.AgentPresenceWatchdogAction = agentpresence.NewAgentPresenceWatchdogActionWithClient(wsmanMessageCreator, client)
 	m.AlarmClockService = alarmclock.NewServiceWithClient(wsmanMessageCreator, client)
 	m.AuditLog = auditlog.NewAuditLogWithClient(wsmanMessageCreator, client)
+	m.AuditPolicyRule = auditpolicyrule.NewAuditPolicyRuleWithClient(wsmanMessageCreator, client)
 	m.AuthorizationService = authorization.NewServiceWithClient(wsmanMessageCreator, client)
 	m.BootCapabilities = boot.NewBootCapabilitiesWithClient(wsmanMessageCreator, client)
 	m.BootSettingData = boot.NewBootSettingDataWithClient(wsmanMessageCreator, client)
 	m.EnvironmentDetectionSettingData = environmentdetection.NewEnvironmentDetectionSettingDataWithClient(wsmanMessageCreator, client)
 	m.EthernetPortSettings = ethernetport.NewEthernetPortSettingsWithClient(wsmanMessageCreator, client)
+	m.EventManagerService = eventmanager.NewEventManagerServiceWithClient(wsmanMessageCreator, client)
 	m.GeneralSettings = general.NewGeneralSettingsWithClient(wsmanMessageCreator, client)
+	m.GeneralSystemDefenseCapabilities = systemdefense.NewGeneralSystemDefenseCapabilitiesWithClient(wsmanMessageCreator, client)
+	m.Hdr8021Filter = systemdefense.NewHdr8021FilterWithClient(wsmanMessageCreator, client)
+	m.HeuristicPacketFilterInterfacePolicy = heuristicpacketfilter.NewHeuristicPacketFilterInterfacePolicyWithClient(wsmanMessageCreator, client)
+	m.HeuristicPacketFilterSettings = heuristicpacketfilter.NewHeuristicPacketFilterSettingsWithClient(wsmanMessageCreator, client)
+	m.HeuristicPacketFilterStatistics = heuristicpacketfilter.NewHeuristicPacketFilterStatisticsWithClient(wsmanMessageCreator, client)
 	m.IEEE8021xCredentialContext = ieee8021x.NewIEEE8021xCredentialContextWithClient(wsmanMessageCreator, client)
 	m.IEEE8021xProfile = ieee8021x.NewIEEE8021xProfileWithClient(wsmanMessageCreator, client)
+	m.IPHeadersFilter = systemdefense.NewIPHeadersFilterWithClient(wsmanMessageCreator, client)
 	m.KerberosSettingData = kerberos.NewKerberosSettingDataWithClient(wsmanMessageCreator, client)
 	m.ManagementPresenceRemoteSAP = managementpresence.NewManagementPresenceRemoteSAPWithClient(wsmanMessageCreator, client)
 	m.MessageLog = messagelog.NewMessageLogWithClient(wsmanMessageCreator, client)
 	m.MPSUsernamePassword = mps.NewMPSUsernamePasswordWithClient(wsmanMessageCreator, client)
+	m.NetworkFilter = systemdefense.NewNetworkFilterWithClient(wsmanMessageCreator, client)
+	m.NetworkPortSystemDefensePolicy = systemdefense.NewNetworkPortSystemDefensePolicyWithClient(wsmanMessageCreator, client)
 	m.PublicKeyCertificate = publickey.NewPublicKeyCertificateWithClient(wsmanMessageCreator, client)
 	m.PublicKeyManagementService = publickey.NewPublicKeyManagementServiceWithClient(wsmanMessageCreator, client)
 	m.PublicPrivateKeyPair = publicprivate.NewPublicPrivateKeyPairWithClient(wsmanMessageCreator, client)
@@ -94,11 +133,15 @@ func NewMessages(client client.WSMan) Messages {
 	m.RemoteAccessPolicyRule = remoteaccess.NewPolicyRuleWithClient(wsmanMessageCreator, client)
 	m.RemoteAccessService = remoteaccess.NewRemoteAccessServiceWithClient(wsmanMessageCreator, client)
 	m.SetupAndConfigurationService = setupandconfiguration.NewSetupAndConfigurationServiceWithClient(wsmanMessageCreator, client)
+	m.SystemDefensePolicy = systemdefense.NewSystemDefensePolicyWithClient(wsmanMessageCreator, client)
+	m.ThirdPartyDataStorageAdministrationService = thirdpartydatastorage.NewThirdPartyDataStorageAdministrationServiceWithClient(wsmanMessageCreator, client)
+	m.ThirdPartyDataStorageService = thirdpartydatastorage.NewThirdPartyDataStorageServiceWithClient(wsmanMessageCreator, client)
 	m.TimeSynchronizationService = timesynchronization.NewTimeSynchronizationServiceWithClient(wsmanMessageCreator, client)
 	m.TLSCredentialContext = tls.NewTLSCredentialContextWithClient(wsmanMessageCreator, client)
 	m.TLSProtocolEndpointCollection = tls.NewTLSProtocolEndpointCollectionWithClient(wsmanMessageCreator, client)
 	m.TLSSettingData = tls.NewTLSSettingDataWithClient(wsmanMessageCreator, client)
 	m.UserInitiatedConnectionService = userinitiatedconnection.NewUserInitiatedConnectionServiceWithClient(wsmanMessageCreator, client)
+	m.WebUIService = webui.NewWebUIServiceWithClient(wsmanMessageCreator, client)
 	m.WiFiPortConfigurationService = wifiportconfiguration.NewWiFiPortConfigurationServiceWithClient(wsmanMessageCreator, client)
 
 	return m
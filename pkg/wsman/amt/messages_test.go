@@ -9,13 +9,17 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/agentpresence"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/alarmclock"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditpolicyrule"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/authorization"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/environmentdetection"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/ethernetport"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/eventmanager"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/general"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/heuristicpacketfilter"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/ieee8021x"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/kerberos"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/managementpresence"
@@ -26,9 +30,12 @@ import (
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/remoteaccess"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/setupandconfiguration"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/systemdefense"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/thirdpartydatastorage"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/timesynchronization"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/tls"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/userinitiatedconnection"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/webui"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/wifiportconfiguration"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
 )
@@ -41,6 +48,22 @@ func TestNewMessages(t *testing.T) {
 		t.Error("wsmanMessageCreator is not initialized")
 	}
 
+	if reflect.DeepEqual(m.ActiveFilterStatistics, systemdefense.ActiveFilterStatistics{}) {
+		t.Error("ActiveFilterStatistics is not initialized")
+	}
+
+	if reflect.DeepEqual(m.AgentPresenceService, agentpresence.Service{}) {
+		t.Error("AgentPresenceService is not initialized")
+	}
+
+	if reflect.DeepEqual(m.AgentPresenceWatchdog, agentpresence.Watchdog{}) {
+		t.Error("AgentPresenceWatchdog is not initialized")
+	}
+
+	if reflect.DeepEqual(m.AgentPresenceWatchdogAction, agentpresence.WatchdogAction{}) {
+		t.Error("AgentPresenceWatchdogAction is not initialized")
+	}
+
 	if reflect.DeepEqual(m.AlarmClockService, alarmclock.Service{}) {
 		t.Error("AlarmClockService is not initialized")
 	}
@@ -49,6 +72,10 @@ func TestNewMessages(t *testing.T) {
 		t.Error("AuditLog is not initialized")
 	}
 
+	if reflect.DeepEqual(m.AuditPolicyRule, auditpolicyrule.Service{}) {
+		t.Error("AuditPolicyRule is not initialized")
+	}
+
 	if reflect.DeepEqual(m.AuthorizationService, authorization.Service{}) {
 		t.Error("AuthorizationService is not initialized")
 	}
@@ -69,10 +96,34 @@ func TestNewMessages(t *testing.T) {
 		t.Error("EthernetPortSettings is not initialized")
 	}
 
+	if reflect.DeepEqual(m.EventManagerService, eventmanager.Service{}) {
+		t.Error("EventManagerService is not initialized")
+	}
+
 	if reflect.DeepEqual(m.GeneralSettings, general.Settings{}) {
 		t.Error("GeneralSettings is not initialized")
 	}
 
+	if reflect.DeepEqual(m.GeneralSystemDefenseCapabilities, systemdefense.Capabilities{}) {
+		t.Error("GeneralSystemDefenseCapabilities is not initialized")
+	}
+
+	if reflect.DeepEqual(m.Hdr8021Filter, systemdefense.Hdr8021Filter{}) {
+		t.Error("Hdr8021Filter is not initialized")
+	}
+
+	if reflect.DeepEqual(m.HeuristicPacketFilterInterfacePolicy, heuristicpacketfilter.InterfacePolicy{}) {
+		t.Error("HeuristicPacketFilterInterfacePolicy is not initialized")
+	}
+
+	if reflect.DeepEqual(m.HeuristicPacketFilterSettings, heuristicpacketfilter.Settings{}) {
+		t.Error("HeuristicPacketFilterSettings is not initialized")
+	}
+
+	if reflect.DeepEqual(m.HeuristicPacketFilterStatistics, heuristicpacketfilter.Statistics{}) {
+		t.Error("HeuristicPacketFilterStatistics is not initialized")
+	}
+
 	if reflect.DeepEqual(m.IEEE8021xCredentialContext, ieee8021x.CredentialContext{}) {
 		t.Error("IEEE8021xCredentialContext is not initialized")
 	}
@@ -81,6 +132,10 @@ func TestNewMessages(t *testing.T) {
 		t.Error("IEEE8021xProfile is not initialized")
 	}
 
+	if reflect.DeepEqual(m.IPHeadersFilter, systemdefense.IPHeadersFilter{}) {
+		t.Error("IPHeadersFilter is not initialized")
+	}
+
 	if reflect.DeepEqual(m.KerberosSettingData, kerberos.SettingData{}) {
 		t.Error("KerberosSettingData is not initialized")
 	}
@@ -97,6 +152,14 @@ func TestNewMessages(t *testing.T) {
 		t.Error("MPSUsernamePassword is not initialized")
 	}
 
+	if reflect.DeepEqual(m.NetworkFilter, systemdefense.NetworkFilter{}) {
+		t.Error("NetworkFilter is not initialized")
+	}
+
+	if reflect.DeepEqual(m.NetworkPortSystemDefensePolicy, systemdefense.NetworkPortPolicy{}) {
+		t.Error("NetworkPortSystemDefensePolicy is not initialized")
+	}
+
 	if reflect.DeepEqual(m.PublicKeyCertificate, publickey.Certificate{}) {
 		t.Error("PublicKeyCertificate is not initialized")
 	}
@@ -129,6 +192,18 @@ func TestNewMessages(t *testing.T) {
 		t.Error("SetupAndConfigurationService is not initialized")
 	}
 
+	if reflect.DeepEqual(m.SystemDefensePolicy, systemdefense.Policy{}) {
+		t.Error("SystemDefensePolicy is not initialized")
+	}
+
+	if reflect.DeepEqual(m.ThirdPartyDataStorageAdministrationService, thirdpartydatastorage.AdministrationService{}) {
+		t.Error("ThirdPartyDataStorageAdministrationService is not initialized")
+	}
+
+	if reflect.DeepEqual(m.ThirdPartyDataStorageService, thirdpartydatastorage.Service{}) {
+		t.Error("ThirdPartyDataStorageService is not initialized")
+	}
+
 	if reflect.DeepEqual(m.TimeSynchronizationService, timesynchronization.Service{}) {
 		t.Error("TimeSynchronizationService is not initialized")
 	}
@@ -149,6 +224,10 @@ func TestNewMessages(t *testing.T) {
 		t.Error("UserInitiatedConnectionService is not initialized")
 	}
 
+	if reflect.DeepEqual(m.WebUIService, webui.Service{}) {
+		t.Error("WebUIService is not initialized")
+	}
+
 	if reflect.DeepEqual(m.WiFiPortConfigurationService, wifiportconfiguration.Service{}) {
 		t.Error("WiFiPortConfigurationService is not initialized")
 	}
@@ -0,0 +1,76 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package redirection
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// accessLogTimeLayout is the layout of the date and time fields of an AccessLog element.
+const accessLogTimeLayout = "01/02/2006 15:04:05"
+
+// ErrInvalidAccessLogEntry is returned when an AccessLog element does not follow the 'Date (MM/DD/YYYY), Time (hh:mm:ss), IP:Port' format.
+var ErrInvalidAccessLogEntry = errors.New("invalid redirection access log entry")
+
+// AccessLogEntry is a decoded element of RedirectionResponse.AccessLog. Intel® AMT does not report the redirection
+// protocol or the user in the AccessLog; they are recorded in the Redirection Manager events of the audit log.
+type AccessLogEntry struct {
+	Time    time.Time // Time of the redirection operation, in the Intel® AMT clock (UTC).
+	Address string    // IP address the session was opened from.
+	Port    int       // Source port the session was opened from.
+}
+
+// ParseAccessLogEntry decodes an AccessLog element holding 'Date (MM/DD/YYYY), Time (hh:mm:ss), IP:Port'.
+func ParseAccessLogEntry(entry string) (AccessLogEntry, error) {
+	fields := strings.Split(entry, ",")
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+
+	if len(fields) != 3 {
+		return AccessLogEntry{}, ErrInvalidAccessLogEntry
+	}
+
+	timestamp, err := time.Parse(accessLogTimeLayout, fields[0]+" "+fields[1])
+	if err != nil {
+		return AccessLogEntry{}, ErrInvalidAccessLogEntry
+	}
+
+	separator := strings.LastIndex(fields[2], ":")
+	if separator <= 0 {
+		return AccessLogEntry{}, ErrInvalidAccessLogEntry
+	}
+
+	port, err := strconv.Atoi(fields[2][separator+1:])
+	if err != nil {
+		return AccessLogEntry{}, ErrInvalidAccessLogEntry
+	}
+
+	return AccessLogEntry{
+		Time:    timestamp,
+		Address: strings.Trim(fields[2][:separator], "[]"),
+		Port:    port,
+	}, nil
+}
+
+// AccessLogEntries decodes the AccessLog of the redirection service in the order reported by Intel® AMT.
+func (r RedirectionResponse) AccessLogEntries() ([]AccessLogEntry, error) {
+	entries := make([]AccessLogEntry, 0, len(r.AccessLog))
+
+	for _, entry := range r.AccessLog {
+		parsed, err := ParseAccessLogEntry(entry)
+		if err != nil {
+			return entries, err
+		}
+
+		entries = append(entries, parsed)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,63 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package redirection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAccessLogEntry(t *testing.T) {
+	tests := []struct {
+		name     string
+		entry    string
+		expected AccessLogEntry
+		err      error
+	}{
+		{
+			"should decode date, time and source address",
+			"03/14/2024, 09:26:53, 192.168.1.20:16994",
+			AccessLogEntry{Time: time.Date(2024, time.March, 14, 9, 26, 53, 0, time.UTC), Address: "192.168.1.20", Port: 16994},
+			nil,
+		},
+		{
+			"should decode an IPv6 source address",
+			"12/01/2023, 23:05:00, [fe80::1]:16995",
+			AccessLogEntry{Time: time.Date(2023, time.December, 1, 23, 5, 0, 0, time.UTC), Address: "fe80::1", Port: 16995},
+			nil,
+		},
+		{"should reject missing fields", "03/14/2024, 09:26:53", AccessLogEntry{}, ErrInvalidAccessLogEntry},
+		{"should reject extra fields", "03/14/2024, 09:26:53, 192.168.1.20:16994, SOL", AccessLogEntry{}, ErrInvalidAccessLogEntry},
+		{"should reject invalid time", "14/03/2024, 09:26:53, 192.168.1.20:16994", AccessLogEntry{}, ErrInvalidAccessLogEntry},
+		{"should reject missing port", "03/14/2024, 09:26:53, 192.168.1.20", AccessLogEntry{}, ErrInvalidAccessLogEntry},
+		{"should reject invalid port", "03/14/2024, 09:26:53, 192.168.1.20:http", AccessLogEntry{}, ErrInvalidAccessLogEntry},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entry, err := ParseAccessLogEntry(test.entry)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.expected, entry)
+		})
+	}
+}
+
+func TestAccessLogEntries(t *testing.T) {
+	service := RedirectionResponse{AccessLog: []string{
+		"03/14/2024, 09:26:53, 192.168.1.20:16994",
+		"invalid",
+	}}
+
+	entries, err := service.AccessLogEntries()
+	assert.ErrorIs(t, err, ErrInvalidAccessLogEntry)
+	assert.Len(t, entries, 1)
+
+	entries, err = RedirectionResponse{}.AccessLogEntries()
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
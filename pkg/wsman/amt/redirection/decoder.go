@@ -8,6 +8,7 @@ package redirection
 const (
 	AMTRedirectionService string = "AMT_RedirectionService"
 	RequestStateChange    string = "RequestStateChange"
+	TerminateSession      string = "TerminateSession"
 	ValueNotFound         string = "Value not found in map"
 )
 
@@ -64,6 +65,50 @@ func (es EnabledState) String() string {
 	return ValueNotFound
 }
 
+// SOLEnabled reports whether SOL is enabled in the redirection EnabledState.
+func (es EnabledState) SOLEnabled() bool {
+	return es == SOLIsEnabledAndIDERIsDisabled || es == IDERAndSOLAreEnabled
+}
+
+// IDEREnabled reports whether IDER is enabled in the redirection EnabledState.
+func (es EnabledState) IDEREnabled() bool {
+	return es == IDERIsEnabledAndSOLIsDisabled || es == IDERAndSOLAreEnabled
+}
+
+// NewRequestedState returns the RequestedState that enables or disables SOL and IDER together.
+func NewRequestedState(sol, ider bool) RequestedState {
+	switch {
+	case sol && ider:
+		return EnableIDERAndSOL
+	case sol:
+		return EnableSOLAndDisableIDER
+	case ider:
+		return EnableIDERAndDisableSOL
+	}
+
+	return DisableIDERAndSOL
+}
+
+const (
+	SessionTypeIDER SessionType = 0
+	SessionTypeSOL  SessionType = 1
+)
+
+// sessionTypeToString is a map of SessionType values to string.
+var sessionTypeToString = map[SessionType]string{
+	SessionTypeIDER: "IDER",
+	SessionTypeSOL:  "SOL",
+}
+
+// String returns a string representation of SessionType.
+func (st SessionType) String() string {
+	if v, ok := sessionTypeToString[st]; ok {
+		return v
+	}
+
+	return ValueNotFound
+}
+
 const (
 	CompletedWithNoError              ReturnValue = 0
 	NotSupported                      ReturnValue = 1
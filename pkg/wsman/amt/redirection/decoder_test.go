@@ -65,3 +65,61 @@ func TestReturnValue_String(t *testing.T) {
 		}
 	}
 }
+
+func TestEnabledState_SOLAndIDEREnabled(t *testing.T) {
+	tests := []struct {
+		state EnabledState
+		sol   bool
+		ider  bool
+	}{
+		{IDERAndSOLAreDisabled, false, false},
+		{IDERIsEnabledAndSOLIsDisabled, false, true},
+		{SOLIsEnabledAndIDERIsDisabled, true, false},
+		{IDERAndSOLAreEnabled, true, true},
+		{Enabled, false, false},
+	}
+
+	for _, test := range tests {
+		if test.state.SOLEnabled() != test.sol || test.state.IDEREnabled() != test.ider {
+			t.Errorf("Expected SOL %t and IDER %t for %s", test.sol, test.ider, test.state)
+		}
+	}
+}
+
+func TestNewRequestedState(t *testing.T) {
+	tests := []struct {
+		sol      bool
+		ider     bool
+		expected RequestedState
+	}{
+		{false, false, DisableIDERAndSOL},
+		{false, true, EnableIDERAndDisableSOL},
+		{true, false, EnableSOLAndDisableIDER},
+		{true, true, EnableIDERAndSOL},
+	}
+
+	for _, test := range tests {
+		result := NewRequestedState(test.sol, test.ider)
+		if result != test.expected {
+			t.Errorf("Expected %d, but got %d", test.expected, result)
+		}
+	}
+}
+
+func TestSessionType_String(t *testing.T) {
+	tests := []struct {
+		sessionType SessionType
+		expected    string
+	}{
+		{SessionTypeIDER, "IDER"},
+		{SessionTypeSOL, "SOL"},
+		{SessionType(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.sessionType.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
@@ -149,3 +149,32 @@ func (service Service) RequestStateChange(requestedState RequestedState) (respon
 
 	return
 }
+
+// TerminateSession closes the active redirection session of the given type.
+// Any return code other than 0 indicates an error condition.
+func (service Service) TerminateSession(sessionType SessionType) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTRedirectionService, TerminateSession), AMTRedirectionService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(TerminateSession), AMTRedirectionService, &TerminateSession_INPUT{SessionType: sessionType})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	if response.Body.TerminateSession_OUTPUT.ReturnValue != 0 {
+		err = errors.New("TerminateSession failed with return code " + response.Body.TerminateSession_OUTPUT.ReturnValue.String())
+	}
+
+	return
+}
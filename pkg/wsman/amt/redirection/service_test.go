@@ -24,7 +24,7 @@ func TestJson(t *testing.T) {
 			GetAndPutResponse: RedirectionResponse{},
 		},
 	}
-	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"GetAndPutResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"ElementName\":\"\",\"EnabledState\":0,\"ListenerEnabled\":false,\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"AccessLog\":null},\"RequestStateChange_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"RedirectionItems\":null}}"
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"GetAndPutResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"ElementName\":\"\",\"EnabledState\":0,\"ListenerEnabled\":false,\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"AccessLog\":null},\"RequestStateChange_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"TerminateSession_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"RedirectionItems\":null}}"
 	result := response.JSON()
 	assert.Equal(t, expectedResult, result)
 }
@@ -35,7 +35,7 @@ func TestYaml(t *testing.T) {
 			GetAndPutResponse: RedirectionResponse{},
 		},
 	}
-	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\ngetandputresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    elementname: \"\"\n    enabledstate: 0\n    listenerenabled: false\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    accesslog: []\nrequeststatechange_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    redirectionitems: []\n"
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\ngetandputresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    elementname: \"\"\n    enabledstate: 0\n    listenerenabled: false\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    accesslog: []\nrequeststatechange_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nterminatesession_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    redirectionitems: []\n"
 	result := response.YAML()
 	assert.Equal(t, expectedResult, result)
 }
@@ -187,6 +187,25 @@ func TestPositiveAMT_RedirectionService(t *testing.T) {
 					},
 				},
 			},
+			// TERMINATE SESSION
+			{
+				"should create a valid AMT_RedirectionService Terminate Session wsman message",
+				AMTRedirectionService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTRedirectionService, TerminateSession),
+				"<h:TerminateSession_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_RedirectionService\"><h:SessionType>1</h:SessionType></h:TerminateSession_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = "TerminateSession"
+
+					return elementUnderTest.TerminateSession(SessionTypeSOL)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					TerminateSession_OUTPUT: TerminateSession_OUTPUT{
+						XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTRedirectionService), Local: "TerminateSession_OUTPUT"},
+						ReturnValue: 0,
+					},
+				},
+			},
 		}
 		for _, test := range tests {
 			t.Run(test.name, func(t *testing.T) {
@@ -348,6 +367,25 @@ func TestNegativeAMT_RedirectionService(t *testing.T) {
 					},
 				},
 			},
+			// TERMINATE SESSION
+			{
+				"should create a valid AMT_RedirectionService Terminate Session wsman message",
+				AMTRedirectionService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTRedirectionService, TerminateSession),
+				"<h:TerminateSession_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_RedirectionService\"><h:SessionType>1</h:SessionType></h:TerminateSession_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.TerminateSession(SessionTypeSOL)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					TerminateSession_OUTPUT: TerminateSession_OUTPUT{
+						XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTRedirectionService), Local: "TerminateSession_OUTPUT"},
+						ReturnValue: 0,
+					},
+				},
+			},
 		}
 		for _, test := range tests {
 			t.Run(test.name, func(t *testing.T) {
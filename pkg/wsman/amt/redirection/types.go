@@ -30,6 +30,7 @@ type (
 		XMLName                   xml.Name                  `xml:"Body"`
 		GetAndPutResponse         RedirectionResponse       `xml:"AMT_RedirectionService"`
 		RequestStateChange_OUTPUT RequestStateChange_OUTPUT `xml:"RequestStateChange_OUTPUT"`
+		TerminateSession_OUTPUT   TerminateSession_OUTPUT   `xml:"TerminateSession_OUTPUT"`
 		EnumerateResponse         common.EnumerateResponse
 		PullResponse              PullResponse
 	}
@@ -55,6 +56,13 @@ type (
 		// Values={Completed with No Error, Not Supported, Unknown or Unspecified Error, Cannot complete within Timeout Period, Failed, Invalid Parameter, In Use, DMTF Reserved, Method Parameters Checked - Job Started, Invalid State Transition, Use of Timeout Parameter Not Supported, Busy, Method Reserved, Vendor Specific}
 		ReturnValue ReturnValue `xml:"ReturnValue"`
 	}
+	TerminateSession_OUTPUT struct {
+		XMLName xml.Name `xml:"TerminateSession_OUTPUT"`
+		// ValueMap={0, 1, 2}
+		//
+		// Values={Completed with No Error, Not Supported, Unknown or Unspecified Error}
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
 
 	// ReturnValue is an integer enumeration that indicates the completion status of the method. A value of 0 indicates success. A non-zero value indicates an error.
 	ReturnValue int
@@ -74,6 +82,11 @@ type (
 		SystemCreationClassName string       `xml:"h:SystemCreationClassName,omitempty"` // The CreationClassName of the scoping System.
 		SystemName              string       `xml:"h:SystemName,omitempty"`              // The Name of the scoping System.
 	}
+	TerminateSession_INPUT struct {
+		XMLName     xml.Name    `xml:"h:TerminateSession_INPUT"`
+		H           string      `xml:"xmlns:h,attr"`
+		SessionType SessionType `xml:"h:SessionType"` // The type of the redirection session to terminate.
+	}
 )
 
 // EnabledState is an integer enumeration that indicates the enabled and disabled states of an element. It can also indicate the transitions between these requested states. For example, shutting down (value=4) and starting (value=10) are transient states between enabled and disabled. The following text briefly summarizes the various enabled and disabled states:
@@ -107,3 +120,10 @@ type EnabledState int
 //
 // Values={disable IDER and SOL, enable IDER and disable SOL, enable SOL and disable IDER, enable IDER and SOL}.
 type RequestedState int
+
+// SessionType is the type of redirection session closed by TerminateSession.
+//
+// ValueMap={0, 1}
+//
+// Values={IDER, SOL}.
+type SessionType int
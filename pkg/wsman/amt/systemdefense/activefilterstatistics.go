@@ -0,0 +1,66 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewActiveFilterStatisticsWithClient instantiates a new ActiveFilterStatistics.
+func NewActiveFilterStatisticsWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) ActiveFilterStatistics {
+	return ActiveFilterStatistics{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTActiveFilterStatistics, client),
+	}
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (statistics ActiveFilterStatistics) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: statistics.base.Enumerate(),
+		},
+	}
+
+	// send the message to AMT
+	err = statistics.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (statistics ActiveFilterStatistics) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: statistics.base.Pull(enumerationContext),
+		},
+	}
+
+	// send the message to AMT
+	err = statistics.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,184 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func capabilitiesResponse() CapabilitiesResponse {
+	return CapabilitiesResponse{
+		XMLName:                            xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_GeneralSystemDefenseCapabilities", Local: "AMT_GeneralSystemDefenseCapabilities"},
+		InstanceID:                         "Intel(r) AMT:General System Defense Capabilities",
+		ElementName:                        "Intel(r) AMT General System Defense Capabilities",
+		GlobalMaxSupportedFilters:          64,
+		GlobalMaxSupportedPolicies:         16,
+		GlobalMaxSupportedCounters:         16,
+		GlobalMaxSupportedRateLimitFilters: 8,
+	}
+}
+
+func TestPositiveGeneralSystemDefenseCapabilities(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/capabilities",
+	}
+	elementUnderTest := NewGeneralSystemDefenseCapabilitiesWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_generalsystemdefensecapabilities Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should create and parse a valid AMT_GeneralSystemDefenseCapabilities Get call",
+				AMTGeneralSystemDefenseCapabilities,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:                 xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CapabilitiesGetResponse: capabilitiesResponse(),
+				},
+			},
+			{
+				"should create and parse a valid AMT_GeneralSystemDefenseCapabilities Enumerate call",
+				AMTGeneralSystemDefenseCapabilities,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_GeneralSystemDefenseCapabilities Pull call",
+				AMTGeneralSystemDefenseCapabilities,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:           xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						CapabilitiesItems: []CapabilitiesResponse{capabilitiesResponse()},
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeGeneralSystemDefenseCapabilities(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/capabilities",
+	}
+	elementUnderTest := NewGeneralSystemDefenseCapabilitiesWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_generalsystemdefensecapabilities Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_GeneralSystemDefenseCapabilities Get call",
+				AMTGeneralSystemDefenseCapabilities,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+			},
+			{
+				"should handle error when AMT_GeneralSystemDefenseCapabilities Enumerate call",
+				AMTGeneralSystemDefenseCapabilities,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_GeneralSystemDefenseCapabilities Pull call",
+				AMTGeneralSystemDefenseCapabilities,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,84 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+// INPUTS Constants.
+const (
+	AMTSystemDefensePolicy              string = "AMT_SystemDefensePolicy"
+	AMTNetworkFilter                    string = "AMT_NetworkFilter"
+	AMTIPHeadersFilter                  string = "AMT_IPHeadersFilter"
+	AMTHdr8021Filter                    string = "AMT_Hdr8021Filter"
+	AMTNetworkPortSystemDefensePolicy   string = "AMT_NetworkPortSystemDefensePolicy"
+	AMTActiveFilterStatistics           string = "AMT_ActiveFilterStatistics"
+	AMTGeneralSystemDefenseCapabilities string = "AMT_GeneralSystemDefenseCapabilities"
+	CIMEthernetPort                     string = "CIM_EthernetPort"
+	ValueNotFound                       string = "Value not found in map"
+)
+
+// DefaultEthernetPort is the DeviceID of the wired network port of Intel® AMT.
+const DefaultEthernetPort string = "Intel(r) AMT Ethernet Port 0"
+
+const (
+	FilterDirectionTransmit FilterDirection = iota
+	FilterDirectionReceive
+)
+
+// filterDirectionToString is a map of the FilterDirection enumeration.
+var filterDirectionToString = map[FilterDirection]string{
+	FilterDirectionTransmit: "Transmit",
+	FilterDirectionReceive:  "Receive",
+}
+
+// String returns a human-readable string representation of the FilterDirection enumeration.
+func (e FilterDirection) String() string {
+	if s, ok := filterDirectionToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	FilterProfilePass FilterProfile = iota
+	FilterProfileDrop
+	FilterProfileRateLimit
+)
+
+// filterProfileToString is a map of the FilterProfile enumeration.
+var filterProfileToString = map[FilterProfile]string{
+	FilterProfilePass:      "Pass",
+	FilterProfileDrop:      "Drop",
+	FilterProfileRateLimit: "RateLimit",
+}
+
+// String returns a human-readable string representation of the FilterProfile enumeration.
+func (e FilterProfile) String() string {
+	if s, ok := filterProfileToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	IPVersion4 IPVersion = 4
+	IPVersion6 IPVersion = 6
+)
+
+// ipVersionToString is a map of the IPVersion enumeration.
+var ipVersionToString = map[IPVersion]string{
+	IPVersion4: "IPv4",
+	IPVersion6: "IPv6",
+}
+
+// String returns a human-readable string representation of the IPVersion enumeration.
+func (e IPVersion) String() string {
+	if s, ok := ipVersionToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
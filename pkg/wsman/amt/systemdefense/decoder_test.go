@@ -0,0 +1,63 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import "testing"
+
+func TestFilterDirection_String(t *testing.T) {
+	tests := []struct {
+		state    FilterDirection
+		expected string
+	}{
+		{FilterDirectionTransmit, "Transmit"},
+		{FilterDirectionReceive, "Receive"},
+		{FilterDirection(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestFilterProfile_String(t *testing.T) {
+	tests := []struct {
+		state    FilterProfile
+		expected string
+	}{
+		{FilterProfilePass, "Pass"},
+		{FilterProfileDrop, "Drop"},
+		{FilterProfileRateLimit, "RateLimit"},
+		{FilterProfile(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestIPVersion_String(t *testing.T) {
+	tests := []struct {
+		state    IPVersion
+		expected string
+	}{
+		{IPVersion4, "IPv4"},
+		{IPVersion6, "IPv6"},
+		{IPVersion(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
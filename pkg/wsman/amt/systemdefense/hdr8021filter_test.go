@@ -0,0 +1,296 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/models"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func lldpFilterRequest() Hdr8021FilterRequest {
+	return Hdr8021FilterRequest{
+		ElementName:        "Drop LLDP",
+		FilterDirection:    FilterDirectionReceive,
+		FilterProfile:      FilterProfileDrop,
+		ActionEventOnMatch: true,
+		HdrDestMACAddress:  "01-80-C2-00-00-0E",
+		HdrProtocolID8021:  35020,
+	}
+}
+
+func lldpFilterResponse() Hdr8021FilterResponse {
+	return Hdr8021FilterResponse{
+		XMLName:            xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_Hdr8021Filter", Local: "AMT_Hdr8021Filter"},
+		InstanceID:         "Intel(r) AMT:Handle: 4",
+		ElementName:        "Drop LLDP",
+		FilterDirection:    FilterDirectionReceive,
+		FilterProfile:      FilterProfileDrop,
+		ActionEventOnMatch: true,
+		HdrDestMACAddress:  "01-80-C2-00-00-0E",
+		HdrProtocolID8021:  35020,
+	}
+}
+
+func TestPositiveHdr8021Filter(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/hdr8021filter",
+	}
+	elementUnderTest := NewHdr8021FilterWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_hdr8021filter Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should create and parse a valid AMT_Hdr8021Filter Get call",
+				AMTHdr8021Filter,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 4</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get("Intel(r) AMT:Handle: 4")
+				},
+				Body{
+					XMLName:                  xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					Hdr8021FilterGetResponse: lldpFilterResponse(),
+				},
+			},
+			{
+				"should create and parse a valid AMT_Hdr8021Filter Enumerate call",
+				AMTHdr8021Filter,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_Hdr8021Filter Pull call",
+				AMTHdr8021Filter,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:            xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						Hdr8021FilterItems: []Hdr8021FilterResponse{lldpFilterResponse()},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_Hdr8021Filter Create call",
+				AMTHdr8021Filter,
+				wsmantesting.Create,
+				"",
+				"<h:AMT_Hdr8021Filter xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_Hdr8021Filter\"><h:ElementName>Drop LLDP</h:ElementName><h:FilterDirection>1</h:FilterDirection><h:FilterProfile>1</h:FilterProfile><h:ActionEventOnMatch>true</h:ActionEventOnMatch><h:HdrDestMACAddress>01-80-C2-00-00-0E</h:HdrDestMACAddress><h:HdrProtocolID8021>35020</h:HdrProtocolID8021></h:AMT_Hdr8021Filter>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(lldpFilterRequest())
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CreateResponse: CreateResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/transfer", Local: "ResourceCreated"},
+						Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+						ReferenceParameters: models.ReferenceParameters_OUTPUT{
+							ResourceURI: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_Hdr8021Filter",
+							SelectorSet: models.SelectorSet_OUTPUT{
+								XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "SelectorSet"},
+								Selector: []message.Selector_OUTPUT{
+									{
+										XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"},
+										Name:    "InstanceID",
+										Value:   "Intel(r) AMT:Handle: 4",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_Hdr8021Filter Put call",
+				AMTHdr8021Filter,
+				wsmantesting.Put,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 4</w:Selector></w:SelectorSet>",
+				"<h:AMT_Hdr8021Filter xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_Hdr8021Filter\"><h:ElementName>Drop LLDP</h:ElementName><h:FilterDirection>1</h:FilterDirection><h:FilterProfile>1</h:FilterProfile><h:ActionEventOnMatch>true</h:ActionEventOnMatch><h:HdrDestMACAddress>01-80-C2-00-00-0E</h:HdrDestMACAddress><h:HdrProtocolID8021>35020</h:HdrProtocolID8021></h:AMT_Hdr8021Filter>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePut
+
+					return elementUnderTest.Put("Intel(r) AMT:Handle: 4", lldpFilterRequest())
+				},
+				Body{
+					XMLName:                  xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					Hdr8021FilterGetResponse: lldpFilterResponse(),
+				},
+			},
+			{
+				"should create and parse a valid AMT_Hdr8021Filter Delete call",
+				AMTHdr8021Filter,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 4</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete("Intel(r) AMT:Handle: 4")
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeHdr8021Filter(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/hdr8021filter",
+	}
+	elementUnderTest := NewHdr8021FilterWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_hdr8021filter Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_Hdr8021Filter Get call",
+				AMTHdr8021Filter,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 4</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get("Intel(r) AMT:Handle: 4")
+				},
+			},
+			{
+				"should handle error when AMT_Hdr8021Filter Enumerate call",
+				AMTHdr8021Filter,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_Hdr8021Filter Pull call",
+				AMTHdr8021Filter,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_Hdr8021Filter Create call",
+				AMTHdr8021Filter,
+				wsmantesting.Create,
+				"",
+				"<h:AMT_Hdr8021Filter xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_Hdr8021Filter\"><h:ElementName>Drop LLDP</h:ElementName><h:FilterDirection>1</h:FilterDirection><h:FilterProfile>1</h:FilterProfile><h:ActionEventOnMatch>true</h:ActionEventOnMatch><h:HdrDestMACAddress>01-80-C2-00-00-0E</h:HdrDestMACAddress><h:HdrProtocolID8021>35020</h:HdrProtocolID8021></h:AMT_Hdr8021Filter>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Create(lldpFilterRequest())
+				},
+			},
+			{
+				"should handle error when AMT_Hdr8021Filter Put call",
+				AMTHdr8021Filter,
+				wsmantesting.Put,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 4</w:Selector></w:SelectorSet>",
+				"<h:AMT_Hdr8021Filter xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_Hdr8021Filter\"><h:ElementName>Drop LLDP</h:ElementName><h:FilterDirection>1</h:FilterDirection><h:FilterProfile>1</h:FilterProfile><h:ActionEventOnMatch>true</h:ActionEventOnMatch><h:HdrDestMACAddress>01-80-C2-00-00-0E</h:HdrDestMACAddress><h:HdrProtocolID8021>35020</h:HdrProtocolID8021></h:AMT_Hdr8021Filter>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Put("Intel(r) AMT:Handle: 4", lldpFilterRequest())
+				},
+			},
+			{
+				"should handle error when AMT_Hdr8021Filter Delete call",
+				AMTHdr8021Filter,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 4</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete("Intel(r) AMT:Handle: 4")
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
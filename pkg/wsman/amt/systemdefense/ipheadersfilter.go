@@ -0,0 +1,161 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewIPHeadersFilterWithClient instantiates a new IPHeadersFilter.
+func NewIPHeadersFilterWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) IPHeadersFilter {
+	return IPHeadersFilter{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTIPHeadersFilter, client),
+	}
+}
+
+// Get retrieves the representation of the filter with the given InstanceID.
+func (filter IPHeadersFilter) Get(instanceID string) (response Response, err error) {
+	selector := message.Selector{Name: "InstanceID", Value: instanceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Get(&selector),
+		},
+	}
+
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (filter IPHeadersFilter) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Enumerate(),
+		},
+	}
+
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (filter IPHeadersFilter) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Pull(enumerationContext),
+		},
+	}
+
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Create creates a new filter. The response holds the endpoint reference of the created filter.
+func (filter IPHeadersFilter) Create(request IPHeadersFilterRequest) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Create(&request, nil),
+		},
+	}
+
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Put changes the properties of the filter with the given InstanceID.
+func (filter IPHeadersFilter) Put(instanceID string, request IPHeadersFilterRequest) (response Response, err error) {
+	selector := message.Selector{Name: "InstanceID", Value: instanceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Put(&request, true, &selector),
+		},
+	}
+
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Delete removes the filter with the given InstanceID.
+func (filter IPHeadersFilter) Delete(instanceID string) (response Response, err error) {
+	selector := message.Selector{Name: "InstanceID", Value: instanceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Delete(selector),
+		},
+	}
+
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,296 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/models"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func managementFilterRequest() IPHeadersFilterRequest {
+	return IPHeadersFilterRequest{
+		ElementName:     "Allow from 192.168.0.10",
+		FilterDirection: FilterDirectionReceive,
+		FilterProfile:   FilterProfilePass,
+		HdrIPVersion:    IPVersion4,
+		HdrSrcAddress:   "192.168.0.10",
+		HdrSrcMask:      "255.255.255.255",
+	}
+}
+
+func managementFilterResponse() IPHeadersFilterResponse {
+	return IPHeadersFilterResponse{
+		XMLName:         xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_IPHeadersFilter", Local: "AMT_IPHeadersFilter"},
+		InstanceID:      "Intel(r) AMT:Handle: 1",
+		ElementName:     "Allow from 192.168.0.10",
+		FilterDirection: FilterDirectionReceive,
+		FilterProfile:   FilterProfilePass,
+		HdrIPVersion:    IPVersion4,
+		HdrSrcAddress:   "192.168.0.10",
+		HdrSrcMask:      "255.255.255.255",
+	}
+}
+
+func TestPositiveIPHeadersFilter(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/ipheadersfilter",
+	}
+	elementUnderTest := NewIPHeadersFilterWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_ipheadersfilter Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should create and parse a valid AMT_IPHeadersFilter Get call",
+				AMTIPHeadersFilter,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 1</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get("Intel(r) AMT:Handle: 1")
+				},
+				Body{
+					XMLName:                    xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					IPHeadersFilterGetResponse: managementFilterResponse(),
+				},
+			},
+			{
+				"should create and parse a valid AMT_IPHeadersFilter Enumerate call",
+				AMTIPHeadersFilter,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_IPHeadersFilter Pull call",
+				AMTIPHeadersFilter,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:              xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						IPHeadersFilterItems: []IPHeadersFilterResponse{managementFilterResponse()},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_IPHeadersFilter Create call",
+				AMTIPHeadersFilter,
+				wsmantesting.Create,
+				"",
+				"<h:AMT_IPHeadersFilter xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_IPHeadersFilter\"><h:ElementName>Allow from 192.168.0.10</h:ElementName><h:FilterDirection>1</h:FilterDirection><h:FilterProfile>0</h:FilterProfile><h:ActionEventOnMatch>false</h:ActionEventOnMatch><h:HdrIPVersion>4</h:HdrIPVersion><h:HdrSrcAddress>192.168.0.10</h:HdrSrcAddress><h:HdrSrcMask>255.255.255.255</h:HdrSrcMask></h:AMT_IPHeadersFilter>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(managementFilterRequest())
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CreateResponse: CreateResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/transfer", Local: "ResourceCreated"},
+						Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+						ReferenceParameters: models.ReferenceParameters_OUTPUT{
+							ResourceURI: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_IPHeadersFilter",
+							SelectorSet: models.SelectorSet_OUTPUT{
+								XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "SelectorSet"},
+								Selector: []message.Selector_OUTPUT{
+									{
+										XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"},
+										Name:    "InstanceID",
+										Value:   "Intel(r) AMT:Handle: 1",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_IPHeadersFilter Put call",
+				AMTIPHeadersFilter,
+				wsmantesting.Put,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 1</w:Selector></w:SelectorSet>",
+				"<h:AMT_IPHeadersFilter xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_IPHeadersFilter\"><h:ElementName>Allow from 192.168.0.10</h:ElementName><h:FilterDirection>1</h:FilterDirection><h:FilterProfile>0</h:FilterProfile><h:ActionEventOnMatch>false</h:ActionEventOnMatch><h:HdrIPVersion>4</h:HdrIPVersion><h:HdrSrcAddress>192.168.0.10</h:HdrSrcAddress><h:HdrSrcMask>255.255.255.255</h:HdrSrcMask></h:AMT_IPHeadersFilter>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePut
+
+					return elementUnderTest.Put("Intel(r) AMT:Handle: 1", managementFilterRequest())
+				},
+				Body{
+					XMLName:                    xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					IPHeadersFilterGetResponse: managementFilterResponse(),
+				},
+			},
+			{
+				"should create and parse a valid AMT_IPHeadersFilter Delete call",
+				AMTIPHeadersFilter,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 1</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete("Intel(r) AMT:Handle: 1")
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeIPHeadersFilter(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/ipheadersfilter",
+	}
+	elementUnderTest := NewIPHeadersFilterWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_ipheadersfilter Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_IPHeadersFilter Get call",
+				AMTIPHeadersFilter,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 1</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get("Intel(r) AMT:Handle: 1")
+				},
+			},
+			{
+				"should handle error when AMT_IPHeadersFilter Enumerate call",
+				AMTIPHeadersFilter,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_IPHeadersFilter Pull call",
+				AMTIPHeadersFilter,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_IPHeadersFilter Create call",
+				AMTIPHeadersFilter,
+				wsmantesting.Create,
+				"",
+				"<h:AMT_IPHeadersFilter xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_IPHeadersFilter\"><h:ElementName>Allow from 192.168.0.10</h:ElementName><h:FilterDirection>1</h:FilterDirection><h:FilterProfile>0</h:FilterProfile><h:ActionEventOnMatch>false</h:ActionEventOnMatch><h:HdrIPVersion>4</h:HdrIPVersion><h:HdrSrcAddress>192.168.0.10</h:HdrSrcAddress><h:HdrSrcMask>255.255.255.255</h:HdrSrcMask></h:AMT_IPHeadersFilter>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Create(managementFilterRequest())
+				},
+			},
+			{
+				"should handle error when AMT_IPHeadersFilter Put call",
+				AMTIPHeadersFilter,
+				wsmantesting.Put,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 1</w:Selector></w:SelectorSet>",
+				"<h:AMT_IPHeadersFilter xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_IPHeadersFilter\"><h:ElementName>Allow from 192.168.0.10</h:ElementName><h:FilterDirection>1</h:FilterDirection><h:FilterProfile>0</h:FilterProfile><h:ActionEventOnMatch>false</h:ActionEventOnMatch><h:HdrIPVersion>4</h:HdrIPVersion><h:HdrSrcAddress>192.168.0.10</h:HdrSrcAddress><h:HdrSrcMask>255.255.255.255</h:HdrSrcMask></h:AMT_IPHeadersFilter>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Put("Intel(r) AMT:Handle: 1", managementFilterRequest())
+				},
+			},
+			{
+				"should handle error when AMT_IPHeadersFilter Delete call",
+				AMTIPHeadersFilter,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 1</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete("Intel(r) AMT:Handle: 1")
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
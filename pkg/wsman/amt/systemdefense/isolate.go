@@ -0,0 +1,222 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// DefaultIsolationPolicyName is the name of the policy created by Isolate when IsolationOptions.PolicyName is empty.
+const DefaultIsolationPolicyName = "Isolation"
+
+var (
+	// ErrNoManagementAddress is returned by Isolate without management addresses, which would make the device unreachable.
+	ErrNoManagementAddress = errors.New("systemdefense: at least one management address is required")
+	// ErrInvalidAddress is returned by Isolate for management addresses that are not IP addresses.
+	ErrInvalidAddress = errors.New("systemdefense: invalid management address")
+	// ErrNoInstanceID is returned by Isolate when AMT does not return the InstanceID of a created filter or policy.
+	ErrNoInstanceID = errors.New("systemdefense: created instance has no InstanceID")
+)
+
+// Isolator isolates a device from the network, except for its management consoles.
+type Isolator struct {
+	Filters      IPHeadersFilter
+	Policies     Policy
+	PortPolicies NetworkPortPolicy
+}
+
+// IsolationOptions configures Isolate.
+type IsolationOptions struct {
+	ManagementAddresses []string // ManagementAddresses are the IPv4 and IPv6 addresses that remain reachable.
+	PolicyName          string   // PolicyName of the created policy, DefaultIsolationPolicyName if empty.
+	PolicyPrecedence    int      // PolicyPrecedence of the created policy, which must be higher than that of the other policies of the ports to take effect.
+	Ports               []string // Ports are the DeviceIDs of the isolated network ports, DefaultEthernetPort if empty.
+}
+
+// Isolation records the instances created by Isolate, so that Release can remove them.
+type Isolation struct {
+	PolicyInstanceID  string
+	FilterInstanceIDs []string
+	Ports             []string
+}
+
+// NewIsolatorWithClient instantiates a new Isolator.
+func NewIsolatorWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Isolator {
+	return Isolator{
+		Filters:      NewIPHeadersFilterWithClient(wsmanMessageCreator, client),
+		Policies:     NewSystemDefensePolicyWithClient(wsmanMessageCreator, client),
+		PortPolicies: NewNetworkPortSystemDefensePolicyWithClient(wsmanMessageCreator, client),
+	}
+}
+
+// Isolate drops all the traffic of the ports except the traffic to and from the management addresses.
+// It creates a pass filter for each direction of each address, a policy that drops the packets matching none of them,
+// and applies the policy to the ports. When a step fails, the instances created so far are removed.
+func (isolator Isolator) Isolate(options IsolationOptions) (isolation Isolation, err error) {
+	if len(options.ManagementAddresses) == 0 {
+		return isolation, ErrNoManagementAddress
+	}
+
+	requests := []IPHeadersFilterRequest{}
+
+	for _, address := range options.ManagementAddresses {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			return isolation, fmt.Errorf("%w: %q", ErrInvalidAddress, address)
+		}
+
+		requests = append(requests, managementFilters(ip)...)
+	}
+
+	isolation.Ports = options.Ports
+	if len(isolation.Ports) == 0 {
+		isolation.Ports = []string{DefaultEthernetPort}
+	}
+
+	handles := []int{}
+
+	for i := range requests {
+		instanceID, handle, createErr := isolator.createFilter(requests[i])
+		if createErr != nil {
+			return Isolation{}, isolator.rollback(isolation, fmt.Errorf("systemdefense: creating filter %q: %w", requests[i].ElementName, createErr))
+		}
+
+		isolation.FilterInstanceIDs = append(isolation.FilterInstanceIDs, instanceID)
+		handles = append(handles, handle)
+	}
+
+	policyName := options.PolicyName
+	if policyName == "" {
+		policyName = DefaultIsolationPolicyName
+	}
+
+	response, err := isolator.Policies.Create(PolicyRequest{
+		PolicyName:            policyName,
+		PolicyPrecedence:      options.PolicyPrecedence,
+		FilterCreationHandles: handles,
+		TxDefaultDrop:         true,
+		RxDefaultDrop:         true,
+	})
+	if err == nil {
+		isolation.PolicyInstanceID, err = createdInstanceID(response)
+	}
+
+	if err != nil {
+		return Isolation{}, isolator.rollback(isolation, fmt.Errorf("systemdefense: creating policy: %w", err))
+	}
+
+	for i, port := range isolation.Ports {
+		if _, err = isolator.PortPolicies.Create(isolation.PolicyInstanceID, port); err != nil {
+			// Only remove the policy from the ports it was applied to.
+			partial := isolation
+			partial.Ports = isolation.Ports[:i]
+
+			return Isolation{}, isolator.rollback(partial, fmt.Errorf("systemdefense: applying policy to %q: %w", port, err))
+		}
+	}
+
+	return isolation, nil
+}
+
+// Release removes the policy and filters created by Isolate, restoring the network access of the device.
+// It attempts every removal and returns the errors of those that fail.
+func (isolator Isolator) Release(isolation Isolation) error {
+	errs := []error{}
+
+	if isolation.PolicyInstanceID != "" {
+		for _, port := range isolation.Ports {
+			if _, err := isolator.PortPolicies.Delete(isolation.PolicyInstanceID, port); err != nil {
+				errs = append(errs, fmt.Errorf("systemdefense: removing policy from %q: %w", port, err))
+			}
+		}
+
+		if _, err := isolator.Policies.Delete(isolation.PolicyInstanceID); err != nil {
+			errs = append(errs, fmt.Errorf("systemdefense: deleting policy %q: %w", isolation.PolicyInstanceID, err))
+		}
+	}
+
+	for _, instanceID := range isolation.FilterInstanceIDs {
+		if _, err := isolator.Filters.Delete(instanceID); err != nil {
+			errs = append(errs, fmt.Errorf("systemdefense: deleting filter %q: %w", instanceID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// rollback releases the partially created isolation and returns err joined with the errors of the release.
+func (isolator Isolator) rollback(isolation Isolation, err error) error {
+	return errors.Join(err, isolator.Release(isolation))
+}
+
+// createFilter creates a filter and returns its InstanceID and handle.
+func (isolator Isolator) createFilter(request IPHeadersFilterRequest) (instanceID string, handle int, err error) {
+	response, err := isolator.Filters.Create(request)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if instanceID, err = createdInstanceID(response); err != nil {
+		return "", 0, err
+	}
+
+	handle, err = Handle(instanceID)
+
+	return instanceID, handle, err
+}
+
+// managementFilters returns the pass filters of the traffic from and to ip.
+func managementFilters(ip net.IP) []IPHeadersFilterRequest {
+	receive := IPHeadersFilterRequest{
+		ElementName:     "Allow from " + ip.String(),
+		FilterDirection: FilterDirectionReceive,
+		FilterProfile:   FilterProfilePass,
+	}
+	transmit := IPHeadersFilterRequest{
+		ElementName:     "Allow to " + ip.String(),
+		FilterDirection: FilterDirectionTransmit,
+		FilterProfile:   FilterProfilePass,
+	}
+
+	if ip.To4() != nil {
+		receive.HdrIPVersion, receive.HdrSrcAddress, receive.HdrSrcMask = IPVersion4, ip.String(), "255.255.255.255"
+		transmit.HdrIPVersion, transmit.HdrDestAddress, transmit.HdrDestMask = IPVersion4, ip.String(), "255.255.255.255"
+	} else {
+		receive.HdrIPVersion, receive.HdrSrcAddress, receive.HdrSrcPrefixLength = IPVersion6, ip.String(), 128
+		transmit.HdrIPVersion, transmit.HdrDestAddress, transmit.HdrDestPrefixLength = IPVersion6, ip.String(), 128
+	}
+
+	return []IPHeadersFilterRequest{receive, transmit}
+}
+
+// createdInstanceID returns the InstanceID selector of the endpoint reference of a Create response.
+func createdInstanceID(response Response) (string, error) {
+	for _, selector := range response.Body.CreateResponse.ReferenceParameters.SelectorSet.Selector {
+		if selector.Name == "InstanceID" {
+			return selector.Value, nil
+		}
+	}
+
+	return "", ErrNoInstanceID
+}
+
+// Handle returns the creation handle of a filter or policy from its InstanceID, such as 5 for "Intel(r) AMT:Handle: 5".
+// The handles of filters are the FilterCreationHandles of a policy.
+func Handle(instanceID string) (int, error) {
+	handle, err := strconv.Atoi(strings.TrimSpace(instanceID[strings.LastIndex(instanceID, ":")+1:]))
+	if err != nil {
+		return 0, fmt.Errorf("systemdefense: no handle in InstanceID %q", instanceID)
+	}
+
+	return handle, nil
+}
@@ -0,0 +1,193 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+var errIsolationClient = errors.New("isolation client error")
+
+// isolationClient answers the Create and Delete calls of an Isolator and records them as "<action> <class>".
+type isolationClient struct {
+	wsmantesting.MockClient
+	calls  []string
+	failOn string
+	handle int
+}
+
+func (c *isolationClient) Post(msg string) ([]byte, error) {
+	call := path.Base(between(msg, "<a:Action>", "</a:Action>")) + " " + path.Base(between(msg, "<w:ResourceURI>", "</w:ResourceURI>"))
+	c.calls = append(c.calls, call)
+
+	if call == c.failOn {
+		return nil, errIsolationClient
+	}
+
+	body := ""
+
+	if strings.HasPrefix(call, "Create") && !strings.HasSuffix(call, AMTNetworkPortSystemDefensePolicy) {
+		c.handle++
+		body = fmt.Sprintf(`<g:ResourceCreated><b:Address>http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</b:Address><b:ReferenceParameters><c:ResourceURI>x</c:ResourceURI><c:SelectorSet><c:Selector Name="InstanceID">Intel(r) AMT:Handle: %d</c:Selector></c:SelectorSet></b:ReferenceParameters></g:ResourceCreated>`, c.handle)
+	}
+
+	return []byte(`<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:b="http://schemas.xmlsoap.org/ws/2004/08/addressing" xmlns:c="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd" xmlns:g="http://schemas.xmlsoap.org/ws/2004/09/transfer"><a:Header></a:Header><a:Body>` + body + `</a:Body></a:Envelope>`), nil
+}
+
+func between(s, start, end string) string {
+	s = s[strings.Index(s, start)+len(start):]
+
+	return s[:strings.Index(s, end)]
+}
+
+func newTestIsolator() (*isolationClient, Isolator) {
+	client := &isolationClient{}
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.AMTResourceURIBase)
+
+	return client, NewIsolatorWithClient(wsmanMessageCreator, client)
+}
+
+func TestIsolate(t *testing.T) {
+	client, isolator := newTestIsolator()
+
+	isolation, err := isolator.Isolate(IsolationOptions{
+		ManagementAddresses: []string{"192.168.0.10", "fd00::10"},
+		PolicyPrecedence:    1,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, Isolation{
+		PolicyInstanceID:  "Intel(r) AMT:Handle: 5",
+		FilterInstanceIDs: []string{"Intel(r) AMT:Handle: 1", "Intel(r) AMT:Handle: 2", "Intel(r) AMT:Handle: 3", "Intel(r) AMT:Handle: 4"},
+		Ports:             []string{DefaultEthernetPort},
+	}, isolation)
+	assert.Equal(t, []string{
+		"Create AMT_IPHeadersFilter",
+		"Create AMT_IPHeadersFilter",
+		"Create AMT_IPHeadersFilter",
+		"Create AMT_IPHeadersFilter",
+		"Create AMT_SystemDefensePolicy",
+		"Create AMT_NetworkPortSystemDefensePolicy",
+	}, client.calls)
+}
+
+func TestIsolateRollsBack(t *testing.T) {
+	tests := []struct {
+		name          string
+		failOn        string
+		expectedCalls []string
+	}{
+		{
+			"should delete the created filters when a filter cannot be created",
+			"Create AMT_IPHeadersFilter",
+			[]string{"Create AMT_IPHeadersFilter"},
+		},
+		{
+			"should delete the filters when the policy cannot be created",
+			"Create AMT_SystemDefensePolicy",
+			[]string{
+				"Create AMT_IPHeadersFilter",
+				"Create AMT_IPHeadersFilter",
+				"Create AMT_SystemDefensePolicy",
+				"Delete AMT_IPHeadersFilter",
+				"Delete AMT_IPHeadersFilter",
+			},
+		},
+		{
+			"should delete the policy and filters when the policy cannot be applied",
+			"Create AMT_NetworkPortSystemDefensePolicy",
+			[]string{
+				"Create AMT_IPHeadersFilter",
+				"Create AMT_IPHeadersFilter",
+				"Create AMT_SystemDefensePolicy",
+				"Create AMT_NetworkPortSystemDefensePolicy",
+				"Delete AMT_SystemDefensePolicy",
+				"Delete AMT_IPHeadersFilter",
+				"Delete AMT_IPHeadersFilter",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, isolator := newTestIsolator()
+			client.failOn = test.failOn
+
+			isolation, err := isolator.Isolate(IsolationOptions{ManagementAddresses: []string{"192.168.0.10"}})
+
+			assert.ErrorIs(t, err, errIsolationClient)
+			assert.Equal(t, Isolation{}, isolation)
+			assert.Equal(t, test.expectedCalls, client.calls)
+		})
+	}
+}
+
+func TestIsolateInvalidOptions(t *testing.T) {
+	client, isolator := newTestIsolator()
+
+	_, err := isolator.Isolate(IsolationOptions{})
+	assert.ErrorIs(t, err, ErrNoManagementAddress)
+
+	_, err = isolator.Isolate(IsolationOptions{ManagementAddresses: []string{"console.example.com"}})
+	assert.ErrorIs(t, err, ErrInvalidAddress)
+	assert.Empty(t, client.calls)
+}
+
+func TestRelease(t *testing.T) {
+	client, isolator := newTestIsolator()
+	client.failOn = "Delete AMT_SystemDefensePolicy"
+
+	err := isolator.Release(Isolation{
+		PolicyInstanceID:  "Intel(r) AMT:Handle: 3",
+		FilterInstanceIDs: []string{"Intel(r) AMT:Handle: 1", "Intel(r) AMT:Handle: 2"},
+		Ports:             []string{DefaultEthernetPort},
+	})
+
+	assert.ErrorIs(t, err, errIsolationClient)
+	assert.Equal(t, []string{
+		"Delete AMT_NetworkPortSystemDefensePolicy",
+		"Delete AMT_SystemDefensePolicy",
+		"Delete AMT_IPHeadersFilter",
+		"Delete AMT_IPHeadersFilter",
+	}, client.calls)
+}
+
+func TestManagementFilters(t *testing.T) {
+	requests := managementFilters(net.ParseIP("192.168.0.10"))
+	assert.Equal(t, IPVersion4, requests[0].HdrIPVersion)
+	assert.Equal(t, FilterDirectionReceive, requests[0].FilterDirection)
+	assert.Equal(t, "192.168.0.10", requests[0].HdrSrcAddress)
+	assert.Equal(t, "255.255.255.255", requests[0].HdrSrcMask)
+	assert.Equal(t, FilterDirectionTransmit, requests[1].FilterDirection)
+	assert.Equal(t, "192.168.0.10", requests[1].HdrDestAddress)
+	assert.Equal(t, "255.255.255.255", requests[1].HdrDestMask)
+
+	requests = managementFilters(net.ParseIP("fd00::10"))
+	assert.Equal(t, IPVersion6, requests[0].HdrIPVersion)
+	assert.Equal(t, "fd00::10", requests[0].HdrSrcAddress)
+	assert.Equal(t, 128, requests[0].HdrSrcPrefixLength)
+	assert.Equal(t, "fd00::10", requests[1].HdrDestAddress)
+	assert.Equal(t, 128, requests[1].HdrDestPrefixLength)
+}
+
+func TestHandle(t *testing.T) {
+	handle, err := Handle("Intel(r) AMT:Handle: 5")
+	assert.NoError(t, err)
+	assert.Equal(t, 5, handle)
+
+	_, err = Handle("Intel(r) AMT:Handle")
+	assert.Error(t, err)
+}
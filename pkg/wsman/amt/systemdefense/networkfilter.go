@@ -0,0 +1,114 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewNetworkFilterWithClient instantiates a new NetworkFilter.
+func NewNetworkFilterWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) NetworkFilter {
+	return NetworkFilter{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTNetworkFilter, client),
+	}
+}
+
+// Get retrieves the representation of the filter with the given InstanceID.
+func (filter NetworkFilter) Get(instanceID string) (response Response, err error) {
+	selector := message.Selector{Name: "InstanceID", Value: instanceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Get(&selector),
+		},
+	}
+
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (filter NetworkFilter) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Enumerate(),
+		},
+	}
+
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (filter NetworkFilter) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Pull(enumerationContext),
+		},
+	}
+
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Delete removes the filter with the given InstanceID.
+func (filter NetworkFilter) Delete(instanceID string) (response Response, err error) {
+	selector := message.Selector{Name: "InstanceID", Value: instanceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Delete(selector),
+		},
+	}
+
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
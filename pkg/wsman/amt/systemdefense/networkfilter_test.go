@@ -0,0 +1,209 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func networkFilterResponse() NetworkFilterResponse {
+	return NetworkFilterResponse{
+		XMLName:         xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_NetworkFilter", Local: "AMT_NetworkFilter"},
+		InstanceID:      "Intel(r) AMT:Handle: 1",
+		ElementName:     "Allow from 192.168.0.10",
+		FilterDirection: FilterDirectionReceive,
+		FilterProfile:   FilterProfilePass,
+	}
+}
+
+func TestPositiveNetworkFilter(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/networkfilter",
+	}
+	elementUnderTest := NewNetworkFilterWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_networkfilter Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should create and parse a valid AMT_NetworkFilter Get call",
+				AMTNetworkFilter,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 1</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get("Intel(r) AMT:Handle: 1")
+				},
+				Body{
+					XMLName:                  xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					NetworkFilterGetResponse: networkFilterResponse(),
+				},
+			},
+			{
+				"should create and parse a valid AMT_NetworkFilter Enumerate call",
+				AMTNetworkFilter,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_NetworkFilter Pull call",
+				AMTNetworkFilter,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:            xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						NetworkFilterItems: []NetworkFilterResponse{networkFilterResponse()},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_NetworkFilter Delete call",
+				AMTNetworkFilter,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 1</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete("Intel(r) AMT:Handle: 1")
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeNetworkFilter(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/networkfilter",
+	}
+	elementUnderTest := NewNetworkFilterWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_networkfilter Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_NetworkFilter Get call",
+				AMTNetworkFilter,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 1</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get("Intel(r) AMT:Handle: 1")
+				},
+			},
+			{
+				"should handle error when AMT_NetworkFilter Enumerate call",
+				AMTNetworkFilter,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_NetworkFilter Pull call",
+				AMTNetworkFilter,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_NetworkFilter Delete call",
+				AMTNetworkFilter,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 1</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete("Intel(r) AMT:Handle: 1")
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,138 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewNetworkPortSystemDefensePolicyWithClient instantiates a new NetworkPortPolicy.
+func NewNetworkPortSystemDefensePolicyWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) NetworkPortPolicy {
+	return NetworkPortPolicy{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTNetworkPortSystemDefensePolicy, client),
+	}
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (portPolicy NetworkPortPolicy) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: portPolicy.base.Enumerate(),
+		},
+	}
+
+	// send the message to AMT
+	err = portPolicy.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (portPolicy NetworkPortPolicy) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: portPolicy.base.Pull(enumerationContext),
+		},
+	}
+
+	// send the message to AMT
+	err = portPolicy.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Create applies the policy with the given InstanceID to the network port with the given DeviceID, such as DefaultEthernetPort.
+func (portPolicy NetworkPortPolicy) Create(policyInstanceID, portDeviceID string) (response Response, err error) {
+	header := portPolicy.base.WSManMessageCreator.CreateHeader(message.BaseActionsCreate, AMTNetworkPortSystemDefensePolicy, nil, "", "")
+	body := fmt.Sprintf(`<Body><h:AMT_NetworkPortSystemDefensePolicy xmlns:h="%s%s"><h:PolicySet>%s</h:PolicySet><h:ManagedElement>%s</h:ManagedElement></h:AMT_NetworkPortSystemDefensePolicy></Body>`, portPolicy.base.WSManMessageCreator.ResourceURIBase, AMTNetworkPortSystemDefensePolicy, portPolicy.policyReference(policyInstanceID), portReference(portDeviceID))
+	response = Response{
+		Message: &client.Message{
+			XMLInput: portPolicy.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = portPolicy.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Delete removes the policy with the given InstanceID from the network port with the given DeviceID.
+func (portPolicy NetworkPortPolicy) Delete(policyInstanceID, portDeviceID string) (response Response, err error) {
+	selectorSet := fmt.Sprintf(`<w:SelectorSet><w:Selector Name="PolicySet"><a:EndpointReference>%s</a:EndpointReference></w:Selector><w:Selector Name="ManagedElement"><a:EndpointReference>%s</a:EndpointReference></w:Selector></w:SelectorSet>`, portPolicy.policyReference(policyInstanceID), portReference(portDeviceID))
+	resourceURI := portPolicy.base.WSManMessageCreator.ResourceURIBase + AMTNetworkPortSystemDefensePolicy
+	header := portPolicy.base.WSManMessageCreator.CreateHeaderWithResourceURI(message.BaseActionsDelete, resourceURI, nil, selectorSet)
+	response = Response{
+		Message: &client.Message{
+			XMLInput: portPolicy.base.WSManMessageCreator.CreateXML(header, message.DeleteBody),
+		},
+	}
+
+	// send the message to AMT
+	err = portPolicy.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// policyReference returns the endpoint reference of the policy with the given InstanceID.
+func (portPolicy NetworkPortPolicy) policyReference(instanceID string) string {
+	return fmt.Sprintf(`<a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>%s%s</w:ResourceURI><w:SelectorSet><w:Selector Name="InstanceID">%s</w:Selector></w:SelectorSet></a:ReferenceParameters>`, portPolicy.base.WSManMessageCreator.ResourceURIBase, AMTSystemDefensePolicy, escape(instanceID))
+}
+
+// portReference returns the endpoint reference of the network port with the given DeviceID.
+func portReference(deviceID string) string {
+	return fmt.Sprintf(`<a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>%s%s</w:ResourceURI><w:SelectorSet><w:Selector Name="CreationClassName">%s</w:Selector><w:Selector Name="DeviceID">%s</w:Selector><w:Selector Name="SystemCreationClassName">CIM_ComputerSystem</w:Selector><w:Selector Name="SystemName">Intel(r) AMT</w:Selector></w:SelectorSet></a:ReferenceParameters>`, message.CIMSchema, CIMEthernetPort, CIMEthernetPort, escape(deviceID))
+}
+
+// escape returns value with the XML special characters escaped.
+func escape(value string) string {
+	var escaped strings.Builder
+
+	_ = xml.EscapeText(&escaped, []byte(value))
+
+	return escaped.String()
+}
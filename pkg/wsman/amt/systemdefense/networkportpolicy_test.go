@@ -0,0 +1,248 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/models"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func portPolicyResponse() NetworkPortPolicyResponse {
+	return NetworkPortPolicyResponse{
+		XMLName:        xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_NetworkPortSystemDefensePolicy", Local: "AMT_NetworkPortSystemDefensePolicy"},
+		PolicySet:      EndpointReference{Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous", ReferenceParameters: policyReferenceParameters()},
+		ManagedElement: EndpointReference{Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous", ReferenceParameters: portReferenceParameters()},
+	}
+}
+
+func policyReferenceParameters() models.ReferenceParameters_OUTPUT {
+	return models.ReferenceParameters_OUTPUT{
+		ResourceURI: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy",
+		SelectorSet: models.SelectorSet_OUTPUT{
+			XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "SelectorSet"},
+			Selector: []message.Selector_OUTPUT{
+				{XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"}, Name: "InstanceID", Value: "Intel(r) AMT:Handle: 3"},
+			},
+		},
+	}
+}
+
+func portReferenceParameters() models.ReferenceParameters_OUTPUT {
+	return models.ReferenceParameters_OUTPUT{
+		ResourceURI: "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_EthernetPort",
+		SelectorSet: models.SelectorSet_OUTPUT{
+			XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "SelectorSet"},
+			Selector: []message.Selector_OUTPUT{
+				{XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"}, Name: "CreationClassName", Value: CIMEthernetPort},
+				{XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"}, Name: "DeviceID", Value: DefaultEthernetPort},
+				{XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"}, Name: "SystemCreationClassName", Value: "CIM_ComputerSystem"},
+				{XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"}, Name: "SystemName", Value: "Intel(r) AMT"},
+			},
+		},
+	}
+}
+
+func TestPositiveNetworkPortSystemDefensePolicy(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/networkportpolicy",
+	}
+	elementUnderTest := NewNetworkPortSystemDefensePolicyWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_networkportsystemdefensepolicy Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should create and parse a valid AMT_NetworkPortSystemDefensePolicy Enumerate call",
+				AMTNetworkPortSystemDefensePolicy,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_NetworkPortSystemDefensePolicy Pull call",
+				AMTNetworkPortSystemDefensePolicy,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:                xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						NetworkPortPolicyItems: []NetworkPortPolicyResponse{portPolicyResponse()},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_NetworkPortSystemDefensePolicy Create call",
+				AMTNetworkPortSystemDefensePolicy,
+				wsmantesting.Create,
+				"",
+				"<h:AMT_NetworkPortSystemDefensePolicy xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_NetworkPortSystemDefensePolicy\"><h:PolicySet><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy</w:ResourceURI><w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 3</w:Selector></w:SelectorSet></a:ReferenceParameters></h:PolicySet><h:ManagedElement><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_EthernetPort</w:ResourceURI><w:SelectorSet><w:Selector Name=\"CreationClassName\">CIM_EthernetPort</w:Selector><w:Selector Name=\"DeviceID\">Intel(r) AMT Ethernet Port 0</w:Selector><w:Selector Name=\"SystemCreationClassName\">CIM_ComputerSystem</w:Selector><w:Selector Name=\"SystemName\">Intel(r) AMT</w:Selector></w:SelectorSet></a:ReferenceParameters></h:ManagedElement></h:AMT_NetworkPortSystemDefensePolicy>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create("Intel(r) AMT:Handle: 3", DefaultEthernetPort)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CreateResponse: CreateResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/transfer", Local: "ResourceCreated"},
+						Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+						ReferenceParameters: models.ReferenceParameters_OUTPUT{
+							ResourceURI: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_NetworkPortSystemDefensePolicy",
+							SelectorSet: models.SelectorSet_OUTPUT{
+								XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "SelectorSet"},
+								Selector: []message.Selector_OUTPUT{
+									{XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"}, Name: "PolicySet"},
+									{XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"}, Name: "ManagedElement"},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_NetworkPortSystemDefensePolicy Delete call",
+				AMTNetworkPortSystemDefensePolicy,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"PolicySet\"><a:EndpointReference><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy</w:ResourceURI><w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 3</w:Selector></w:SelectorSet></a:ReferenceParameters></a:EndpointReference></w:Selector><w:Selector Name=\"ManagedElement\"><a:EndpointReference><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_EthernetPort</w:ResourceURI><w:SelectorSet><w:Selector Name=\"CreationClassName\">CIM_EthernetPort</w:Selector><w:Selector Name=\"DeviceID\">Intel(r) AMT Ethernet Port 0</w:Selector><w:Selector Name=\"SystemCreationClassName\">CIM_ComputerSystem</w:Selector><w:Selector Name=\"SystemName\">Intel(r) AMT</w:Selector></w:SelectorSet></a:ReferenceParameters></a:EndpointReference></w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete("Intel(r) AMT:Handle: 3", DefaultEthernetPort)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeNetworkPortSystemDefensePolicy(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/networkportpolicy",
+	}
+	elementUnderTest := NewNetworkPortSystemDefensePolicyWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_networkportsystemdefensepolicy Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_NetworkPortSystemDefensePolicy Enumerate call",
+				AMTNetworkPortSystemDefensePolicy,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_NetworkPortSystemDefensePolicy Pull call",
+				AMTNetworkPortSystemDefensePolicy,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_NetworkPortSystemDefensePolicy Create call",
+				AMTNetworkPortSystemDefensePolicy,
+				wsmantesting.Create,
+				"",
+				"<h:AMT_NetworkPortSystemDefensePolicy xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_NetworkPortSystemDefensePolicy\"><h:PolicySet><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy</w:ResourceURI><w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 3</w:Selector></w:SelectorSet></a:ReferenceParameters></h:PolicySet><h:ManagedElement><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_EthernetPort</w:ResourceURI><w:SelectorSet><w:Selector Name=\"CreationClassName\">CIM_EthernetPort</w:Selector><w:Selector Name=\"DeviceID\">Intel(r) AMT Ethernet Port 0</w:Selector><w:Selector Name=\"SystemCreationClassName\">CIM_ComputerSystem</w:Selector><w:Selector Name=\"SystemName\">Intel(r) AMT</w:Selector></w:SelectorSet></a:ReferenceParameters></h:ManagedElement></h:AMT_NetworkPortSystemDefensePolicy>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Create("Intel(r) AMT:Handle: 3", DefaultEthernetPort)
+				},
+			},
+			{
+				"should handle error when AMT_NetworkPortSystemDefensePolicy Delete call",
+				AMTNetworkPortSystemDefensePolicy,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"PolicySet\"><a:EndpointReference><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy</w:ResourceURI><w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 3</w:Selector></w:SelectorSet></a:ReferenceParameters></a:EndpointReference></w:Selector><w:Selector Name=\"ManagedElement\"><a:EndpointReference><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_EthernetPort</w:ResourceURI><w:SelectorSet><w:Selector Name=\"CreationClassName\">CIM_EthernetPort</w:Selector><w:Selector Name=\"DeviceID\">Intel(r) AMT Ethernet Port 0</w:Selector><w:Selector Name=\"SystemCreationClassName\">CIM_ComputerSystem</w:Selector><w:Selector Name=\"SystemName\">Intel(r) AMT</w:Selector></w:SelectorSet></a:ReferenceParameters></a:EndpointReference></w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete("Intel(r) AMT:Handle: 3", DefaultEthernetPort)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
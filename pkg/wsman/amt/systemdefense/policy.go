@@ -0,0 +1,177 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package systemdefense facilitates communication with Intel® AMT devices to configure System Defense, the network filters of AMT that can block, rate limit and count the traffic of the host.
+//
+// SystemDefensePolicy:
+// A set of network filters and the default actions for the packets that match none of them.
+//
+// NetworkFilter, IPHeadersFilter and Hdr8021Filter:
+// The filters of a policy, matching the IP headers or the 802.1 headers of the packets.
+//
+// NetworkPortSystemDefensePolicy:
+// Applies a policy to a network port of AMT.
+//
+// ActiveFilterStatistics:
+// The packet counts of the filters of the active policies.
+//
+// GeneralSystemDefenseCapabilities:
+// The limits of the System Defense implementation of the device.
+package systemdefense
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewSystemDefensePolicyWithClient instantiates a new system defense Policy.
+func NewSystemDefensePolicyWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Policy {
+	return Policy{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTSystemDefensePolicy, client),
+	}
+}
+
+// Get retrieves the representation of the policy with the given InstanceID.
+func (policy Policy) Get(instanceID string) (response Response, err error) {
+	selector := message.Selector{Name: "InstanceID", Value: instanceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.Get(&selector),
+		},
+	}
+
+	// send the message to AMT
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (policy Policy) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.Enumerate(),
+		},
+	}
+
+	// send the message to AMT
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (policy Policy) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.Pull(enumerationContext),
+		},
+	}
+
+	// send the message to AMT
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Create creates a new policy. The response holds the endpoint reference of the created policy.
+func (policy Policy) Create(request PolicyRequest) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.Create(&request, nil),
+		},
+	}
+
+	// send the message to AMT
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Put changes the properties of the policy with the given InstanceID.
+func (policy Policy) Put(instanceID string, request PolicyRequest) (response Response, err error) {
+	selector := message.Selector{Name: "InstanceID", Value: instanceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.Put(&request, true, &selector),
+		},
+	}
+
+	// send the message to AMT
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Delete removes the policy with the given InstanceID.
+func (policy Policy) Delete(instanceID string) (response Response, err error) {
+	selector := message.Selector{Name: "InstanceID", Value: instanceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: policy.base.Delete(selector),
+		},
+	}
+
+	// send the message to AMT
+	err = policy.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,317 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/models"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func TestJson(t *testing.T) {
+	response := Response{
+		Body: Body{
+			PullResponse: PullResponse{},
+		},
+	}
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"PolicyGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"PolicyName\":\"\",\"PolicyPrecedence\":0,\"AntiSpoofingSupport\":0,\"FilterCreationHandles\":null,\"TxDefaultDrop\":false,\"TxDefaultMatchEvent\":false,\"TxDefaultCount\":false,\"RxDefaultDrop\":false,\"RxDefaultMatchEvent\":false,\"RxDefaultCount\":false},\"NetworkFilterGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"FilterDirection\":0,\"FilterProfile\":0,\"FilterProfileData\":0,\"ActionEventOnMatch\":false},\"IPHeadersFilterGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"FilterDirection\":0,\"FilterProfile\":0,\"FilterProfileData\":0,\"ActionEventOnMatch\":false,\"HdrIPVersion\":0,\"HdrSrcAddress\":\"\",\"HdrSrcMask\":\"\",\"HdrSrcPrefixLength\":0,\"HdrDestAddress\":\"\",\"HdrDestMask\":\"\",\"HdrDestPrefixLength\":0,\"HdrProtocolID\":0,\"HdrSrcPortStart\":0,\"HdrSrcPortEnd\":0,\"HdrDestPortStart\":0,\"HdrDestPortEnd\":0},\"Hdr8021FilterGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"FilterDirection\":0,\"FilterProfile\":0,\"FilterProfileData\":0,\"ActionEventOnMatch\":false,\"HdrSrcMACAddress\":\"\",\"HdrDestMACAddress\":\"\",\"HdrProtocolID8021\":0,\"HdrPriorityValue\":0,\"HdrVLANID\":0},\"CapabilitiesGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"GlobalMaxSupportedFilters\":0,\"GlobalMaxSupportedPolicies\":0,\"GlobalMaxSupportedCounters\":0,\"GlobalMaxSupportedRateLimitFilters\":0},\"CreateResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Address\":\"\",\"ReferenceParameters\":{\"ResourceURI\":\"\",\"SelectorSet\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Selector\":null}}},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"PolicyItems\":null,\"NetworkFilterItems\":null,\"IPHeadersFilterItems\":null,\"Hdr8021FilterItems\":null,\"NetworkPortPolicyItems\":null,\"ActiveFilterStatisticsItems\":null,\"CapabilitiesItems\":null}}"
+	result := response.JSON()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestYaml(t *testing.T) {
+	response := Response{
+		Body: Body{
+			PullResponse: PullResponse{},
+		},
+	}
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\npolicygetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    instanceid: \"\"\n    elementname: \"\"\n    policyname: \"\"\n    policyprecedence: 0\n    antispoofingsupport: 0\n    filtercreationhandles: []\n    txdefaultdrop: false\n    txdefaultmatchevent: false\n    txdefaultcount: false\n    rxdefaultdrop: false\n    rxdefaultmatchevent: false\n    rxdefaultcount: false\nnetworkfiltergetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    instanceid: \"\"\n    elementname: \"\"\n    filterdirection: 0\n    filterprofile: 0\n    filterprofiledata: 0\n    actioneventonmatch: false\nipheadersfiltergetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    instanceid: \"\"\n    elementname: \"\"\n    filterdirection: 0\n    filterprofile: 0\n    filterprofiledata: 0\n    actioneventonmatch: false\n    hdripversion: 0\n    hdrsrcaddress: \"\"\n    hdrsrcmask: \"\"\n    hdrsrcprefixlength: 0\n    hdrdestaddress: \"\"\n    hdrdestmask: \"\"\n    hdrdestprefixlength: 0\n    hdrprotocolid: 0\n    hdrsrcportstart: 0\n    hdrsrcportend: 0\n    hdrdestportstart: 0\n    hdrdestportend: 0\nhdr8021filtergetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    instanceid: \"\"\n    elementname: \"\"\n    filterdirection: 0\n    filterprofile: 0\n    filterprofiledata: 0\n    actioneventonmatch: false\n    hdrsrcmacaddress: \"\"\n    hdrdestmacaddress: \"\"\n    hdrprotocolid8021: 0\n    hdrpriorityvalue: 0\n    hdrvlanid: 0\ncapabilitiesgetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    instanceid: \"\"\n    elementname: \"\"\n    globalmaxsupportedfilters: 0\n    globalmaxsupportedpolicies: 0\n    globalmaxsupportedcounters: 0\n    globalmaxsupportedratelimitfilters: 0\ncreateresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    address: \"\"\n    referenceparameters:\n        resourceuri: \"\"\n        selectorset:\n            xmlname:\n                space: \"\"\n                local: \"\"\n            selector: []\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    policyitems: []\n    networkfilteritems: []\n    ipheadersfilteritems: []\n    hdr8021filteritems: []\n    networkportpolicyitems: []\n    activefilterstatisticsitems: []\n    capabilitiesitems: []\n"
+	result := response.YAML()
+	assert.Equal(t, expectedResult, result)
+}
+
+func isolationPolicyRequest() PolicyRequest {
+	return PolicyRequest{
+		PolicyName:            DefaultIsolationPolicyName,
+		PolicyPrecedence:      1,
+		FilterCreationHandles: []int{1, 2},
+		TxDefaultDrop:         true,
+		RxDefaultDrop:         true,
+	}
+}
+
+func isolationPolicyResponse() PolicyResponse {
+	return PolicyResponse{
+		XMLName:               xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy", Local: "AMT_SystemDefensePolicy"},
+		InstanceID:            "Intel(r) AMT:Handle: 3",
+		ElementName:           DefaultIsolationPolicyName,
+		PolicyName:            DefaultIsolationPolicyName,
+		PolicyPrecedence:      1,
+		FilterCreationHandles: []int{1, 2},
+		TxDefaultDrop:         true,
+		RxDefaultDrop:         true,
+	}
+}
+
+func TestPositiveSystemDefensePolicy(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/policy",
+	}
+	elementUnderTest := NewSystemDefensePolicyWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_systemdefensepolicy Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should create and parse a valid AMT_SystemDefensePolicy Get call",
+				AMTSystemDefensePolicy,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 3</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get("Intel(r) AMT:Handle: 3")
+				},
+				Body{
+					XMLName:           xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PolicyGetResponse: isolationPolicyResponse(),
+				},
+			},
+			{
+				"should create and parse a valid AMT_SystemDefensePolicy Enumerate call",
+				AMTSystemDefensePolicy,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_SystemDefensePolicy Pull call",
+				AMTSystemDefensePolicy,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:     xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						PolicyItems: []PolicyResponse{isolationPolicyResponse()},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_SystemDefensePolicy Create call",
+				AMTSystemDefensePolicy,
+				wsmantesting.Create,
+				"",
+				"<h:AMT_SystemDefensePolicy xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy\"><h:PolicyName>Isolation</h:PolicyName><h:PolicyPrecedence>1</h:PolicyPrecedence><h:AntiSpoofingSupport>0</h:AntiSpoofingSupport><h:FilterCreationHandles>1</h:FilterCreationHandles><h:FilterCreationHandles>2</h:FilterCreationHandles><h:TxDefaultDrop>true</h:TxDefaultDrop><h:TxDefaultMatchEvent>false</h:TxDefaultMatchEvent><h:TxDefaultCount>false</h:TxDefaultCount><h:RxDefaultDrop>true</h:RxDefaultDrop><h:RxDefaultMatchEvent>false</h:RxDefaultMatchEvent><h:RxDefaultCount>false</h:RxDefaultCount></h:AMT_SystemDefensePolicy>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(isolationPolicyRequest())
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CreateResponse: CreateResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/transfer", Local: "ResourceCreated"},
+						Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+						ReferenceParameters: models.ReferenceParameters_OUTPUT{
+							ResourceURI: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy",
+							SelectorSet: models.SelectorSet_OUTPUT{
+								XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "SelectorSet"},
+								Selector: []message.Selector_OUTPUT{
+									{
+										XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"},
+										Name:    "InstanceID",
+										Value:   "Intel(r) AMT:Handle: 3",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_SystemDefensePolicy Put call",
+				AMTSystemDefensePolicy,
+				wsmantesting.Put,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 3</w:Selector></w:SelectorSet>",
+				"<h:AMT_SystemDefensePolicy xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy\"><h:PolicyName>Isolation</h:PolicyName><h:PolicyPrecedence>1</h:PolicyPrecedence><h:AntiSpoofingSupport>0</h:AntiSpoofingSupport><h:FilterCreationHandles>1</h:FilterCreationHandles><h:FilterCreationHandles>2</h:FilterCreationHandles><h:TxDefaultDrop>true</h:TxDefaultDrop><h:TxDefaultMatchEvent>false</h:TxDefaultMatchEvent><h:TxDefaultCount>false</h:TxDefaultCount><h:RxDefaultDrop>true</h:RxDefaultDrop><h:RxDefaultMatchEvent>false</h:RxDefaultMatchEvent><h:RxDefaultCount>false</h:RxDefaultCount></h:AMT_SystemDefensePolicy>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePut
+
+					return elementUnderTest.Put("Intel(r) AMT:Handle: 3", isolationPolicyRequest())
+				},
+				Body{
+					XMLName:           xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PolicyGetResponse: isolationPolicyResponse(),
+				},
+			},
+			{
+				"should create and parse a valid AMT_SystemDefensePolicy Delete call",
+				AMTSystemDefensePolicy,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 3</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete("Intel(r) AMT:Handle: 3")
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeSystemDefensePolicy(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/systemdefense/policy",
+	}
+	elementUnderTest := NewSystemDefensePolicyWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_systemdefensepolicy Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_SystemDefensePolicy Get call",
+				AMTSystemDefensePolicy,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 3</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get("Intel(r) AMT:Handle: 3")
+				},
+			},
+			{
+				"should handle error when AMT_SystemDefensePolicy Enumerate call",
+				AMTSystemDefensePolicy,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_SystemDefensePolicy Pull call",
+				AMTSystemDefensePolicy,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_SystemDefensePolicy Create call",
+				AMTSystemDefensePolicy,
+				wsmantesting.Create,
+				"",
+				"<h:AMT_SystemDefensePolicy xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy\"><h:PolicyName>Isolation</h:PolicyName><h:PolicyPrecedence>1</h:PolicyPrecedence><h:AntiSpoofingSupport>0</h:AntiSpoofingSupport><h:FilterCreationHandles>1</h:FilterCreationHandles><h:FilterCreationHandles>2</h:FilterCreationHandles><h:TxDefaultDrop>true</h:TxDefaultDrop><h:TxDefaultMatchEvent>false</h:TxDefaultMatchEvent><h:TxDefaultCount>false</h:TxDefaultCount><h:RxDefaultDrop>true</h:RxDefaultDrop><h:RxDefaultMatchEvent>false</h:RxDefaultMatchEvent><h:RxDefaultCount>false</h:RxDefaultCount></h:AMT_SystemDefensePolicy>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Create(isolationPolicyRequest())
+				},
+			},
+			{
+				"should handle error when AMT_SystemDefensePolicy Put call",
+				AMTSystemDefensePolicy,
+				wsmantesting.Put,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 3</w:Selector></w:SelectorSet>",
+				"<h:AMT_SystemDefensePolicy xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_SystemDefensePolicy\"><h:PolicyName>Isolation</h:PolicyName><h:PolicyPrecedence>1</h:PolicyPrecedence><h:AntiSpoofingSupport>0</h:AntiSpoofingSupport><h:FilterCreationHandles>1</h:FilterCreationHandles><h:FilterCreationHandles>2</h:FilterCreationHandles><h:TxDefaultDrop>true</h:TxDefaultDrop><h:TxDefaultMatchEvent>false</h:TxDefaultMatchEvent><h:TxDefaultCount>false</h:TxDefaultCount><h:RxDefaultDrop>true</h:RxDefaultDrop><h:RxDefaultMatchEvent>false</h:RxDefaultMatchEvent><h:RxDefaultCount>false</h:RxDefaultCount></h:AMT_SystemDefensePolicy>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Put("Intel(r) AMT:Handle: 3", isolationPolicyRequest())
+				},
+			},
+			{
+				"should handle error when AMT_SystemDefensePolicy Delete call",
+				AMTSystemDefensePolicy,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"InstanceID\">Intel(r) AMT:Handle: 3</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete("Intel(r) AMT:Handle: 3")
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
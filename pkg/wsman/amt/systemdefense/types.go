@@ -0,0 +1,223 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package systemdefense
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/models"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type (
+	Policy struct {
+		base message.Base
+	}
+	NetworkFilter struct {
+		base message.Base
+	}
+	IPHeadersFilter struct {
+		base message.Base
+	}
+	Hdr8021Filter struct {
+		base message.Base
+	}
+	NetworkPortPolicy struct {
+		base message.Base
+	}
+	ActiveFilterStatistics struct {
+		base message.Base
+	}
+	Capabilities struct {
+		base message.Base
+	}
+)
+
+// OUTPUT
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName                    xml.Name                `xml:"Body"`
+		PolicyGetResponse          PolicyResponse          `xml:"AMT_SystemDefensePolicy"`
+		NetworkFilterGetResponse   NetworkFilterResponse   `xml:"AMT_NetworkFilter"`
+		IPHeadersFilterGetResponse IPHeadersFilterResponse `xml:"AMT_IPHeadersFilter"`
+		Hdr8021FilterGetResponse   Hdr8021FilterResponse   `xml:"AMT_Hdr8021Filter"`
+		CapabilitiesGetResponse    CapabilitiesResponse    `xml:"AMT_GeneralSystemDefenseCapabilities"`
+		CreateResponse             CreateResponse          `xml:"ResourceCreated"`
+		EnumerateResponse          common.EnumerateResponse
+		PullResponse               PullResponse
+	}
+	PullResponse struct {
+		XMLName                     xml.Name                         `xml:"PullResponse"`
+		PolicyItems                 []PolicyResponse                 `xml:"Items>AMT_SystemDefensePolicy"`
+		NetworkFilterItems          []NetworkFilterResponse          `xml:"Items>AMT_NetworkFilter"`
+		IPHeadersFilterItems        []IPHeadersFilterResponse        `xml:"Items>AMT_IPHeadersFilter"`
+		Hdr8021FilterItems          []Hdr8021FilterResponse          `xml:"Items>AMT_Hdr8021Filter"`
+		NetworkPortPolicyItems      []NetworkPortPolicyResponse      `xml:"Items>AMT_NetworkPortSystemDefensePolicy"`
+		ActiveFilterStatisticsItems []ActiveFilterStatisticsResponse `xml:"Items>AMT_ActiveFilterStatistics"`
+		CapabilitiesItems           []CapabilitiesResponse           `xml:"Items>AMT_GeneralSystemDefenseCapabilities"`
+	}
+	PolicyResponse struct {
+		XMLName               xml.Name `xml:"AMT_SystemDefensePolicy"`
+		InstanceID            string   `xml:"InstanceID,omitempty"`            // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName           string   `xml:"ElementName,omitempty"`           // A user-friendly name for the object.
+		PolicyName            string   `xml:"PolicyName,omitempty"`            // A user defined name of the policy.
+		PolicyPrecedence      int      `xml:"PolicyPrecedence"`                // The precedence of the policy; of the policies that apply to a port, the one with the highest precedence is active.
+		AntiSpoofingSupport   int      `xml:"AntiSpoofingSupport"`             // Whether anti-spoofing filters are applied to the traffic of the port.
+		FilterCreationHandles []int    `xml:"FilterCreationHandles,omitempty"` // The handles of the filters of the policy.
+		TxDefaultDrop         bool     `xml:"TxDefaultDrop"`                   // Drop the transmitted packets that match no filter of the policy.
+		TxDefaultMatchEvent   bool     `xml:"TxDefaultMatchEvent"`             // Generate an event for the transmitted packets that match no filter of the policy.
+		TxDefaultCount        bool     `xml:"TxDefaultCount"`                  // Count the transmitted packets that match no filter of the policy.
+		RxDefaultDrop         bool     `xml:"RxDefaultDrop"`                   // Drop the received packets that match no filter of the policy.
+		RxDefaultMatchEvent   bool     `xml:"RxDefaultMatchEvent"`             // Generate an event for the received packets that match no filter of the policy.
+		RxDefaultCount        bool     `xml:"RxDefaultCount"`                  // Count the received packets that match no filter of the policy.
+	}
+	NetworkFilterResponse struct {
+		XMLName            xml.Name        `xml:"AMT_NetworkFilter"`
+		InstanceID         string          `xml:"InstanceID,omitempty"`  // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName        string          `xml:"ElementName,omitempty"` // A user-friendly name for the object.
+		FilterDirection    FilterDirection `xml:"FilterDirection"`       // The direction of the packets that the filter applies to.
+		FilterProfile      FilterProfile   `xml:"FilterProfile"`         // The action taken on the packets that match the filter.
+		FilterProfileData  int             `xml:"FilterProfileData"`     // The data of the filter profile, such as the rate of a rate limit filter.
+		ActionEventOnMatch bool            `xml:"ActionEventOnMatch"`    // Generate an event for the packets that match the filter.
+	}
+	IPHeadersFilterResponse struct {
+		XMLName             xml.Name        `xml:"AMT_IPHeadersFilter"`
+		InstanceID          string          `xml:"InstanceID,omitempty"`          // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName         string          `xml:"ElementName,omitempty"`         // A user-friendly name for the object.
+		FilterDirection     FilterDirection `xml:"FilterDirection"`               // The direction of the packets that the filter applies to.
+		FilterProfile       FilterProfile   `xml:"FilterProfile"`                 // The action taken on the packets that match the filter.
+		FilterProfileData   int             `xml:"FilterProfileData"`             // The data of the filter profile, such as the rate of a rate limit filter.
+		ActionEventOnMatch  bool            `xml:"ActionEventOnMatch"`            // Generate an event for the packets that match the filter.
+		HdrIPVersion        IPVersion       `xml:"HdrIPVersion"`                  // The IP version of the packets that the filter applies to.
+		HdrSrcAddress       string          `xml:"HdrSrcAddress,omitempty"`       // The source address of the packets.
+		HdrSrcMask          string          `xml:"HdrSrcMask,omitempty"`          // The mask of the source address of IPv4 packets.
+		HdrSrcPrefixLength  int             `xml:"HdrSrcPrefixLength,omitempty"`  // The prefix length of the source address of IPv6 packets.
+		HdrDestAddress      string          `xml:"HdrDestAddress,omitempty"`      // The destination address of the packets.
+		HdrDestMask         string          `xml:"HdrDestMask,omitempty"`         // The mask of the destination address of IPv4 packets.
+		HdrDestPrefixLength int             `xml:"HdrDestPrefixLength,omitempty"` // The prefix length of the destination address of IPv6 packets.
+		HdrProtocolID       int             `xml:"HdrProtocolID,omitempty"`       // The IP protocol number of the packets, such as 6 for TCP and 17 for UDP.
+		HdrSrcPortStart     int             `xml:"HdrSrcPortStart,omitempty"`     // The first source port of the packets.
+		HdrSrcPortEnd       int             `xml:"HdrSrcPortEnd,omitempty"`       // The last source port of the packets.
+		HdrDestPortStart    int             `xml:"HdrDestPortStart,omitempty"`    // The first destination port of the packets.
+		HdrDestPortEnd      int             `xml:"HdrDestPortEnd,omitempty"`      // The last destination port of the packets.
+	}
+	Hdr8021FilterResponse struct {
+		XMLName            xml.Name        `xml:"AMT_Hdr8021Filter"`
+		InstanceID         string          `xml:"InstanceID,omitempty"`        // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName        string          `xml:"ElementName,omitempty"`       // A user-friendly name for the object.
+		FilterDirection    FilterDirection `xml:"FilterDirection"`             // The direction of the packets that the filter applies to.
+		FilterProfile      FilterProfile   `xml:"FilterProfile"`               // The action taken on the packets that match the filter.
+		FilterProfileData  int             `xml:"FilterProfileData"`           // The data of the filter profile, such as the rate of a rate limit filter.
+		ActionEventOnMatch bool            `xml:"ActionEventOnMatch"`          // Generate an event for the packets that match the filter.
+		HdrSrcMACAddress   string          `xml:"HdrSrcMACAddress,omitempty"`  // The source MAC address of the frames.
+		HdrDestMACAddress  string          `xml:"HdrDestMACAddress,omitempty"` // The destination MAC address of the frames.
+		HdrProtocolID8021  int             `xml:"HdrProtocolID8021,omitempty"` // The EtherType of the frames.
+		HdrPriorityValue   int             `xml:"HdrPriorityValue,omitempty"`  // The 802.1Q priority of the frames.
+		HdrVLANID          int             `xml:"HdrVLANID,omitempty"`         // The 802.1Q VLAN ID of the frames.
+	}
+	NetworkPortPolicyResponse struct {
+		XMLName        xml.Name          `xml:"AMT_NetworkPortSystemDefensePolicy"`
+		PolicySet      EndpointReference `xml:"PolicySet"`      // The system defense policy.
+		ManagedElement EndpointReference `xml:"ManagedElement"` // The network port that the policy applies to.
+	}
+	ActiveFilterStatisticsResponse struct {
+		XMLName              xml.Name `xml:"AMT_ActiveFilterStatistics"`
+		InstanceID           string   `xml:"InstanceID,omitempty"`  // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName          string   `xml:"ElementName,omitempty"` // A user-friendly name for the object.
+		FilterCreationHandle int      `xml:"FilterCreationHandle"`  // The handle of the filter that the statistics belong to.
+		ReadCount            uint64   `xml:"ReadCount"`             // The number of packets that matched the filter.
+	}
+	CapabilitiesResponse struct {
+		XMLName                            xml.Name `xml:"AMT_GeneralSystemDefenseCapabilities"`
+		InstanceID                         string   `xml:"InstanceID,omitempty"`               // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName                        string   `xml:"ElementName,omitempty"`              // A user-friendly name for the object.
+		GlobalMaxSupportedFilters          int      `xml:"GlobalMaxSupportedFilters"`          // The maximum number of filters.
+		GlobalMaxSupportedPolicies         int      `xml:"GlobalMaxSupportedPolicies"`         // The maximum number of policies.
+		GlobalMaxSupportedCounters         int      `xml:"GlobalMaxSupportedCounters"`         // The maximum number of filters that count the packets they match.
+		GlobalMaxSupportedRateLimitFilters int      `xml:"GlobalMaxSupportedRateLimitFilters"` // The maximum number of rate limit filters.
+	}
+	CreateResponse struct {
+		XMLName             xml.Name                          `xml:"ResourceCreated"`
+		Address             string                            `xml:"Address,omitempty"`
+		ReferenceParameters models.ReferenceParameters_OUTPUT `xml:"ReferenceParameters,omitempty"`
+	}
+	EndpointReference struct {
+		Address             string                            `xml:"Address,omitempty"`
+		ReferenceParameters models.ReferenceParameters_OUTPUT `xml:"ReferenceParameters,omitempty"`
+	}
+)
+
+// INPUT
+// Request Types.
+type (
+	PolicyRequest struct {
+		XMLName               xml.Name `xml:"h:AMT_SystemDefensePolicy"`
+		H                     string   `xml:"xmlns:h,attr"`
+		InstanceID            string   `xml:"h:InstanceID,omitempty"`            // The InstanceID of the policy, set for Put only.
+		PolicyName            string   `xml:"h:PolicyName"`                      // A user defined name of the policy.
+		PolicyPrecedence      int      `xml:"h:PolicyPrecedence"`                // The precedence of the policy; of the policies that apply to a port, the one with the highest precedence is active.
+		AntiSpoofingSupport   int      `xml:"h:AntiSpoofingSupport"`             // Whether anti-spoofing filters are applied to the traffic of the port.
+		FilterCreationHandles []int    `xml:"h:FilterCreationHandles,omitempty"` // The handles of the filters of the policy.
+		TxDefaultDrop         bool     `xml:"h:TxDefaultDrop"`                   // Drop the transmitted packets that match no filter of the policy.
+		TxDefaultMatchEvent   bool     `xml:"h:TxDefaultMatchEvent"`             // Generate an event for the transmitted packets that match no filter of the policy.
+		TxDefaultCount        bool     `xml:"h:TxDefaultCount"`                  // Count the transmitted packets that match no filter of the policy.
+		RxDefaultDrop         bool     `xml:"h:RxDefaultDrop"`                   // Drop the received packets that match no filter of the policy.
+		RxDefaultMatchEvent   bool     `xml:"h:RxDefaultMatchEvent"`             // Generate an event for the received packets that match no filter of the policy.
+		RxDefaultCount        bool     `xml:"h:RxDefaultCount"`                  // Count the received packets that match no filter of the policy.
+	}
+	IPHeadersFilterRequest struct {
+		XMLName             xml.Name        `xml:"h:AMT_IPHeadersFilter"`
+		H                   string          `xml:"xmlns:h,attr"`
+		InstanceID          string          `xml:"h:InstanceID,omitempty"`          // The InstanceID of the filter, set for Put only.
+		ElementName         string          `xml:"h:ElementName,omitempty"`         // A user-friendly name for the object.
+		FilterDirection     FilterDirection `xml:"h:FilterDirection"`               // The direction of the packets that the filter applies to.
+		FilterProfile       FilterProfile   `xml:"h:FilterProfile"`                 // The action taken on the packets that match the filter.
+		FilterProfileData   int             `xml:"h:FilterProfileData,omitempty"`   // The data of the filter profile, such as the rate of a rate limit filter.
+		ActionEventOnMatch  bool            `xml:"h:ActionEventOnMatch"`            // Generate an event for the packets that match the filter.
+		HdrIPVersion        IPVersion       `xml:"h:HdrIPVersion"`                  // The IP version of the packets that the filter applies to.
+		HdrSrcAddress       string          `xml:"h:HdrSrcAddress,omitempty"`       // The source address of the packets.
+		HdrSrcMask          string          `xml:"h:HdrSrcMask,omitempty"`          // The mask of the source address of IPv4 packets.
+		HdrSrcPrefixLength  int             `xml:"h:HdrSrcPrefixLength,omitempty"`  // The prefix length of the source address of IPv6 packets.
+		HdrDestAddress      string          `xml:"h:HdrDestAddress,omitempty"`      // The destination address of the packets.
+		HdrDestMask         string          `xml:"h:HdrDestMask,omitempty"`         // The mask of the destination address of IPv4 packets.
+		HdrDestPrefixLength int             `xml:"h:HdrDestPrefixLength,omitempty"` // The prefix length of the destination address of IPv6 packets.
+		HdrProtocolID       int             `xml:"h:HdrProtocolID,omitempty"`       // The IP protocol number of the packets, such as 6 for TCP and 17 for UDP.
+		HdrSrcPortStart     int             `xml:"h:HdrSrcPortStart,omitempty"`     // The first source port of the packets.
+		HdrSrcPortEnd       int             `xml:"h:HdrSrcPortEnd,omitempty"`       // The last source port of the packets.
+		HdrDestPortStart    int             `xml:"h:HdrDestPortStart,omitempty"`    // The first destination port of the packets.
+		HdrDestPortEnd      int             `xml:"h:HdrDestPortEnd,omitempty"`      // The last destination port of the packets.
+	}
+	Hdr8021FilterRequest struct {
+		XMLName            xml.Name        `xml:"h:AMT_Hdr8021Filter"`
+		H                  string          `xml:"xmlns:h,attr"`
+		InstanceID         string          `xml:"h:InstanceID,omitempty"`        // The InstanceID of the filter, set for Put only.
+		ElementName        string          `xml:"h:ElementName,omitempty"`       // A user-friendly name for the object.
+		FilterDirection    FilterDirection `xml:"h:FilterDirection"`             // The direction of the packets that the filter applies to.
+		FilterProfile      FilterProfile   `xml:"h:FilterProfile"`               // The action taken on the packets that match the filter.
+		FilterProfileData  int             `xml:"h:FilterProfileData,omitempty"` // The data of the filter profile, such as the rate of a rate limit filter.
+		ActionEventOnMatch bool            `xml:"h:ActionEventOnMatch"`          // Generate an event for the packets that match the filter.
+		HdrSrcMACAddress   string          `xml:"h:HdrSrcMACAddress,omitempty"`  // The source MAC address of the frames.
+		HdrDestMACAddress  string          `xml:"h:HdrDestMACAddress,omitempty"` // The destination MAC address of the frames.
+		HdrProtocolID8021  int             `xml:"h:HdrProtocolID8021,omitempty"` // The EtherType of the frames.
+		HdrPriorityValue   int             `xml:"h:HdrPriorityValue,omitempty"`  // The 802.1Q priority of the frames.
+		HdrVLANID          int             `xml:"h:HdrVLANID,omitempty"`         // The 802.1Q VLAN ID of the frames.
+	}
+)
+
+type (
+	FilterDirection int
+	FilterProfile   int
+	IPVersion       int
+)
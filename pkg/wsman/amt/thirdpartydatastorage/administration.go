@@ -0,0 +1,220 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartydatastorage
+
+import (
+	"encoding/xml"
+	"errors"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewThirdPartyDataStorageAdministrationServiceWithClient instantiates a new third party data storage AdministrationService.
+func NewThirdPartyDataStorageAdministrationServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) AdministrationService {
+	return AdministrationService{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTThirdPartyDataStorageAdministrationService, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (administration AdministrationService) Get() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: administration.base.Get(nil),
+		},
+	}
+
+	// send the message to AMT
+	err = administration.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (administration AdministrationService) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: administration.base.Enumerate(),
+		},
+	}
+
+	// send the message to AMT
+	err = administration.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (administration AdministrationService) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: administration.base.Pull(enumerationContext),
+		},
+	}
+
+	// send the message to AMT
+	err = administration.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// AddStorageFpaclEntry adds an entry to the factory partner allocation control list, which reserves storage for the application of the entry.
+// Any return code other than 0 indicates an error condition.
+func (administration AdministrationService) AddStorageFpaclEntry(entry AllocationEntry) (response Response, err error) {
+	header := administration.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageAdministrationService, AddStorageFpaclEntry), AMTThirdPartyDataStorageAdministrationService, nil, "", "")
+	body := administration.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(AddStorageFpaclEntry), AMTThirdPartyDataStorageAdministrationService, &AddStorageFpaclEntry_INPUT{
+		ApplicationName:     entry.ApplicationName,
+		VendorName:          entry.VendorName,
+		IsPartner:           entry.IsPartner,
+		TotalAllocationSize: entry.TotalAllocationSize,
+	})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: administration.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = administration.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.AddStorageFpaclEntry_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("AddStorageFpaclEntry failed with return code " + response.Body.AddStorageFpaclEntry_OUTPUT.ReturnValue.String())
+	}
+
+	return response, nil
+}
+
+// EnumerateStorageAllocEntries returns the handles of the entries of the allocation control list.
+// Any return code other than 0 indicates an error condition.
+func (administration AdministrationService) EnumerateStorageAllocEntries() (response Response, err error) {
+	header := administration.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageAdministrationService, EnumerateStorageAllocEntries), AMTThirdPartyDataStorageAdministrationService, nil, "", "")
+	body := administration.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(EnumerateStorageAllocEntries), AMTThirdPartyDataStorageAdministrationService, nil)
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: administration.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = administration.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.EnumerateStorageAllocEntries_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("EnumerateStorageAllocEntries failed with return code " + response.Body.EnumerateStorageAllocEntries_OUTPUT.ReturnValue.String())
+	}
+
+	return response, nil
+}
+
+// GetStorageAllocEntry returns the entry of the allocation control list with the given handle.
+// Any return code other than 0 indicates an error condition.
+func (administration AdministrationService) GetStorageAllocEntry(handle int) (response Response, err error) {
+	header := administration.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageAdministrationService, GetStorageAllocEntry), AMTThirdPartyDataStorageAdministrationService, nil, "", "")
+	body := administration.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetStorageAllocEntry), AMTThirdPartyDataStorageAdministrationService, &GetStorageAllocEntry_INPUT{Handle: handle})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: administration.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = administration.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.GetStorageAllocEntry_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("GetStorageAllocEntry failed with return code " + response.Body.GetStorageAllocEntry_OUTPUT.ReturnValue.String())
+	}
+
+	return response, nil
+}
+
+// RemoveStorageFpaclEntry removes the entry of the allocation control list with the given handle.
+// Any return code other than 0 indicates an error condition.
+func (administration AdministrationService) RemoveStorageFpaclEntry(handle int) (response Response, err error) {
+	header := administration.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageAdministrationService, RemoveStorageFpaclEntry), AMTThirdPartyDataStorageAdministrationService, nil, "", "")
+	body := administration.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(RemoveStorageFpaclEntry), AMTThirdPartyDataStorageAdministrationService, &RemoveStorageFpaclEntry_INPUT{Handle: handle})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: administration.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = administration.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.RemoveStorageFpaclEntry_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("RemoveStorageFpaclEntry failed with return code " + response.Body.RemoveStorageFpaclEntry_OUTPUT.ReturnValue.String())
+	}
+
+	return response, nil
+}
@@ -0,0 +1,312 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartydatastorage
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func administrationServiceResponse() AdministrationServiceResponse {
+	return AdministrationServiceResponse{
+		XMLName:                 xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService", Local: "AMT_ThirdPartyDataStorageAdministrationService"},
+		CreationClassName:       "AMT_ThirdPartyDataStorageAdministrationService",
+		Name:                    "Intel(r) AMT Third Party Data Storage Administration Service",
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+		ElementName:             "Intel(r) AMT Third Party Data Storage Administration Service",
+		EnabledState:            5,
+		RequestedState:          12,
+	}
+}
+
+func TestPositiveThirdPartyDataStorageAdministrationService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/thirdpartydatastorage/administration",
+	}
+	elementUnderTest := NewThirdPartyDataStorageAdministrationServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_thirdpartydatastorageadministrationservice Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageAdministrationService Get call",
+				AMTThirdPartyDataStorageAdministrationService,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:                          xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AdministrationServiceGetResponse: administrationServiceResponse(),
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageAdministrationService Enumerate call",
+				AMTThirdPartyDataStorageAdministrationService,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageAdministrationService Pull call",
+				AMTThirdPartyDataStorageAdministrationService,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:                    xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						AdministrationServiceItems: []AdministrationServiceResponse{administrationServiceResponse()},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageAdministrationService AddStorageFpaclEntry call",
+				AMTThirdPartyDataStorageAdministrationService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageAdministrationService, AddStorageFpaclEntry),
+				"",
+				"<h:AddStorageFpaclEntry_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService\"><h:ApplicationName>Asset Agent</h:ApplicationName><h:VendorName>Contoso</h:VendorName><h:IsPartner>true</h:IsPartner><h:TotalAllocationSize>65536</h:TotalAllocationSize></h:AddStorageFpaclEntry_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = AddStorageFpaclEntry
+
+					return elementUnderTest.AddStorageFpaclEntry(AllocationEntry{ApplicationName: "Asset Agent", VendorName: "Contoso", IsPartner: true, TotalAllocationSize: 65536})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AddStorageFpaclEntry_OUTPUT: AddStorageFpaclEntry_OUTPUT{
+						XMLName: xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService", Local: "AddStorageFpaclEntry_OUTPUT"},
+						Handle:  1,
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageAdministrationService EnumerateStorageAllocEntries call",
+				AMTThirdPartyDataStorageAdministrationService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageAdministrationService, EnumerateStorageAllocEntries),
+				"",
+				"<h:EnumerateStorageAllocEntries_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService\"></h:EnumerateStorageAllocEntries_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = EnumerateStorageAllocEntries
+
+					return elementUnderTest.EnumerateStorageAllocEntries()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateStorageAllocEntries_OUTPUT: EnumerateStorageAllocEntries_OUTPUT{
+						XMLName: xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService", Local: "EnumerateStorageAllocEntries_OUTPUT"},
+						Handles: []int{1},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageAdministrationService GetStorageAllocEntry call",
+				AMTThirdPartyDataStorageAdministrationService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageAdministrationService, GetStorageAllocEntry),
+				"",
+				"<h:GetStorageAllocEntry_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService\"><h:Handle>1</h:Handle></h:GetStorageAllocEntry_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = GetStorageAllocEntry
+
+					return elementUnderTest.GetStorageAllocEntry(1)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetStorageAllocEntry_OUTPUT: GetStorageAllocEntry_OUTPUT{
+						XMLName:             xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService", Local: "GetStorageAllocEntry_OUTPUT"},
+						ApplicationName:     "Asset Agent",
+						VendorName:          "Contoso",
+						IsPartner:           true,
+						TotalAllocationSize: 65536,
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageAdministrationService RemoveStorageFpaclEntry call",
+				AMTThirdPartyDataStorageAdministrationService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageAdministrationService, RemoveStorageFpaclEntry),
+				"",
+				"<h:RemoveStorageFpaclEntry_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService\"><h:Handle>1</h:Handle></h:RemoveStorageFpaclEntry_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = RemoveStorageFpaclEntry
+
+					return elementUnderTest.RemoveStorageFpaclEntry(1)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					RemoveStorageFpaclEntry_OUTPUT: RemoveStorageFpaclEntry_OUTPUT{
+						XMLName: xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService", Local: "RemoveStorageFpaclEntry_OUTPUT"},
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeThirdPartyDataStorageAdministrationService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/thirdpartydatastorage/administration",
+	}
+	elementUnderTest := NewThirdPartyDataStorageAdministrationServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_thirdpartydatastorageadministrationservice Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_ThirdPartyDataStorageAdministrationService Get call",
+				AMTThirdPartyDataStorageAdministrationService,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageAdministrationService Enumerate call",
+				AMTThirdPartyDataStorageAdministrationService,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageAdministrationService Pull call",
+				AMTThirdPartyDataStorageAdministrationService,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageAdministrationService AddStorageFpaclEntry call",
+				AMTThirdPartyDataStorageAdministrationService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageAdministrationService, AddStorageFpaclEntry),
+				"",
+				"<h:AddStorageFpaclEntry_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService\"><h:ApplicationName>Asset Agent</h:ApplicationName><h:VendorName>Contoso</h:VendorName><h:IsPartner>true</h:IsPartner><h:TotalAllocationSize>65536</h:TotalAllocationSize></h:AddStorageFpaclEntry_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.AddStorageFpaclEntry(AllocationEntry{ApplicationName: "Asset Agent", VendorName: "Contoso", IsPartner: true, TotalAllocationSize: 65536})
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageAdministrationService EnumerateStorageAllocEntries call",
+				AMTThirdPartyDataStorageAdministrationService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageAdministrationService, EnumerateStorageAllocEntries),
+				"",
+				"<h:EnumerateStorageAllocEntries_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService\"></h:EnumerateStorageAllocEntries_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.EnumerateStorageAllocEntries()
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageAdministrationService GetStorageAllocEntry call",
+				AMTThirdPartyDataStorageAdministrationService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageAdministrationService, GetStorageAllocEntry),
+				"",
+				"<h:GetStorageAllocEntry_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService\"><h:Handle>1</h:Handle></h:GetStorageAllocEntry_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.GetStorageAllocEntry(1)
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageAdministrationService RemoveStorageFpaclEntry call",
+				AMTThirdPartyDataStorageAdministrationService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageAdministrationService, RemoveStorageFpaclEntry),
+				"",
+				"<h:RemoveStorageFpaclEntry_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageAdministrationService\"><h:Handle>1</h:Handle></h:RemoveStorageFpaclEntry_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.RemoveStorageFpaclEntry(1)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
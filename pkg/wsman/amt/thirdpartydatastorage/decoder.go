@@ -0,0 +1,80 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartydatastorage
+
+// INPUTS Constants.
+const (
+	AMTThirdPartyDataStorageService               string = "AMT_ThirdPartyDataStorageService"
+	AMTThirdPartyDataStorageAdministrationService string = "AMT_ThirdPartyDataStorageAdministrationService"
+	GetMTU                                        string = "GetMTU"
+	GetTotalStorage                               string = "GetTotalStorage"
+	GetAvailableStorage                           string = "GetAvailableStorage"
+	GetRegisteredApplications                     string = "GetRegisteredApplications"
+	GetApplicationAttributes                      string = "GetApplicationAttributes"
+	RegisterApplication                           string = "RegisterApplication"
+	UnregisterApplication                         string = "UnregisterApplication"
+	GetCurrentApplicationHandle                   string = "GetCurrentApplicationHandle"
+	AllocateBlock                                 string = "AllocateBlock"
+	DeallocateBlock                               string = "DeallocateBlock"
+	GetAllocatedBlocks                            string = "GetAllocatedBlocks"
+	GetBlockAttributes                            string = "GetBlockAttributes"
+	ReadBlock                                     string = "ReadBlock"
+	WriteBlock                                    string = "WriteBlock"
+	AddStorageFpaclEntry                          string = "AddStorageFpaclEntry"
+	EnumerateStorageAllocEntries                  string = "EnumerateStorageAllocEntries"
+	GetStorageAllocEntry                          string = "GetStorageAllocEntry"
+	RemoveStorageFpaclEntry                       string = "RemoveStorageFpaclEntry"
+	ValueNotFound                                 string = "Value not found in map"
+)
+
+// MaxBlockNameLength is the maximum length of the name of a block, and so of a key of a Store.
+const MaxBlockNameLength = 32
+
+const (
+	ReturnValueSuccess                  ReturnValue = 0
+	ReturnValueInternalError            ReturnValue = 1
+	ReturnValueNotReady                 ReturnValue = 2
+	ReturnValueApplicationNotRegistered ReturnValue = 8
+	ReturnValueInvalidRegistrationData  ReturnValue = 9
+	ReturnValueApplicationDoesNotExist  ReturnValue = 10
+	ReturnValueNotEnoughStorage         ReturnValue = 11
+	ReturnValueInvalidName              ReturnValue = 12
+	ReturnValueBlockDoesNotExist        ReturnValue = 13
+	ReturnValueInvalidByteOffset        ReturnValue = 14
+	ReturnValueInvalidByteCount         ReturnValue = 15
+	ReturnValueNotPermitted             ReturnValue = 16
+	ReturnValueNotOwner                 ReturnValue = 17
+	ReturnValueMaxLimitReached          ReturnValue = 23
+	ReturnValueInvalidParameter         ReturnValue = 36
+)
+
+// returnValueToString is a map of ReturnValue value to string.
+var returnValueToString = map[ReturnValue]string{
+	ReturnValueSuccess:                  "Success",
+	ReturnValueInternalError:            "InternalError",
+	ReturnValueNotReady:                 "NotReady",
+	ReturnValueApplicationNotRegistered: "ApplicationNotRegistered",
+	ReturnValueInvalidRegistrationData:  "InvalidRegistrationData",
+	ReturnValueApplicationDoesNotExist:  "ApplicationDoesNotExist",
+	ReturnValueNotEnoughStorage:         "NotEnoughStorage",
+	ReturnValueInvalidName:              "InvalidName",
+	ReturnValueBlockDoesNotExist:        "BlockDoesNotExist",
+	ReturnValueInvalidByteOffset:        "InvalidByteOffset",
+	ReturnValueInvalidByteCount:         "InvalidByteCount",
+	ReturnValueNotPermitted:             "NotPermitted",
+	ReturnValueNotOwner:                 "NotOwner",
+	ReturnValueMaxLimitReached:          "MaxLimitReached",
+	ReturnValueInvalidParameter:         "InvalidParameter",
+}
+
+// String returns the string representation of the ReturnValue value.
+func (r ReturnValue) String() string {
+	if value, exists := returnValueToString[r]; exists {
+		return value
+	}
+
+	return ValueNotFound
+}
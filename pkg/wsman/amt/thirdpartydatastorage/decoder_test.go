@@ -0,0 +1,31 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartydatastorage
+
+import "testing"
+
+func TestReturnValue_String(t *testing.T) {
+	tests := []struct {
+		state    ReturnValue
+		expected string
+	}{
+		{ReturnValueSuccess, "Success"},
+		{ReturnValueApplicationNotRegistered, "ApplicationNotRegistered"},
+		{ReturnValueNotEnoughStorage, "NotEnoughStorage"},
+		{ReturnValueBlockDoesNotExist, "BlockDoesNotExist"},
+		{ReturnValueInvalidByteCount, "InvalidByteCount"},
+		{ReturnValueNotOwner, "NotOwner"},
+		{ReturnValueInvalidParameter, "InvalidParameter"},
+		{ReturnValue(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
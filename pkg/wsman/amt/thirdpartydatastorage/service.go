@@ -0,0 +1,579 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package thirdpartydatastorage facilitates communication with Intel® AMT devices to store the data of host and network
+// applications in the nonvolatile memory of the ME, where it survives the reinstallation of the operating system.
+//
+// ThirdPartyDataStorageService:
+// Registers applications and allocates, reads, writes and frees their storage blocks.
+//
+// ThirdPartyDataStorageAdministrationService:
+// Administers the allocation of the storage between the registered applications.
+//
+// Store:
+// A key/value store on top of the ThirdPartyDataStorageService, keeping each value in a block named after its key.
+package thirdpartydatastorage
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/methods"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewThirdPartyDataStorageServiceWithClient instantiates a new third party data storage Service.
+func NewThirdPartyDataStorageServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Service {
+	return Service{
+		base: message.NewBaseWithClient(wsmanMessageCreator, AMTThirdPartyDataStorageService, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (service Service) Get() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Get(nil),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (service Service) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Enumerate(),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (service Service) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.Pull(enumerationContext),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// GetMTU returns the maximum number of bytes that ReadBlock and WriteBlock transfer in a single call.
+// Any return code other than 0 indicates an error condition.
+func (service Service) GetMTU() (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageService, GetMTU), AMTThirdPartyDataStorageService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetMTU), AMTThirdPartyDataStorageService, nil)
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.GetMTU_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("GetMTU failed with return code " + response.Body.GetMTU_OUTPUT.ReturnValue.String())
+	}
+
+	return response, nil
+}
+
+// GetTotalStorage returns the size, in bytes, of the storage of the device.
+// Any return code other than 0 indicates an error condition.
+func (service Service) GetTotalStorage() (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageService, GetTotalStorage), AMTThirdPartyDataStorageService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetTotalStorage), AMTThirdPartyDataStorageService, nil)
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.GetTotalStorage_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("GetTotalStorage failed with return code " + response.Body.GetTotalStorage_OUTPUT.ReturnValue.String())
+	}
+
+	return response, nil
+}
+
+// GetAvailableStorage returns the number of bytes of the storage that can still be allocated.
+// Any return code other than 0 indicates an error condition.
+func (service Service) GetAvailableStorage() (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageService, GetAvailableStorage), AMTThirdPartyDataStorageService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetAvailableStorage), AMTThirdPartyDataStorageService, nil)
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.GetAvailableStorage_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("GetAvailableStorage failed with return code " + response.Body.GetAvailableStorage_OUTPUT.ReturnValue.String())
+	}
+
+	return response, nil
+}
+
+// GetRegisteredApplications returns the handles of the registered applications.
+// Any return code other than 0 indicates an error condition.
+func (service Service) GetRegisteredApplications() (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageService, GetRegisteredApplications), AMTThirdPartyDataStorageService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetRegisteredApplications), AMTThirdPartyDataStorageService, nil)
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.GetRegisteredApplications_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("GetRegisteredApplications failed with return code " + response.Body.GetRegisteredApplications_OUTPUT.ReturnValue.String())
+	}
+
+	return response, nil
+}
+
+// GetApplicationAttributes returns the UUID and names of the registered application with the given handle.
+// Any return code other than 0 indicates an error condition.
+func (service Service) GetApplicationAttributes(applicationHandle int) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageService, GetApplicationAttributes), AMTThirdPartyDataStorageService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetApplicationAttributes), AMTThirdPartyDataStorageService, &GetApplicationAttributes_INPUT{ApplicationHandle: applicationHandle})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.GetApplicationAttributes_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("GetApplicationAttributes failed with return code " + response.Body.GetApplicationAttributes_OUTPUT.ReturnValue.String())
+	}
+
+	return response, nil
+}
+
+// RegisterApplication registers the application, which allows it to allocate blocks. Registering a registered application has no effect.
+// Any return code other than 0 indicates an error condition.
+func (service Service) RegisterApplication(application Application) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageService, RegisterApplication), AMTThirdPartyDataStorageService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(RegisterApplication), AMTThirdPartyDataStorageService, &RegisterApplication_INPUT{
+		CallerUUID:      uuidBytes(application.UUID),
+		VendorName:      application.VendorName,
+		ApplicationName: application.ApplicationName,
+		EnterpriseName:  application.EnterpriseName,
+	})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.RegisterApplication_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("RegisterApplication failed with return code " + response.Body.RegisterApplication_OUTPUT.ReturnValue.String())
+	}
+
+	return response, nil
+}
+
+// UnregisterApplication unregisters the application with the given handle and frees its blocks.
+// Any return code other than 0 indicates an error condition.
+func (service Service) UnregisterApplication(applicationHandle int) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageService, UnregisterApplication), AMTThirdPartyDataStorageService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(UnregisterApplication), AMTThirdPartyDataStorageService, &UnregisterApplication_INPUT{ApplicationHandle: applicationHandle})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.UnregisterApplication_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("UnregisterApplication failed with return code " + response.Body.UnregisterApplication_OUTPUT.ReturnValue.String())
+	}
+
+	return response, nil
+}
+
+// GetCurrentApplicationHandle returns the handle of the registered application, which is the session handle of its block operations.
+// Any return code other than 0 indicates an error condition.
+func (service Service) GetCurrentApplicationHandle(application Application) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageService, GetCurrentApplicationHandle), AMTThirdPartyDataStorageService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetCurrentApplicationHandle), AMTThirdPartyDataStorageService, &GetCurrentApplicationHandle_INPUT{
+		VendorName:      application.VendorName,
+		ApplicationName: application.ApplicationName,
+		EnterpriseName:  application.EnterpriseName,
+	})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.GetCurrentApplicationHandle_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("GetCurrentApplicationHandle failed with return code " + response.Body.GetCurrentApplicationHandle_OUTPUT.ReturnValue.String())
+	}
+
+	return response, nil
+}
+
+// AllocateBlock allocates a block of bytesRequested bytes to the application of the session. Hidden blocks are not listed to the other applications.
+// Any return code other than 0 indicates an error condition.
+func (service Service) AllocateBlock(sessionHandle, bytesRequested int, hidden bool, name string) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageService, AllocateBlock), AMTThirdPartyDataStorageService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(AllocateBlock), AMTThirdPartyDataStorageService, &AllocateBlock_INPUT{
+		SessionHandle:  sessionHandle,
+		BytesRequested: bytesRequested,
+		BlockHidden:    hidden,
+		BlockName:      name,
+	})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.AllocateBlock_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("AllocateBlock failed with return code " + response.Body.AllocateBlock_OUTPUT.ReturnValue.String())
+	}
+
+	return response, nil
+}
+
+// DeallocateBlock frees the block with the given handle.
+// Any return code other than 0 indicates an error condition.
+func (service Service) DeallocateBlock(sessionHandle, blockHandle int) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageService, DeallocateBlock), AMTThirdPartyDataStorageService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(DeallocateBlock), AMTThirdPartyDataStorageService, &DeallocateBlock_INPUT{SessionHandle: sessionHandle, BlockHandle: blockHandle})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.DeallocateBlock_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("DeallocateBlock failed with return code " + response.Body.DeallocateBlock_OUTPUT.ReturnValue.String())
+	}
+
+	return response, nil
+}
+
+// GetAllocatedBlocks returns the handles of the blocks allocated to the application with the handle ownerHandle.
+// Any return code other than 0 indicates an error condition.
+func (service Service) GetAllocatedBlocks(sessionHandle, ownerHandle int) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageService, GetAllocatedBlocks), AMTThirdPartyDataStorageService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetAllocatedBlocks), AMTThirdPartyDataStorageService, &GetAllocatedBlocks_INPUT{SessionHandle: sessionHandle, BlockOwnerApplication: ownerHandle})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.GetAllocatedBlocks_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("GetAllocatedBlocks failed with return code " + response.Body.GetAllocatedBlocks_OUTPUT.ReturnValue.String())
+	}
+
+	return response, nil
+}
+
+// GetBlockAttributes returns the size and name of the block with the given handle.
+// Any return code other than 0 indicates an error condition.
+func (service Service) GetBlockAttributes(sessionHandle, blockHandle int) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageService, GetBlockAttributes), AMTThirdPartyDataStorageService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(GetBlockAttributes), AMTThirdPartyDataStorageService, &GetBlockAttributes_INPUT{SessionHandle: sessionHandle, BlockHandle: blockHandle})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.GetBlockAttributes_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("GetBlockAttributes failed with return code " + response.Body.GetBlockAttributes_OUTPUT.ReturnValue.String())
+	}
+
+	return response, nil
+}
+
+// ReadBlock reads byteCount bytes of the block from byteOffset. byteCount must not exceed the MTU of the storage.
+// The Base64 decoded bytes are returned in ReadBlock_OUTPUT.Bytes.
+// Any return code other than 0 indicates an error condition.
+func (service Service) ReadBlock(sessionHandle, blockHandle, byteOffset, byteCount int) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageService, ReadBlock), AMTThirdPartyDataStorageService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(ReadBlock), AMTThirdPartyDataStorageService, &ReadBlock_INPUT{
+		SessionHandle: sessionHandle,
+		BlockHandle:   blockHandle,
+		ByteOffset:    byteOffset,
+		ByteCount:     byteCount,
+	})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.ReadBlock_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("ReadBlock failed with return code " + response.Body.ReadBlock_OUTPUT.ReturnValue.String())
+	}
+
+	response.Body.ReadBlock_OUTPUT.Bytes, err = base64.StdEncoding.DecodeString(response.Body.ReadBlock_OUTPUT.Data)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// WriteBlock writes data to the block from byteOffset. The length of data must not exceed the MTU of the storage.
+// Any return code other than 0 indicates an error condition.
+func (service Service) WriteBlock(sessionHandle, blockHandle, byteOffset int, data []byte) (response Response, err error) {
+	header := service.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(AMTThirdPartyDataStorageService, WriteBlock), AMTThirdPartyDataStorageService, nil, "", "")
+	body := service.base.WSManMessageCreator.CreateBody(methods.GenerateInputMethod(WriteBlock), AMTThirdPartyDataStorageService, &WriteBlock_INPUT{
+		SessionHandle: sessionHandle,
+		BlockHandle:   blockHandle,
+		ByteOffset:    byteOffset,
+		Data:          base64.StdEncoding.EncodeToString(data),
+	})
+
+	response = Response{
+		Message: &client.Message{
+			XMLInput: service.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	// send the message to AMT
+	err = service.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	if response.Body.WriteBlock_OUTPUT.ReturnValue != ReturnValueSuccess {
+		return response, errors.New("WriteBlock failed with return code " + response.Body.WriteBlock_OUTPUT.ReturnValue.String())
+	}
+
+	return response, nil
+}
+
+// uuidBytes returns the bytes of id as the elements of a uint8 array parameter.
+func uuidBytes(id uuid.UUID) []int {
+	bytes := make([]int, len(id))
+	for i, b := range id {
+		bytes[i] = int(b)
+	}
+
+	return bytes
+}
@@ -0,0 +1,647 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartydatastorage
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func TestJson(t *testing.T) {
+	response := Response{
+		Body: Body{
+			PullResponse: PullResponse{},
+		},
+	}
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ServiceGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"ElementName\":\"\",\"EnabledState\":0,\"RequestedState\":0},\"AdministrationServiceGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"ElementName\":\"\",\"EnabledState\":0,\"RequestedState\":0},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ServiceItems\":null,\"AdministrationServiceItems\":null},\"GetMTU_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Mtu\":0,\"ReturnValue\":0},\"GetTotalStorage_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"TotalStorage\":0,\"ReturnValue\":0},\"GetAvailableStorage_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"AvailableStorage\":0,\"ReturnValue\":0},\"GetRegisteredApplications_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ApplicationHandles\":null,\"ReturnValue\":0},\"GetApplicationAttributes_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"UUID\":null,\"VendorName\":\"\",\"ApplicationName\":\"\",\"EnterpriseName\":\"\",\"ReturnValue\":0},\"RegisterApplication_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"UnregisterApplication_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"GetCurrentApplicationHandle_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ApplicationHandle\":0,\"ReturnValue\":0},\"AllocateBlock_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"BlockHandle\":0,\"ReturnValue\":0},\"DeallocateBlock_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"GetAllocatedBlocks_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"BlockHandles\":null,\"ReturnValue\":0},\"GetBlockAttributes_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"BlockSize\":0,\"BlockHidden\":false,\"BlockName\":\"\",\"ReturnValue\":0},\"ReadBlock_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Data\":\"\",\"Bytes\":null,\"ReturnValue\":0},\"WriteBlock_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"AddStorageFpaclEntry_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Handle\":0,\"ReturnValue\":0},\"EnumerateStorageAllocEntries_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Handles\":null,\"ReturnValue\":0},\"GetStorageAllocEntry_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ApplicationName\":\"\",\"VendorName\":\"\",\"IsPartner\":false,\"TotalAllocationSize\":0,\"ReturnValue\":0},\"RemoveStorageFpaclEntry_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0}}"
+	result := response.JSON()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestYaml(t *testing.T) {
+	response := Response{
+		Body: Body{
+			PullResponse: PullResponse{},
+		},
+	}
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\nservicegetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    elementname: \"\"\n    enabledstate: 0\n    requestedstate: 0\nadministrationservicegetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    elementname: \"\"\n    enabledstate: 0\n    requestedstate: 0\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    serviceitems: []\n    administrationserviceitems: []\ngetmtu_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    mtu: 0\n    returnvalue: 0\ngettotalstorage_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    totalstorage: 0\n    returnvalue: 0\ngetavailablestorage_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    availablestorage: 0\n    returnvalue: 0\ngetregisteredapplications_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    applicationhandles: []\n    returnvalue: 0\ngetapplicationattributes_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    uuid: []\n    vendorname: \"\"\n    applicationname: \"\"\n    enterprisename: \"\"\n    returnvalue: 0\nregisterapplication_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\nunregisterapplication_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\ngetcurrentapplicationhandle_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    applicationhandle: 0\n    returnvalue: 0\nallocateblock_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    blockhandle: 0\n    returnvalue: 0\ndeallocateblock_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\ngetallocatedblocks_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    blockhandles: []\n    returnvalue: 0\ngetblockattributes_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    blocksize: 0\n    blockhidden: false\n    blockname: \"\"\n    returnvalue: 0\nreadblock_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    data: \"\"\n    bytes: []\n    returnvalue: 0\nwriteblock_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\naddstoragefpaclentry_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    handle: 0\n    returnvalue: 0\nenumeratestorageallocentries_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    handles: []\n    returnvalue: 0\ngetstorageallocentry_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    applicationname: \"\"\n    vendorname: \"\"\n    ispartner: false\n    totalallocationsize: 0\n    returnvalue: 0\nremovestoragefpaclentry_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\n"
+	result := response.YAML()
+	assert.Equal(t, expectedResult, result)
+}
+
+var testApplicationUUID = uuid.MustParse("d1e0ab5c-7f3a-4c38-9d4e-8a1f2b3c4d5e")
+
+func serviceResponse() ServiceResponse {
+	return ServiceResponse{
+		XMLName:                 xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService", Local: "AMT_ThirdPartyDataStorageService"},
+		CreationClassName:       "AMT_ThirdPartyDataStorageService",
+		Name:                    "Intel(r) AMT Third Party Data Storage Service",
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+		ElementName:             "Intel(r) AMT Third Party Data Storage Service",
+		EnabledState:            5,
+		RequestedState:          12,
+	}
+}
+
+func TestPositiveThirdPartyDataStorageService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/thirdpartydatastorage/service",
+	}
+	elementUnderTest := NewThirdPartyDataStorageServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_thirdpartydatastorageservice Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageService Get call",
+				AMTThirdPartyDataStorageService,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:            xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ServiceGetResponse: serviceResponse(),
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageService Enumerate call",
+				AMTThirdPartyDataStorageService,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageService Pull call",
+				AMTThirdPartyDataStorageService,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:      xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						ServiceItems: []ServiceResponse{serviceResponse()},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageService GetMTU call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, GetMTU),
+				"",
+				"<h:GetMTU_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"></h:GetMTU_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = GetMTU
+
+					return elementUnderTest.GetMTU()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetMTU_OUTPUT: GetMTU_OUTPUT{
+						XMLName: xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService", Local: "GetMTU_OUTPUT"},
+						Mtu:     1024,
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageService GetTotalStorage call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, GetTotalStorage),
+				"",
+				"<h:GetTotalStorage_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"></h:GetTotalStorage_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = GetTotalStorage
+
+					return elementUnderTest.GetTotalStorage()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetTotalStorage_OUTPUT: GetTotalStorage_OUTPUT{
+						XMLName:      xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService", Local: "GetTotalStorage_OUTPUT"},
+						TotalStorage: 196608,
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageService GetAvailableStorage call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, GetAvailableStorage),
+				"",
+				"<h:GetAvailableStorage_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"></h:GetAvailableStorage_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = GetAvailableStorage
+
+					return elementUnderTest.GetAvailableStorage()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetAvailableStorage_OUTPUT: GetAvailableStorage_OUTPUT{
+						XMLName:          xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService", Local: "GetAvailableStorage_OUTPUT"},
+						AvailableStorage: 131072,
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageService GetRegisteredApplications call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, GetRegisteredApplications),
+				"",
+				"<h:GetRegisteredApplications_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"></h:GetRegisteredApplications_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = GetRegisteredApplications
+
+					return elementUnderTest.GetRegisteredApplications()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetRegisteredApplications_OUTPUT: GetRegisteredApplications_OUTPUT{
+						XMLName:            xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService", Local: "GetRegisteredApplications_OUTPUT"},
+						ApplicationHandles: []int{1, 2},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageService GetApplicationAttributes call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, GetApplicationAttributes),
+				"",
+				"<h:GetApplicationAttributes_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:ApplicationHandle>1</h:ApplicationHandle></h:GetApplicationAttributes_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = GetApplicationAttributes
+
+					return elementUnderTest.GetApplicationAttributes(1)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetApplicationAttributes_OUTPUT: GetApplicationAttributes_OUTPUT{
+						XMLName:         xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService", Local: "GetApplicationAttributes_OUTPUT"},
+						UUID:            uuidBytes(testApplicationUUID),
+						VendorName:      "Contoso",
+						ApplicationName: "Asset Agent",
+						EnterpriseName:  "Contoso IT",
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageService RegisterApplication call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, RegisterApplication),
+				"",
+				"<h:RegisterApplication_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:CallerUUID>209</h:CallerUUID><h:CallerUUID>224</h:CallerUUID><h:CallerUUID>171</h:CallerUUID><h:CallerUUID>92</h:CallerUUID><h:CallerUUID>127</h:CallerUUID><h:CallerUUID>58</h:CallerUUID><h:CallerUUID>76</h:CallerUUID><h:CallerUUID>56</h:CallerUUID><h:CallerUUID>157</h:CallerUUID><h:CallerUUID>78</h:CallerUUID><h:CallerUUID>138</h:CallerUUID><h:CallerUUID>31</h:CallerUUID><h:CallerUUID>43</h:CallerUUID><h:CallerUUID>60</h:CallerUUID><h:CallerUUID>77</h:CallerUUID><h:CallerUUID>94</h:CallerUUID><h:VendorName>Contoso</h:VendorName><h:ApplicationName>Asset Agent</h:ApplicationName><h:EnterpriseName>Contoso IT</h:EnterpriseName></h:RegisterApplication_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = RegisterApplication
+
+					return elementUnderTest.RegisterApplication(Application{UUID: testApplicationUUID, VendorName: "Contoso", ApplicationName: "Asset Agent", EnterpriseName: "Contoso IT"})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					RegisterApplication_OUTPUT: RegisterApplication_OUTPUT{
+						XMLName: xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService", Local: "RegisterApplication_OUTPUT"},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageService UnregisterApplication call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, UnregisterApplication),
+				"",
+				"<h:UnregisterApplication_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:ApplicationHandle>1</h:ApplicationHandle></h:UnregisterApplication_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = UnregisterApplication
+
+					return elementUnderTest.UnregisterApplication(1)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					UnregisterApplication_OUTPUT: UnregisterApplication_OUTPUT{
+						XMLName: xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService", Local: "UnregisterApplication_OUTPUT"},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageService GetCurrentApplicationHandle call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, GetCurrentApplicationHandle),
+				"",
+				"<h:GetCurrentApplicationHandle_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:VendorName>Contoso</h:VendorName><h:ApplicationName>Asset Agent</h:ApplicationName><h:EnterpriseName>Contoso IT</h:EnterpriseName></h:GetCurrentApplicationHandle_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = GetCurrentApplicationHandle
+
+					return elementUnderTest.GetCurrentApplicationHandle(Application{UUID: testApplicationUUID, VendorName: "Contoso", ApplicationName: "Asset Agent", EnterpriseName: "Contoso IT"})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetCurrentApplicationHandle_OUTPUT: GetCurrentApplicationHandle_OUTPUT{
+						XMLName:           xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService", Local: "GetCurrentApplicationHandle_OUTPUT"},
+						ApplicationHandle: 1,
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageService AllocateBlock call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, AllocateBlock),
+				"",
+				"<h:AllocateBlock_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:SessionHandle>1</h:SessionHandle><h:BytesRequested>9</h:BytesRequested><h:BlockHidden>true</h:BlockHidden><h:BlockName>asset-tag</h:BlockName></h:AllocateBlock_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = AllocateBlock
+
+					return elementUnderTest.AllocateBlock(1, 9, true, "asset-tag")
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					AllocateBlock_OUTPUT: AllocateBlock_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService", Local: "AllocateBlock_OUTPUT"},
+						BlockHandle: 3,
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageService DeallocateBlock call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, DeallocateBlock),
+				"",
+				"<h:DeallocateBlock_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle></h:DeallocateBlock_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = DeallocateBlock
+
+					return elementUnderTest.DeallocateBlock(1, 3)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					DeallocateBlock_OUTPUT: DeallocateBlock_OUTPUT{
+						XMLName: xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService", Local: "DeallocateBlock_OUTPUT"},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageService GetAllocatedBlocks call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, GetAllocatedBlocks),
+				"",
+				"<h:GetAllocatedBlocks_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:SessionHandle>1</h:SessionHandle><h:BlockOwnerApplication>1</h:BlockOwnerApplication></h:GetAllocatedBlocks_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = GetAllocatedBlocks
+
+					return elementUnderTest.GetAllocatedBlocks(1, 1)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetAllocatedBlocks_OUTPUT: GetAllocatedBlocks_OUTPUT{
+						XMLName:      xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService", Local: "GetAllocatedBlocks_OUTPUT"},
+						BlockHandles: []int{3, 4},
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageService GetBlockAttributes call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, GetBlockAttributes),
+				"",
+				"<h:GetBlockAttributes_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle></h:GetBlockAttributes_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = GetBlockAttributes
+
+					return elementUnderTest.GetBlockAttributes(1, 3)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetBlockAttributes_OUTPUT: GetBlockAttributes_OUTPUT{
+						XMLName:     xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService", Local: "GetBlockAttributes_OUTPUT"},
+						BlockSize:   9,
+						BlockHidden: true,
+						BlockName:   "asset-tag",
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageService ReadBlock call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, ReadBlock),
+				"",
+				"<h:ReadBlock_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle><h:ByteOffset>0</h:ByteOffset><h:ByteCount>9</h:ByteCount></h:ReadBlock_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = ReadBlock
+
+					return elementUnderTest.ReadBlock(1, 3, 0, 9)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ReadBlock_OUTPUT: ReadBlock_OUTPUT{
+						XMLName: xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService", Local: "ReadBlock_OUTPUT"},
+						Data:    "QVNTRVQtMDQy",
+						Bytes:   []byte("ASSET-042"),
+					},
+				},
+			},
+			{
+				"should create and parse a valid AMT_ThirdPartyDataStorageService WriteBlock call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, WriteBlock),
+				"",
+				"<h:WriteBlock_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle><h:ByteOffset>0</h:ByteOffset><h:Data>QVNTRVQtMDQy</h:Data></h:WriteBlock_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = WriteBlock
+
+					return elementUnderTest.WriteBlock(1, 3, 0, []byte("ASSET-042"))
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					WriteBlock_OUTPUT: WriteBlock_OUTPUT{
+						XMLName: xml.Name{Space: "http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService", Local: "WriteBlock_OUTPUT"},
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeThirdPartyDataStorageService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/thirdpartydatastorage/service",
+	}
+	elementUnderTest := NewThirdPartyDataStorageServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_thirdpartydatastorageservice Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService Get call",
+				AMTThirdPartyDataStorageService,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService Enumerate call",
+				AMTThirdPartyDataStorageService,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService Pull call",
+				AMTThirdPartyDataStorageService,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService GetMTU call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, GetMTU),
+				"",
+				"<h:GetMTU_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"></h:GetMTU_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.GetMTU()
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService GetTotalStorage call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, GetTotalStorage),
+				"",
+				"<h:GetTotalStorage_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"></h:GetTotalStorage_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.GetTotalStorage()
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService GetAvailableStorage call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, GetAvailableStorage),
+				"",
+				"<h:GetAvailableStorage_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"></h:GetAvailableStorage_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.GetAvailableStorage()
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService GetRegisteredApplications call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, GetRegisteredApplications),
+				"",
+				"<h:GetRegisteredApplications_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"></h:GetRegisteredApplications_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.GetRegisteredApplications()
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService GetApplicationAttributes call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, GetApplicationAttributes),
+				"",
+				"<h:GetApplicationAttributes_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:ApplicationHandle>1</h:ApplicationHandle></h:GetApplicationAttributes_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.GetApplicationAttributes(1)
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService RegisterApplication call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, RegisterApplication),
+				"",
+				"<h:RegisterApplication_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:CallerUUID>209</h:CallerUUID><h:CallerUUID>224</h:CallerUUID><h:CallerUUID>171</h:CallerUUID><h:CallerUUID>92</h:CallerUUID><h:CallerUUID>127</h:CallerUUID><h:CallerUUID>58</h:CallerUUID><h:CallerUUID>76</h:CallerUUID><h:CallerUUID>56</h:CallerUUID><h:CallerUUID>157</h:CallerUUID><h:CallerUUID>78</h:CallerUUID><h:CallerUUID>138</h:CallerUUID><h:CallerUUID>31</h:CallerUUID><h:CallerUUID>43</h:CallerUUID><h:CallerUUID>60</h:CallerUUID><h:CallerUUID>77</h:CallerUUID><h:CallerUUID>94</h:CallerUUID><h:VendorName>Contoso</h:VendorName><h:ApplicationName>Asset Agent</h:ApplicationName><h:EnterpriseName>Contoso IT</h:EnterpriseName></h:RegisterApplication_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.RegisterApplication(Application{UUID: testApplicationUUID, VendorName: "Contoso", ApplicationName: "Asset Agent", EnterpriseName: "Contoso IT"})
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService UnregisterApplication call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, UnregisterApplication),
+				"",
+				"<h:UnregisterApplication_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:ApplicationHandle>1</h:ApplicationHandle></h:UnregisterApplication_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.UnregisterApplication(1)
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService GetCurrentApplicationHandle call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, GetCurrentApplicationHandle),
+				"",
+				"<h:GetCurrentApplicationHandle_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:VendorName>Contoso</h:VendorName><h:ApplicationName>Asset Agent</h:ApplicationName><h:EnterpriseName>Contoso IT</h:EnterpriseName></h:GetCurrentApplicationHandle_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.GetCurrentApplicationHandle(Application{UUID: testApplicationUUID, VendorName: "Contoso", ApplicationName: "Asset Agent", EnterpriseName: "Contoso IT"})
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService AllocateBlock call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, AllocateBlock),
+				"",
+				"<h:AllocateBlock_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:SessionHandle>1</h:SessionHandle><h:BytesRequested>9</h:BytesRequested><h:BlockHidden>true</h:BlockHidden><h:BlockName>asset-tag</h:BlockName></h:AllocateBlock_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.AllocateBlock(1, 9, true, "asset-tag")
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService DeallocateBlock call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, DeallocateBlock),
+				"",
+				"<h:DeallocateBlock_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle></h:DeallocateBlock_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.DeallocateBlock(1, 3)
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService GetAllocatedBlocks call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, GetAllocatedBlocks),
+				"",
+				"<h:GetAllocatedBlocks_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:SessionHandle>1</h:SessionHandle><h:BlockOwnerApplication>1</h:BlockOwnerApplication></h:GetAllocatedBlocks_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.GetAllocatedBlocks(1, 1)
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService GetBlockAttributes call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, GetBlockAttributes),
+				"",
+				"<h:GetBlockAttributes_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle></h:GetBlockAttributes_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.GetBlockAttributes(1, 3)
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService ReadBlock call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, ReadBlock),
+				"",
+				"<h:ReadBlock_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle><h:ByteOffset>0</h:ByteOffset><h:ByteCount>9</h:ByteCount></h:ReadBlock_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.ReadBlock(1, 3, 0, 9)
+				},
+			},
+			{
+				"should handle error when AMT_ThirdPartyDataStorageService WriteBlock call",
+				AMTThirdPartyDataStorageService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTThirdPartyDataStorageService, WriteBlock),
+				"",
+				"<h:WriteBlock_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_ThirdPartyDataStorageService\"><h:SessionHandle>1</h:SessionHandle><h:BlockHandle>3</h:BlockHandle><h:ByteOffset>0</h:ByteOffset><h:Data>QVNTRVQtMDQy</h:Data></h:WriteBlock_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.WriteBlock(1, 3, 0, []byte("ASSET-042"))
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,247 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartydatastorage
+
+import "errors"
+
+var (
+	// ErrInvalidKey is returned when a key is empty or longer than MaxBlockNameLength.
+	ErrInvalidKey = errors.New("thirdpartydatastorage: key must have 1 to 32 characters")
+	// ErrEmptyValue is returned by Store.Put for an empty value, as blocks cannot be empty.
+	ErrEmptyValue = errors.New("thirdpartydatastorage: value is empty")
+	// ErrKeyNotFound is returned when no block of the application is named after the key.
+	ErrKeyNotFound = errors.New("thirdpartydatastorage: key not found")
+)
+
+// Storage is the subset of Service used by the Store.
+type Storage interface {
+	GetMTU() (Response, error)
+	GetRegisteredApplications() (Response, error)
+	GetApplicationAttributes(applicationHandle int) (Response, error)
+	RegisterApplication(application Application) (Response, error)
+	GetCurrentApplicationHandle(application Application) (Response, error)
+	AllocateBlock(sessionHandle, bytesRequested int, hidden bool, name string) (Response, error)
+	DeallocateBlock(sessionHandle, blockHandle int) (Response, error)
+	GetAllocatedBlocks(sessionHandle, ownerHandle int) (Response, error)
+	GetBlockAttributes(sessionHandle, blockHandle int) (Response, error)
+	ReadBlock(sessionHandle, blockHandle, byteOffset, byteCount int) (Response, error)
+	WriteBlock(sessionHandle, blockHandle, byteOffset int, data []byte) (Response, error)
+}
+
+// Store is a key/value store of an application. Each value is kept in a hidden block of the application named after
+// its key, so keys are at most MaxBlockNameLength characters long. The reads and writes are split to fit the MTU of
+// the storage. A Store is not safe for concurrent use.
+type Store struct {
+	storage Storage
+	handle  int
+	mtu     int
+}
+
+type block struct {
+	handle int
+	size   int
+}
+
+// Applications returns the registered applications of the storage.
+func Applications(storage Storage) ([]Application, error) {
+	response, err := storage.GetRegisteredApplications()
+	if err != nil {
+		return nil, err
+	}
+
+	handles := response.Body.GetRegisteredApplications_OUTPUT.ApplicationHandles
+	applications := make([]Application, 0, len(handles))
+
+	for _, handle := range handles {
+		response, err = storage.GetApplicationAttributes(handle)
+		if err != nil {
+			return nil, err
+		}
+
+		attributes := response.Body.GetApplicationAttributes_OUTPUT
+		application := Application{
+			VendorName:      attributes.VendorName,
+			ApplicationName: attributes.ApplicationName,
+			EnterpriseName:  attributes.EnterpriseName,
+		}
+
+		for i := 0; i < len(attributes.UUID) && i < len(application.UUID); i++ {
+			application.UUID[i] = byte(attributes.UUID[i])
+		}
+
+		applications = append(applications, application)
+	}
+
+	return applications, nil
+}
+
+// NewStore registers the application and returns its Store.
+func NewStore(storage Storage, application Application) (*Store, error) {
+	if _, err := storage.RegisterApplication(application); err != nil {
+		return nil, err
+	}
+
+	response, err := storage.GetCurrentApplicationHandle(application)
+	if err != nil {
+		return nil, err
+	}
+
+	handle := response.Body.GetCurrentApplicationHandle_OUTPUT.ApplicationHandle
+
+	response, err = storage.GetMTU()
+	if err != nil {
+		return nil, err
+	}
+
+	mtu := response.Body.GetMTU_OUTPUT.Mtu
+	if mtu <= 0 {
+		return nil, errors.New("thirdpartydatastorage: invalid MTU")
+	}
+
+	return &Store{
+		storage: storage,
+		handle:  handle,
+		mtu:     mtu,
+	}, nil
+}
+
+// Keys returns the keys of the store.
+func (store *Store) Keys() ([]string, error) {
+	blocks, err := store.blocks()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(blocks))
+	for key := range blocks {
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// Get returns the value of the key, or ErrKeyNotFound.
+func (store *Store) Get(key string) ([]byte, error) {
+	b, err := store.find(key)
+	if err != nil {
+		return nil, err
+	}
+
+	value := make([]byte, 0, b.size)
+
+	for offset := 0; offset < b.size; offset += store.mtu {
+		response, err := store.storage.ReadBlock(store.handle, b.handle, offset, store.chunk(offset, b.size))
+		if err != nil {
+			return nil, err
+		}
+
+		value = append(value, response.Body.ReadBlock_OUTPUT.Bytes...)
+	}
+
+	return value, nil
+}
+
+// Put sets the value of the key. The block of the key is reallocated when the size of the value changes, so the key
+// is removed when the value cannot be written to the new block.
+func (store *Store) Put(key string, value []byte) error {
+	if len(value) == 0 {
+		return ErrEmptyValue
+	}
+
+	b, err := store.find(key)
+	if err != nil && !errors.Is(err, ErrKeyNotFound) {
+		return err
+	}
+
+	if err == nil && b.size != len(value) {
+		if _, err = store.storage.DeallocateBlock(store.handle, b.handle); err != nil {
+			return err
+		}
+
+		err = ErrKeyNotFound
+	}
+
+	if err != nil {
+		response, err := store.storage.AllocateBlock(store.handle, len(value), true, key)
+		if err != nil {
+			return err
+		}
+
+		b = block{handle: response.Body.AllocateBlock_OUTPUT.BlockHandle, size: len(value)}
+	}
+
+	for offset := 0; offset < len(value); offset += store.mtu {
+		if _, err = store.storage.WriteBlock(store.handle, b.handle, offset, value[offset:offset+store.chunk(offset, len(value))]); err != nil {
+			_, _ = store.storage.DeallocateBlock(store.handle, b.handle)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Delete removes the key, or returns ErrKeyNotFound.
+func (store *Store) Delete(key string) error {
+	b, err := store.find(key)
+	if err != nil {
+		return err
+	}
+
+	_, err = store.storage.DeallocateBlock(store.handle, b.handle)
+
+	return err
+}
+
+// chunk returns the number of bytes of a size byte block transferred by the call at offset.
+func (store *Store) chunk(offset, size int) int {
+	if size-offset < store.mtu {
+		return size - offset
+	}
+
+	return store.mtu
+}
+
+// find returns the block of the key.
+func (store *Store) find(key string) (block, error) {
+	if key == "" || len(key) > MaxBlockNameLength {
+		return block{}, ErrInvalidKey
+	}
+
+	blocks, err := store.blocks()
+	if err != nil {
+		return block{}, err
+	}
+
+	b, ok := blocks[key]
+	if !ok {
+		return block{}, ErrKeyNotFound
+	}
+
+	return b, nil
+}
+
+// blocks returns the blocks of the application by name.
+func (store *Store) blocks() (map[string]block, error) {
+	response, err := store.storage.GetAllocatedBlocks(store.handle, store.handle)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make(map[string]block)
+
+	for _, handle := range response.Body.GetAllocatedBlocks_OUTPUT.BlockHandles {
+		response, err = store.storage.GetBlockAttributes(store.handle, handle)
+		if err != nil {
+			return nil, err
+		}
+
+		attributes := response.Body.GetBlockAttributes_OUTPUT
+		blocks[attributes.BlockName] = block{handle: handle, size: attributes.BlockSize}
+	}
+
+	return blocks, nil
+}
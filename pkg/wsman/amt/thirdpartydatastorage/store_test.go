@@ -0,0 +1,233 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartydatastorage
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errStorage = errors.New("storage error")
+
+type memoryBlock struct {
+	name string
+	data []byte
+}
+
+// memoryStorage keeps the blocks of a single application in memory and records the calls of the Store.
+type memoryStorage struct {
+	mtu        int
+	nextHandle int
+	blocks     map[int]*memoryBlock
+	calls      []string
+	failOn     string
+}
+
+func newMemoryStorage(mtu int) *memoryStorage {
+	return &memoryStorage{mtu: mtu, nextHandle: 1, blocks: make(map[int]*memoryBlock)}
+}
+
+func (s *memoryStorage) call(method string, args ...interface{}) error {
+	s.calls = append(s.calls, fmt.Sprint(append([]interface{}{method}, args...)...))
+
+	if method == s.failOn {
+		return errStorage
+	}
+
+	return nil
+}
+
+func (s *memoryStorage) GetMTU() (response Response, err error) {
+	response.Body.GetMTU_OUTPUT.Mtu = s.mtu
+
+	return response, s.call(GetMTU)
+}
+
+func (s *memoryStorage) GetRegisteredApplications() (response Response, err error) {
+	response.Body.GetRegisteredApplications_OUTPUT.ApplicationHandles = []int{1}
+
+	return response, s.call(GetRegisteredApplications)
+}
+
+func (s *memoryStorage) GetApplicationAttributes(applicationHandle int) (response Response, err error) {
+	response.Body.GetApplicationAttributes_OUTPUT = GetApplicationAttributes_OUTPUT{
+		UUID:            uuidBytes(testApplicationUUID),
+		VendorName:      "Contoso",
+		ApplicationName: "Asset Agent",
+		EnterpriseName:  "Contoso IT",
+	}
+
+	return response, s.call(GetApplicationAttributes)
+}
+
+func (s *memoryStorage) RegisterApplication(application Application) (Response, error) {
+	return Response{}, s.call(RegisterApplication)
+}
+
+func (s *memoryStorage) GetCurrentApplicationHandle(application Application) (response Response, err error) {
+	response.Body.GetCurrentApplicationHandle_OUTPUT.ApplicationHandle = 1
+
+	return response, s.call(GetCurrentApplicationHandle)
+}
+
+func (s *memoryStorage) AllocateBlock(sessionHandle, bytesRequested int, hidden bool, name string) (response Response, err error) {
+	if err = s.call(AllocateBlock, " ", name, " ", bytesRequested); err != nil {
+		return response, err
+	}
+
+	s.blocks[s.nextHandle] = &memoryBlock{name: name, data: make([]byte, bytesRequested)}
+	response.Body.AllocateBlock_OUTPUT.BlockHandle = s.nextHandle
+	s.nextHandle++
+
+	return response, nil
+}
+
+func (s *memoryStorage) DeallocateBlock(sessionHandle, blockHandle int) (Response, error) {
+	delete(s.blocks, blockHandle)
+
+	return Response{}, s.call(DeallocateBlock, " ", blockHandle)
+}
+
+func (s *memoryStorage) GetAllocatedBlocks(sessionHandle, ownerHandle int) (response Response, err error) {
+	for handle := range s.blocks {
+		response.Body.GetAllocatedBlocks_OUTPUT.BlockHandles = append(response.Body.GetAllocatedBlocks_OUTPUT.BlockHandles, handle)
+	}
+
+	sort.Ints(response.Body.GetAllocatedBlocks_OUTPUT.BlockHandles)
+
+	return response, s.call(GetAllocatedBlocks)
+}
+
+func (s *memoryStorage) GetBlockAttributes(sessionHandle, blockHandle int) (response Response, err error) {
+	b := s.blocks[blockHandle]
+	response.Body.GetBlockAttributes_OUTPUT = GetBlockAttributes_OUTPUT{BlockSize: len(b.data), BlockHidden: true, BlockName: b.name}
+
+	return response, s.call(GetBlockAttributes)
+}
+
+func (s *memoryStorage) ReadBlock(sessionHandle, blockHandle, byteOffset, byteCount int) (response Response, err error) {
+	if byteCount > s.mtu {
+		return response, errors.New("byte count exceeds the MTU")
+	}
+
+	response.Body.ReadBlock_OUTPUT.Bytes = s.blocks[blockHandle].data[byteOffset : byteOffset+byteCount]
+	response.Body.ReadBlock_OUTPUT.Data = base64.StdEncoding.EncodeToString(response.Body.ReadBlock_OUTPUT.Bytes)
+
+	return response, s.call(ReadBlock, " ", byteOffset, " ", byteCount)
+}
+
+func (s *memoryStorage) WriteBlock(sessionHandle, blockHandle, byteOffset int, data []byte) (Response, error) {
+	if err := s.call(WriteBlock, " ", byteOffset, " ", len(data)); err != nil {
+		return Response{}, err
+	}
+
+	if len(data) > s.mtu {
+		return Response{}, errors.New("data exceeds the MTU")
+	}
+
+	copy(s.blocks[blockHandle].data[byteOffset:], data)
+
+	return Response{}, nil
+}
+
+func newTestStore(t *testing.T, mtu int) (*memoryStorage, *Store) {
+	t.Helper()
+
+	storage := newMemoryStorage(mtu)
+	store, err := NewStore(storage, Application{UUID: testApplicationUUID, VendorName: "Contoso", ApplicationName: "Asset Agent", EnterpriseName: "Contoso IT"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{RegisterApplication, GetCurrentApplicationHandle, GetMTU}, storage.calls)
+
+	storage.calls = nil
+
+	return storage, store
+}
+
+func TestStore(t *testing.T) {
+	storage, store := newTestStore(t, 4)
+
+	assert.NoError(t, store.Put("asset-tag", []byte("ASSET-042")))
+	assert.Equal(t, []string{GetAllocatedBlocks, "AllocateBlock asset-tag 9", "WriteBlock 0 4", "WriteBlock 4 4", "WriteBlock 8 1"}, storage.calls)
+
+	assert.NoError(t, store.Put("recovery", []byte("wipe-2024-10")))
+
+	value, err := store.Get("asset-tag")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("ASSET-042"), value)
+
+	storage.calls = nil
+	assert.NoError(t, store.Put("asset-tag", []byte("ASSET-043")))
+	assert.NotContains(t, storage.calls, "AllocateBlock asset-tag 9")
+
+	storage.calls = nil
+	assert.NoError(t, store.Put("asset-tag", []byte("ASSET-1000")))
+	assert.Contains(t, storage.calls, "DeallocateBlock 1")
+	assert.Contains(t, storage.calls, "AllocateBlock asset-tag 10")
+
+	value, err = store.Get("asset-tag")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("ASSET-1000"), value)
+
+	keys, err := store.Keys()
+	assert.NoError(t, err)
+	sort.Strings(keys)
+	assert.Equal(t, []string{"asset-tag", "recovery"}, keys)
+
+	assert.NoError(t, store.Delete("recovery"))
+
+	_, err = store.Get("recovery")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+	assert.ErrorIs(t, store.Delete("recovery"), ErrKeyNotFound)
+}
+
+func TestStoreInvalidArguments(t *testing.T) {
+	storage, store := newTestStore(t, 4)
+
+	_, err := store.Get("")
+	assert.ErrorIs(t, err, ErrInvalidKey)
+	assert.ErrorIs(t, store.Put("an-asset-tag-key-that-is-too-long", []byte("ASSET-042")), ErrInvalidKey)
+	assert.ErrorIs(t, store.Put("asset-tag", nil), ErrEmptyValue)
+	assert.Empty(t, storage.calls)
+}
+
+func TestStoreWriteFailure(t *testing.T) {
+	storage, store := newTestStore(t, 4)
+	storage.failOn = WriteBlock
+
+	assert.ErrorIs(t, store.Put("asset-tag", []byte("ASSET-042")), errStorage)
+	assert.Equal(t, []string{GetAllocatedBlocks, "AllocateBlock asset-tag 9", "WriteBlock 0 4", "DeallocateBlock 1"}, storage.calls)
+	assert.Empty(t, storage.blocks)
+}
+
+func TestNewStoreFailure(t *testing.T) {
+	for _, method := range []string{RegisterApplication, GetCurrentApplicationHandle, GetMTU} {
+		storage := newMemoryStorage(4)
+		storage.failOn = method
+
+		_, err := NewStore(storage, Application{})
+		assert.ErrorIs(t, err, errStorage)
+	}
+
+	_, err := NewStore(newMemoryStorage(0), Application{})
+	assert.Error(t, err)
+}
+
+func TestApplications(t *testing.T) {
+	storage := newMemoryStorage(4)
+
+	applications, err := Applications(storage)
+	assert.NoError(t, err)
+	assert.Equal(t, []Application{{UUID: testApplicationUUID, VendorName: "Contoso", ApplicationName: "Asset Agent", EnterpriseName: "Contoso IT"}}, applications)
+
+	storage.failOn = GetApplicationAttributes
+	_, err = Applications(storage)
+	assert.ErrorIs(t, err, errStorage)
+}
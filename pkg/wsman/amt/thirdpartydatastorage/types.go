@@ -0,0 +1,286 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package thirdpartydatastorage
+
+import (
+	"encoding/xml"
+
+	"github.com/google/uuid"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type (
+	Service struct {
+		base message.Base
+	}
+	AdministrationService struct {
+		base message.Base
+	}
+)
+
+// OUTPUT
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName                             xml.Name                      `xml:"Body"`
+		ServiceGetResponse                  ServiceResponse               `xml:"AMT_ThirdPartyDataStorageService"`
+		AdministrationServiceGetResponse    AdministrationServiceResponse `xml:"AMT_ThirdPartyDataStorageAdministrationService"`
+		EnumerateResponse                   common.EnumerateResponse
+		PullResponse                        PullResponse
+		GetMTU_OUTPUT                       GetMTU_OUTPUT
+		GetTotalStorage_OUTPUT              GetTotalStorage_OUTPUT
+		GetAvailableStorage_OUTPUT          GetAvailableStorage_OUTPUT
+		GetRegisteredApplications_OUTPUT    GetRegisteredApplications_OUTPUT
+		GetApplicationAttributes_OUTPUT     GetApplicationAttributes_OUTPUT
+		RegisterApplication_OUTPUT          RegisterApplication_OUTPUT
+		UnregisterApplication_OUTPUT        UnregisterApplication_OUTPUT
+		GetCurrentApplicationHandle_OUTPUT  GetCurrentApplicationHandle_OUTPUT
+		AllocateBlock_OUTPUT                AllocateBlock_OUTPUT
+		DeallocateBlock_OUTPUT              DeallocateBlock_OUTPUT
+		GetAllocatedBlocks_OUTPUT           GetAllocatedBlocks_OUTPUT
+		GetBlockAttributes_OUTPUT           GetBlockAttributes_OUTPUT
+		ReadBlock_OUTPUT                    ReadBlock_OUTPUT
+		WriteBlock_OUTPUT                   WriteBlock_OUTPUT
+		AddStorageFpaclEntry_OUTPUT         AddStorageFpaclEntry_OUTPUT
+		EnumerateStorageAllocEntries_OUTPUT EnumerateStorageAllocEntries_OUTPUT
+		GetStorageAllocEntry_OUTPUT         GetStorageAllocEntry_OUTPUT
+		RemoveStorageFpaclEntry_OUTPUT      RemoveStorageFpaclEntry_OUTPUT
+	}
+	PullResponse struct {
+		XMLName                    xml.Name                        `xml:"PullResponse"`
+		ServiceItems               []ServiceResponse               `xml:"Items>AMT_ThirdPartyDataStorageService"`
+		AdministrationServiceItems []AdministrationServiceResponse `xml:"Items>AMT_ThirdPartyDataStorageAdministrationService"`
+	}
+	ServiceResponse struct {
+		XMLName                 xml.Name `xml:"AMT_ThirdPartyDataStorageService"`
+		CreationClassName       string   `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass that is used in the creation of an instance.
+		Name                    string   `xml:"Name,omitempty"`                    // The Name property uniquely identifies the Service and provides an indication of the functionality that is managed.
+		SystemCreationClassName string   `xml:"SystemCreationClassName,omitempty"` // The CreationClassName of the scoping System.
+		SystemName              string   `xml:"SystemName,omitempty"`              // The Name of the scoping System.
+		ElementName             string   `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		EnabledState            int      `xml:"EnabledState"`                      // EnabledState is an integer enumeration that indicates the enabled and disabled states of an element.
+		RequestedState          int      `xml:"RequestedState"`                    // RequestedState is an integer enumeration that indicates the last requested or desired state for the element.
+	}
+	AdministrationServiceResponse struct {
+		XMLName                 xml.Name `xml:"AMT_ThirdPartyDataStorageAdministrationService"`
+		CreationClassName       string   `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass that is used in the creation of an instance.
+		Name                    string   `xml:"Name,omitempty"`                    // The Name property uniquely identifies the Service and provides an indication of the functionality that is managed.
+		SystemCreationClassName string   `xml:"SystemCreationClassName,omitempty"` // The CreationClassName of the scoping System.
+		SystemName              string   `xml:"SystemName,omitempty"`              // The Name of the scoping System.
+		ElementName             string   `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		EnabledState            int      `xml:"EnabledState"`                      // EnabledState is an integer enumeration that indicates the enabled and disabled states of an element.
+		RequestedState          int      `xml:"RequestedState"`                    // RequestedState is an integer enumeration that indicates the last requested or desired state for the element.
+	}
+	GetMTU_OUTPUT struct {
+		XMLName     xml.Name    `xml:"GetMTU_OUTPUT"`
+		Mtu         int         `xml:"Mtu"` // The maximum number of bytes transferred by a single ReadBlock or WriteBlock call.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	GetTotalStorage_OUTPUT struct {
+		XMLName      xml.Name    `xml:"GetTotalStorage_OUTPUT"`
+		TotalStorage int         `xml:"TotalStorage"` // The size, in bytes, of the storage.
+		ReturnValue  ReturnValue `xml:"ReturnValue"`
+	}
+	GetAvailableStorage_OUTPUT struct {
+		XMLName          xml.Name    `xml:"GetAvailableStorage_OUTPUT"`
+		AvailableStorage int         `xml:"AvailableStorage"` // The number of bytes that can still be allocated.
+		ReturnValue      ReturnValue `xml:"ReturnValue"`
+	}
+	GetRegisteredApplications_OUTPUT struct {
+		XMLName            xml.Name    `xml:"GetRegisteredApplications_OUTPUT"`
+		ApplicationHandles []int       `xml:"ApplicationHandles"` // The handles of the registered applications.
+		ReturnValue        ReturnValue `xml:"ReturnValue"`
+	}
+	GetApplicationAttributes_OUTPUT struct {
+		XMLName         xml.Name    `xml:"GetApplicationAttributes_OUTPUT"`
+		UUID            []int       `xml:"UUID"`            // The bytes of the UUID of the application.
+		VendorName      string      `xml:"VendorName"`      // The name of the vendor of the application.
+		ApplicationName string      `xml:"ApplicationName"` // The name of the application.
+		EnterpriseName  string      `xml:"EnterpriseName"`  // The name of the enterprise that the application belongs to.
+		ReturnValue     ReturnValue `xml:"ReturnValue"`
+	}
+	RegisterApplication_OUTPUT struct {
+		XMLName     xml.Name    `xml:"RegisterApplication_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	UnregisterApplication_OUTPUT struct {
+		XMLName     xml.Name    `xml:"UnregisterApplication_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	GetCurrentApplicationHandle_OUTPUT struct {
+		XMLName           xml.Name    `xml:"GetCurrentApplicationHandle_OUTPUT"`
+		ApplicationHandle int         `xml:"ApplicationHandle"` // The handle of the application, which is the session handle of its block operations.
+		ReturnValue       ReturnValue `xml:"ReturnValue"`
+	}
+	AllocateBlock_OUTPUT struct {
+		XMLName     xml.Name    `xml:"AllocateBlock_OUTPUT"`
+		BlockHandle int         `xml:"BlockHandle"` // The handle of the allocated block.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	DeallocateBlock_OUTPUT struct {
+		XMLName     xml.Name    `xml:"DeallocateBlock_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	GetAllocatedBlocks_OUTPUT struct {
+		XMLName      xml.Name    `xml:"GetAllocatedBlocks_OUTPUT"`
+		BlockHandles []int       `xml:"BlockHandles"` // The handles of the blocks of the application.
+		ReturnValue  ReturnValue `xml:"ReturnValue"`
+	}
+	GetBlockAttributes_OUTPUT struct {
+		XMLName     xml.Name    `xml:"GetBlockAttributes_OUTPUT"`
+		BlockSize   int         `xml:"BlockSize"`   // The size, in bytes, of the block.
+		BlockHidden bool        `xml:"BlockHidden"` // Whether the block is hidden from the other applications.
+		BlockName   string      `xml:"BlockName"`   // The name of the block.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	ReadBlock_OUTPUT struct {
+		XMLName     xml.Name    `xml:"ReadBlock_OUTPUT"`
+		Data        string      `xml:"Data"` // The Base64 encoded bytes read from the block.
+		Bytes       []byte      `xml:"-"`    // The decoded Data.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	WriteBlock_OUTPUT struct {
+		XMLName     xml.Name    `xml:"WriteBlock_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	AddStorageFpaclEntry_OUTPUT struct {
+		XMLName     xml.Name    `xml:"AddStorageFpaclEntry_OUTPUT"`
+		Handle      int         `xml:"Handle"` // The handle of the added entry.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	EnumerateStorageAllocEntries_OUTPUT struct {
+		XMLName     xml.Name    `xml:"EnumerateStorageAllocEntries_OUTPUT"`
+		Handles     []int       `xml:"Handles"` // The handles of the entries of the allocation control list.
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+	GetStorageAllocEntry_OUTPUT struct {
+		XMLName             xml.Name    `xml:"GetStorageAllocEntry_OUTPUT"`
+		ApplicationName     string      `xml:"ApplicationName"`     // The name of the application of the entry.
+		VendorName          string      `xml:"VendorName"`          // The name of the vendor of the application.
+		IsPartner           bool        `xml:"IsPartner"`           // Whether the application is of a factory partner.
+		TotalAllocationSize int         `xml:"TotalAllocationSize"` // The number of bytes reserved for the application.
+		ReturnValue         ReturnValue `xml:"ReturnValue"`
+	}
+	RemoveStorageFpaclEntry_OUTPUT struct {
+		XMLName     xml.Name    `xml:"RemoveStorageFpaclEntry_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+)
+
+// INPUT
+// Request Types.
+type (
+	GetApplicationAttributes_INPUT struct {
+		XMLName           xml.Name `xml:"h:GetApplicationAttributes_INPUT"`
+		H                 string   `xml:"xmlns:h,attr"`
+		ApplicationHandle int      `xml:"h:ApplicationHandle"`
+	}
+	RegisterApplication_INPUT struct {
+		XMLName         xml.Name `xml:"h:RegisterApplication_INPUT"`
+		H               string   `xml:"xmlns:h,attr"`
+		CallerUUID      []int    `xml:"h:CallerUUID"` // The bytes of the UUID of the application.
+		VendorName      string   `xml:"h:VendorName"`
+		ApplicationName string   `xml:"h:ApplicationName"`
+		EnterpriseName  string   `xml:"h:EnterpriseName"`
+	}
+	UnregisterApplication_INPUT struct {
+		XMLName           xml.Name `xml:"h:UnregisterApplication_INPUT"`
+		H                 string   `xml:"xmlns:h,attr"`
+		ApplicationHandle int      `xml:"h:ApplicationHandle"`
+	}
+	GetCurrentApplicationHandle_INPUT struct {
+		XMLName         xml.Name `xml:"h:GetCurrentApplicationHandle_INPUT"`
+		H               string   `xml:"xmlns:h,attr"`
+		VendorName      string   `xml:"h:VendorName"`
+		ApplicationName string   `xml:"h:ApplicationName"`
+		EnterpriseName  string   `xml:"h:EnterpriseName"`
+	}
+	AllocateBlock_INPUT struct {
+		XMLName        xml.Name `xml:"h:AllocateBlock_INPUT"`
+		H              string   `xml:"xmlns:h,attr"`
+		SessionHandle  int      `xml:"h:SessionHandle"`
+		BytesRequested int      `xml:"h:BytesRequested"`
+		BlockHidden    bool     `xml:"h:BlockHidden"`
+		BlockName      string   `xml:"h:BlockName"`
+	}
+	DeallocateBlock_INPUT struct {
+		XMLName       xml.Name `xml:"h:DeallocateBlock_INPUT"`
+		H             string   `xml:"xmlns:h,attr"`
+		SessionHandle int      `xml:"h:SessionHandle"`
+		BlockHandle   int      `xml:"h:BlockHandle"`
+	}
+	GetAllocatedBlocks_INPUT struct {
+		XMLName               xml.Name `xml:"h:GetAllocatedBlocks_INPUT"`
+		H                     string   `xml:"xmlns:h,attr"`
+		SessionHandle         int      `xml:"h:SessionHandle"`
+		BlockOwnerApplication int      `xml:"h:BlockOwnerApplication"`
+	}
+	GetBlockAttributes_INPUT struct {
+		XMLName       xml.Name `xml:"h:GetBlockAttributes_INPUT"`
+		H             string   `xml:"xmlns:h,attr"`
+		SessionHandle int      `xml:"h:SessionHandle"`
+		BlockHandle   int      `xml:"h:BlockHandle"`
+	}
+	ReadBlock_INPUT struct {
+		XMLName       xml.Name `xml:"h:ReadBlock_INPUT"`
+		H             string   `xml:"xmlns:h,attr"`
+		SessionHandle int      `xml:"h:SessionHandle"`
+		BlockHandle   int      `xml:"h:BlockHandle"`
+		ByteOffset    int      `xml:"h:ByteOffset"`
+		ByteCount     int      `xml:"h:ByteCount"`
+	}
+	WriteBlock_INPUT struct {
+		XMLName       xml.Name `xml:"h:WriteBlock_INPUT"`
+		H             string   `xml:"xmlns:h,attr"`
+		SessionHandle int      `xml:"h:SessionHandle"`
+		BlockHandle   int      `xml:"h:BlockHandle"`
+		ByteOffset    int      `xml:"h:ByteOffset"`
+		Data          string   `xml:"h:Data"` // The Base64 encoded bytes to write.
+	}
+	AddStorageFpaclEntry_INPUT struct {
+		XMLName             xml.Name `xml:"h:AddStorageFpaclEntry_INPUT"`
+		H                   string   `xml:"xmlns:h,attr"`
+		ApplicationName     string   `xml:"h:ApplicationName"`
+		VendorName          string   `xml:"h:VendorName"`
+		IsPartner           bool     `xml:"h:IsPartner"`
+		TotalAllocationSize int      `xml:"h:TotalAllocationSize"`
+	}
+	GetStorageAllocEntry_INPUT struct {
+		XMLName xml.Name `xml:"h:GetStorageAllocEntry_INPUT"`
+		H       string   `xml:"xmlns:h,attr"`
+		Handle  int      `xml:"h:Handle"`
+	}
+	RemoveStorageFpaclEntry_INPUT struct {
+		XMLName xml.Name `xml:"h:RemoveStorageFpaclEntry_INPUT"`
+		H       string   `xml:"xmlns:h,attr"`
+		Handle  int      `xml:"h:Handle"`
+	}
+	// Application identifies an application of the storage.
+	Application struct {
+		UUID            uuid.UUID // The UUID of the application.
+		VendorName      string    // The name of the vendor of the application.
+		ApplicationName string    // The name of the application.
+		EnterpriseName  string    // The name of the enterprise that the application belongs to.
+	}
+	// AllocationEntry is an entry of the factory partner allocation control list.
+	AllocationEntry struct {
+		ApplicationName     string // The name of the application.
+		VendorName          string // The name of the vendor of the application.
+		IsPartner           bool   // Whether the application is of a factory partner.
+		TotalAllocationSize int    // The number of bytes reserved for the application.
+	}
+)
+
+type ReturnValue int
@@ -0,0 +1,286 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package webui
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func TestJson(t *testing.T) {
+	response := Response{
+		Body: Body{
+			GetResponse: WebUIResponse{},
+		},
+	}
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"RequestStateChange_OUTPUT\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ReturnValue\":0},\"GetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"ElementName\":\"\",\"EnabledState\":0,\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\"},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"WebUIItems\":null}}"
+	result := response.JSON()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestYaml(t *testing.T) {
+	response := Response{
+		Body: Body{
+			GetResponse: WebUIResponse{},
+		},
+	}
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\nrequeststatechange_output:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    returnvalue: 0\ngetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    elementname: \"\"\n    enabledstate: 0\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    webuiitems: []\n"
+	result := response.YAML()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestPositiveAMT_WebUIService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/webui",
+	}
+	elementUnderTest := NewWebUIServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_UserInitiatedConnectionService Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid AMT_WebUIService Get wsman message",
+				AMTWebUIService,
+				wsmantesting.Get,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetResponse: WebUIResponse{
+						XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTWebUIService), Local: AMTWebUIService},
+						CreationClassName:       AMTWebUIService,
+						ElementName:             "Intel(r) AMT Web UI Service",
+						EnabledState:            32769,
+						Name:                    "Intel(r) AMT Web UI Service",
+						SystemCreationClassName: "CIM_ComputerSystem",
+						SystemName:              "Intel(r) AMT",
+					},
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid AMT_WebUIService Enumerate wsman message",
+				AMTWebUIService,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "D3000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid AMT_WebUIService Pull wsman message",
+				AMTWebUIService,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						WebUIItems: []WebUIResponse{
+							{
+								XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTWebUIService), Local: AMTWebUIService},
+								CreationClassName:       AMTWebUIService,
+								ElementName:             "Intel(r) AMT Web UI Service",
+								EnabledState:            32769,
+								Name:                    "Intel(r) AMT Web UI Service",
+								SystemCreationClassName: "CIM_ComputerSystem",
+								SystemName:              "Intel(r) AMT",
+							},
+						},
+					},
+				},
+			},
+			// REQUEST STATE CHANGE
+			{
+				"should create a valid AMT_WebUIService RequestStateChange wsman message",
+				AMTWebUIService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTWebUIService, "RequestStateChange"),
+				"<h:RequestStateChange_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_WebUIService\"><h:RequestedState>32769</h:RequestedState></h:RequestStateChange_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = "RequestStateChange"
+
+					return elementUnderTest.RequestStateChange(Enable)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					RequestStateChange_OUTPUT: RequestStateChange_OUTPUT{
+						XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTWebUIService), Local: "RequestStateChange_OUTPUT"},
+						ReturnValue: 0,
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, "", test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeAMT_WebUIService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.AMTResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "amt/webui",
+	}
+	elementUnderTest := NewWebUIServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("amt_UserInitiatedConnectionService Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create a valid AMT_WebUIService Get wsman message",
+				AMTWebUIService,
+				wsmantesting.Get,
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					GetResponse: WebUIResponse{
+						XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTWebUIService), Local: AMTWebUIService},
+						CreationClassName:       AMTWebUIService,
+						ElementName:             "Intel(r) AMT Web UI Service",
+						EnabledState:            32769,
+						Name:                    "Intel(r) AMT Web UI Service",
+						SystemCreationClassName: "CIM_ComputerSystem",
+						SystemName:              "Intel(r) AMT",
+					},
+				},
+			},
+			// ENUMERATES
+			{
+				"should create a valid AMT_WebUIService Enumerate wsman message",
+				AMTWebUIService,
+				wsmantesting.Enumerate,
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "D3000000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create a valid AMT_WebUIService Pull wsman message",
+				AMTWebUIService,
+				wsmantesting.Pull,
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: message.XMLPullResponseSpace, Local: "PullResponse"},
+						WebUIItems: []WebUIResponse{
+							{
+								XMLName:                 xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTWebUIService), Local: AMTWebUIService},
+								CreationClassName:       AMTWebUIService,
+								ElementName:             "Intel(r) AMT Web UI Service",
+								EnabledState:            32769,
+								Name:                    "Intel(r) AMT Web UI Service",
+								SystemCreationClassName: "CIM_ComputerSystem",
+								SystemName:              "Intel(r) AMT",
+							},
+						},
+					},
+				},
+			},
+			// REQUEST STATE CHANGE
+			{
+				"should create a valid AMT_WebUIService RequestStateChange wsman message",
+				AMTWebUIService,
+				fmt.Sprintf("%s%s/%s", message.AMTSchema, AMTWebUIService, "RequestStateChange"),
+				"<h:RequestStateChange_INPUT xmlns:h=\"http://intel.com/wbem/wscim/1/amt-schema/1/AMT_WebUIService\"><h:RequestedState>32769</h:RequestedState></h:RequestStateChange_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.RequestStateChange(Enable)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					RequestStateChange_OUTPUT: RequestStateChange_OUTPUT{
+						XMLName:     xml.Name{Space: fmt.Sprintf("%s%s", message.AMTSchema, AMTWebUIService), Local: "RequestStateChange_OUTPUT"},
+						ReturnValue: 0,
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, "", test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.NotEqual(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
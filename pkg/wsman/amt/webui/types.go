@@ -0,0 +1,67 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package webui
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type Service struct {
+	base message.Base
+}
+
+// The state requested for the element. The valid input values for the Web UI request state change are: 32768 and 32769.
+//
+// ValueMap={32768, 32769}
+//
+// Values={Web UI disabled, Web UI enabled}.
+type RequestedState int
+
+// EnabledState is an integer enumeration that indicates the enabled and disabled states of an element.
+type EnabledState int
+
+// ReturnValue is a 32-bit unsigned integer indicating the success or failure of the operation.
+type ReturnValue int
+
+// OUTPUTS
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName                   xml.Name                  `xml:"Body"`
+		RequestStateChange_OUTPUT RequestStateChange_OUTPUT `xml:"RequestStateChange_OUTPUT"`
+		GetResponse               WebUIResponse
+		EnumerateResponse         common.EnumerateResponse
+		PullResponse              PullResponse
+	}
+	WebUIResponse struct {
+		XMLName                 xml.Name     `xml:"AMT_WebUIService"`
+		CreationClassName       string       `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass that is used in the creation of an instance. When used with the other key properties of this class, this property allows all instances of this class and its subclasses to be uniquely identified.
+		ElementName             string       `xml:"ElementName,omitempty"`             // A user-friendly name for the object. This property allows each instance to define a user-friendly name in addition to its key properties, identity data, and description information.
+		EnabledState            EnabledState `xml:"EnabledState"`                      // EnabledState is an integer enumeration that indicates the enabled and disabled states of the Web UI.
+		Name                    string       `xml:"Name,omitempty"`                    // The Name property uniquely identifies the Service and provides an indication of the functionality that is managed.
+		SystemCreationClassName string       `xml:"SystemCreationClassName,omitempty"` // The CreationClassName of the scoping System.
+		SystemName              string       `xml:"SystemName,omitempty"`              // The Name of the scoping System.
+	}
+	PullResponse struct {
+		XMLName    xml.Name        `xml:"PullResponse"`
+		WebUIItems []WebUIResponse `xml:"Items>AMT_WebUIService"`
+	}
+
+	RequestStateChange_OUTPUT struct {
+		XMLName     xml.Name    `xml:"RequestStateChange_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+)
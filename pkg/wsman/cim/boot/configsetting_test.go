@@ -137,6 +137,23 @@ func TestPositiveConfigSetting(t *testing.T) {
 					},
 				},
 			},
+			{
+				"should create and parse a valid cim_BootConfigSetting ChangeBootOrder call with an empty source",
+				CIMBootConfigSetting,
+				methods.GenerateAction(CIMBootConfigSetting, ChangeBootOrder),
+				"<h:ChangeBootOrder_INPUT xmlns:h=\"http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_BootConfigSetting\"></h:ChangeBootOrder_INPUT>",
+				func() (Response, error) {
+					client.CurrentMessage = "ChangeBootOrder"
+
+					return elementUnderTest.ChangeBootOrder("")
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ChangeBootOrder_OUTPUT: ChangeBootOrder_OUTPUT{
+						ReturnValue: 0,
+					},
+				},
+			},
 		}
 
 		for _, test := range tests {
@@ -0,0 +1,137 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package indication
+
+const (
+	CIMIndicationFilter                string = "CIM_IndicationFilter"
+	CIMFilterCollection                string = "CIM_FilterCollection"
+	CIMListenerDestinationWSManagement string = "CIM_ListenerDestinationWSManagement"
+	CIMIndicationSubscription          string = "CIM_IndicationSubscription"
+	ValueNotFound                      string = "Value not found in map"
+)
+
+// QueryLanguageWQL is the query language of the indication filters of Intel® AMT.
+const QueryLanguageWQL string = "WQL"
+
+const (
+	DeliveryModePush DeliveryMode = iota + 2
+	DeliveryModePushWithAck
+	DeliveryModeEvents
+	DeliveryModePull
+)
+
+// deliveryModeToString is a map of the DeliveryMode enumeration.
+var deliveryModeToString = map[DeliveryMode]string{
+	DeliveryModePush:        "Push",
+	DeliveryModePushWithAck: "PushWithAck",
+	DeliveryModeEvents:      "Events",
+	DeliveryModePull:        "Pull",
+}
+
+// String returns a human-readable string representation of the DeliveryMode enumeration.
+func (e DeliveryMode) String() string {
+	if s, ok := deliveryModeToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	ProtocolOther Protocol = iota + 1
+	ProtocolCIMXML
+	ProtocolSMCLP
+	ProtocolWSManagement
+)
+
+// protocolToString is a map of the Protocol enumeration.
+var protocolToString = map[Protocol]string{
+	ProtocolOther:        "Other",
+	ProtocolCIMXML:       "CIM-XML",
+	ProtocolSMCLP:        "SM CLP",
+	ProtocolWSManagement: "WS-Management",
+}
+
+// String returns a human-readable string representation of the Protocol enumeration.
+func (e Protocol) String() string {
+	if s, ok := protocolToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	PersistenceTypeOther PersistenceType = iota + 1
+	PersistenceTypePermanent
+	PersistenceTypeTransient
+)
+
+// persistenceTypeToString is a map of the PersistenceType enumeration.
+var persistenceTypeToString = map[PersistenceType]string{
+	PersistenceTypeOther:     "Other",
+	PersistenceTypePermanent: "Permanent",
+	PersistenceTypeTransient: "Transient",
+}
+
+// String returns a human-readable string representation of the PersistenceType enumeration.
+func (e PersistenceType) String() string {
+	if s, ok := persistenceTypeToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	OnFatalErrorPolicyOther OnFatalErrorPolicy = iota + 1
+	OnFatalErrorPolicyIgnore
+	OnFatalErrorPolicyDisable
+	OnFatalErrorPolicyRemove
+)
+
+// onFatalErrorPolicyToString is a map of the OnFatalErrorPolicy enumeration.
+var onFatalErrorPolicyToString = map[OnFatalErrorPolicy]string{
+	OnFatalErrorPolicyOther:   "Other",
+	OnFatalErrorPolicyIgnore:  "Ignore",
+	OnFatalErrorPolicyDisable: "Disable",
+	OnFatalErrorPolicyRemove:  "Remove",
+}
+
+// String returns a human-readable string representation of the OnFatalErrorPolicy enumeration.
+func (e OnFatalErrorPolicy) String() string {
+	if s, ok := onFatalErrorPolicyToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	SubscriptionStateUnknown SubscriptionState = iota
+	SubscriptionStateOther
+	SubscriptionStateEnabled
+	SubscriptionStateEnabledDegraded
+	SubscriptionStateDisabled
+)
+
+// subscriptionStateToString is a map of the SubscriptionState enumeration.
+var subscriptionStateToString = map[SubscriptionState]string{
+	SubscriptionStateUnknown:         "Unknown",
+	SubscriptionStateOther:           "Other",
+	SubscriptionStateEnabled:         "Enabled",
+	SubscriptionStateEnabledDegraded: "EnabledDegraded",
+	SubscriptionStateDisabled:        "Disabled",
+}
+
+// String returns a human-readable string representation of the SubscriptionState enumeration.
+func (e SubscriptionState) String() string {
+	if s, ok := subscriptionStateToString[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
@@ -0,0 +1,140 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package indication facilitates communication with Intel® AMT devices to configure the delivery of alerts as WS-Management indications.
+//
+// IndicationFilter:
+// Defines the criteria, as a query, for generating an indication and what data should be returned in the indication.
+//
+// FilterCollection:
+// A collection of indication filters that can be subscribed to as a whole, such as "Intel(r) AMT:All".
+//
+// ListenerDestinationWSManagement:
+// Describes the destination, a WS-Management event listener, that indications are delivered to.
+//
+// IndicationSubscription:
+// Associates an indication filter with the listener destination that its indications are delivered to.
+package indication
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewIndicationFilterWithClient instantiates a new Filter.
+func NewIndicationFilterWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Filter {
+	return Filter{
+		base: message.NewBaseWithClient(wsmanMessageCreator, CIMIndicationFilter, client),
+	}
+}
+
+// Get retrieves the representation of the filter with the given name.
+func (filter Filter) Get(name string) (response Response, err error) {
+	selector := message.Selector{Name: "Name", Value: name}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Get(&selector),
+		},
+	}
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (filter Filter) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (filter Filter) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Create creates a new filter. The response holds the endpoint reference of the created filter.
+func (filter Filter) Create(request FilterRequest) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Create(&request, nil),
+		},
+	}
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Delete removes the filter with the given name.
+func (filter Filter) Delete(name string) (response Response, err error) {
+	selector := message.Selector{Name: "Name", Value: name}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: filter.base.Delete(selector),
+		},
+	}
+	// send the message to AMT
+	err = filter.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
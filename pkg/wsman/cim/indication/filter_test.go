@@ -0,0 +1,297 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package indication
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	alarmClockFilter = "Intel(r) AMT Alarm Clock"
+	alarmClockQuery  = `SELECT * FROM CIM_AlertIndication WHERE OwningEntity="Intel(r) AMT" AND MessageID="iAMT0035"`
+)
+
+func TestJson(t *testing.T) {
+	response := Response{
+		Body: Body{
+			PullResponse: PullResponse{},
+		},
+	}
+	expectedResult := "{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"FilterGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"ElementName\":\"\",\"Name\":\"\",\"Query\":\"\",\"QueryLanguage\":\"\",\"SourceNamespace\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"IndividualSubscriptionSupported\":false},\"FilterCollectionGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"InstanceID\":\"\",\"ElementName\":\"\",\"CollectionName\":\"\"},\"ListenerDestinationGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"CreationClassName\":\"\",\"ElementName\":\"\",\"Name\":\"\",\"SystemCreationClassName\":\"\",\"SystemName\":\"\",\"Destination\":\"\",\"DeliveryMode\":0,\"PersistenceType\":0,\"Protocol\":0},\"SubscriptionGetResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Filter\":{\"Address\":\"\",\"ReferenceParameters\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ResourceURI\":\"\",\"SelectorSet\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Selectors\":null}}},\"Handler\":{\"Address\":\"\",\"ReferenceParameters\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ResourceURI\":\"\",\"SelectorSet\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Selectors\":null}}},\"OnFatalErrorPolicy\":0,\"SubscriptionState\":0,\"SubscriptionDuration\":0,\"SubscriptionTimeRemaining\":0},\"CreateResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Address\":\"\",\"ReferenceParameters\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"ResourceURI\":\"\",\"SelectorSet\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"Selectors\":null}}},\"EnumerateResponse\":{\"EnumerationContext\":\"\"},\"PullResponse\":{\"XMLName\":{\"Space\":\"\",\"Local\":\"\"},\"FilterItems\":null,\"FilterCollectionItems\":null,\"ListenerDestinationItems\":null,\"SubscriptionItems\":null}}"
+	result := response.JSON()
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestYaml(t *testing.T) {
+	response := Response{
+		Body: Body{
+			PullResponse: PullResponse{},
+		},
+	}
+	expectedResult := "xmlname:\n    space: \"\"\n    local: \"\"\nfiltergetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    elementname: \"\"\n    name: \"\"\n    query: \"\"\n    querylanguage: \"\"\n    sourcenamespace: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    individualsubscriptionsupported: false\nfiltercollectiongetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    instanceid: \"\"\n    elementname: \"\"\n    collectionname: \"\"\nlistenerdestinationgetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    creationclassname: \"\"\n    elementname: \"\"\n    name: \"\"\n    systemcreationclassname: \"\"\n    systemname: \"\"\n    destination: \"\"\n    deliverymode: 0\n    persistencetype: 0\n    protocol: 0\nsubscriptiongetresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    filter:\n        address: \"\"\n        referenceparameters:\n            xmlname:\n                space: \"\"\n                local: \"\"\n            resourceuri: \"\"\n            selectorset:\n                xmlname:\n                    space: \"\"\n                    local: \"\"\n                selectors: []\n    handler:\n        address: \"\"\n        referenceparameters:\n            xmlname:\n                space: \"\"\n                local: \"\"\n            resourceuri: \"\"\n            selectorset:\n                xmlname:\n                    space: \"\"\n                    local: \"\"\n                selectors: []\n    onfatalerrorpolicy: 0\n    subscriptionstate: 0\n    subscriptionduration: 0\n    subscriptiontimeremaining: 0\ncreateresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    address: \"\"\n    referenceparameters:\n        xmlname:\n            space: \"\"\n            local: \"\"\n        resourceuri: \"\"\n        selectorset:\n            xmlname:\n                space: \"\"\n                local: \"\"\n            selectors: []\nenumerateresponse:\n    enumerationcontext: \"\"\npullresponse:\n    xmlname:\n        space: \"\"\n        local: \"\"\n    filteritems: []\n    filtercollectionitems: []\n    listenerdestinationitems: []\n    subscriptionitems: []\n"
+	result := response.YAML()
+	assert.Equal(t, expectedResult, result)
+}
+
+func alarmClockFilterResponse() FilterResponse {
+	return FilterResponse{
+		XMLName:                         xml.Name{Space: "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_IndicationFilter", Local: "CIM_IndicationFilter"},
+		CreationClassName:               CIMIndicationFilter,
+		ElementName:                     alarmClockFilter,
+		Name:                            alarmClockFilter,
+		Query:                           alarmClockQuery,
+		QueryLanguage:                   QueryLanguageWQL,
+		SourceNamespace:                 "interop",
+		SystemCreationClassName:         "CIM_ComputerSystem",
+		SystemName:                      "Intel(r) AMT",
+		IndividualSubscriptionSupported: true,
+	}
+}
+
+func TestPositiveCIMIndicationFilter(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/indication/filter",
+	}
+	elementUnderTest := NewIndicationFilterWithClient(wsmanMessageCreator, &client)
+
+	t.Run("cim_IndicationFilter Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create and parse a valid cim_IndicationFilter Get call",
+				CIMIndicationFilter,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"Name\">Intel(r) AMT Alarm Clock</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(alarmClockFilter)
+				},
+				Body{
+					XMLName:           xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					FilterGetResponse: alarmClockFilterResponse(),
+				},
+			},
+			// ENUMERATES
+			{
+				"should create and parse a valid cim_IndicationFilter Enumerate call",
+				CIMIndicationFilter,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create and parse a valid cim_IndicationFilter Pull call",
+				CIMIndicationFilter,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:     xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						FilterItems: []FilterResponse{alarmClockFilterResponse()},
+					},
+				},
+			},
+			// CREATE
+			{
+				"should create and parse a valid cim_IndicationFilter Create call",
+				CIMIndicationFilter,
+				wsmantesting.Create,
+				"",
+				"<h:CIM_IndicationFilter xmlns:h=\"http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_IndicationFilter\"><h:ElementName>Intel(r) AMT Alarm Clock</h:ElementName><h:Name>Intel(r) AMT Alarm Clock</h:Name><h:Query>SELECT * FROM CIM_AlertIndication WHERE OwningEntity=&#34;Intel(r) AMT&#34; AND MessageID=&#34;iAMT0035&#34;</h:Query><h:QueryLanguage>WQL</h:QueryLanguage><h:SourceNamespace>interop</h:SourceNamespace></h:CIM_IndicationFilter>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(FilterRequest{
+						ElementName:     alarmClockFilter,
+						Name:            alarmClockFilter,
+						Query:           alarmClockQuery,
+						QueryLanguage:   QueryLanguageWQL,
+						SourceNamespace: "interop",
+					})
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CreateResponse: CreateResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/transfer", Local: "ResourceCreated"},
+						Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+						ReferenceParameters: ReferenceParameters{
+							XMLName:     xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/08/addressing", Local: "ReferenceParameters"},
+							ResourceURI: "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_IndicationFilter",
+							SelectorSet: SelectorSet{
+								XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "SelectorSet"},
+								Selectors: []Selector{
+									{
+										XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"},
+										Name:    "Name",
+										Text:    alarmClockFilter,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			// DELETE
+			{
+				"should create and parse a valid cim_IndicationFilter Delete call",
+				CIMIndicationFilter,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"Name\">Intel(r) AMT Alarm Clock</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete(alarmClockFilter)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeCIMIndicationFilter(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/indication/filter",
+	}
+	elementUnderTest := NewIndicationFilterWithClient(wsmanMessageCreator, &client)
+
+	t.Run("cim_IndicationFilter Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when cim_IndicationFilter Get call",
+				CIMIndicationFilter,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"Name\">Intel(r) AMT Alarm Clock</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(alarmClockFilter)
+				},
+			},
+			{
+				"should handle error when cim_IndicationFilter Enumerate call",
+				CIMIndicationFilter,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when cim_IndicationFilter Pull call",
+				CIMIndicationFilter,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when cim_IndicationFilter Create call",
+				CIMIndicationFilter,
+				wsmantesting.Create,
+				"",
+				"<h:CIM_IndicationFilter xmlns:h=\"http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_IndicationFilter\"><h:Name>test</h:Name><h:Query>SELECT * FROM CIM_AlertIndication</h:Query><h:QueryLanguage>WQL</h:QueryLanguage></h:CIM_IndicationFilter>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Create(FilterRequest{Name: "test", Query: "SELECT * FROM CIM_AlertIndication", QueryLanguage: QueryLanguageWQL})
+				},
+			},
+			{
+				"should handle error when cim_IndicationFilter Delete call",
+				CIMIndicationFilter,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"Name\">test</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete("test")
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,84 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package indication
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewFilterCollectionWithClient instantiates a new FilterCollection.
+func NewFilterCollectionWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) FilterCollection {
+	return FilterCollection{
+		base: message.NewBaseWithClient(wsmanMessageCreator, CIMFilterCollection, client),
+	}
+}
+
+// Get retrieves the representation of the filter collection with the given instance ID.
+func (collection FilterCollection) Get(instanceID string) (response Response, err error) {
+	selector := message.Selector{Name: "InstanceID", Value: instanceID}
+	response = Response{
+		Message: &client.Message{
+			XMLInput: collection.base.Get(&selector),
+		},
+	}
+	// send the message to AMT
+	err = collection.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (collection FilterCollection) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: collection.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = collection.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (collection FilterCollection) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: collection.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = collection.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
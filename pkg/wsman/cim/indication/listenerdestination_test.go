@@ -0,0 +1,277 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package indication
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const consoleDestination = "Console"
+
+func consoleDestinationRequest() ListenerDestinationRequest {
+	return ListenerDestinationRequest{
+		ElementName:     consoleDestination,
+		Name:            consoleDestination,
+		Destination:     "http://192.168.0.10:8080/events",
+		DeliveryMode:    DeliveryModePush,
+		PersistenceType: PersistenceTypePermanent,
+		Protocol:        ProtocolWSManagement,
+	}
+}
+
+func consoleDestinationResponse() ListenerDestinationResponse {
+	return ListenerDestinationResponse{
+		XMLName:                 xml.Name{Space: "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ListenerDestinationWSManagement", Local: "CIM_ListenerDestinationWSManagement"},
+		CreationClassName:       CIMListenerDestinationWSManagement,
+		ElementName:             consoleDestination,
+		Name:                    consoleDestination,
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+		Destination:             "http://192.168.0.10:8080/events",
+		DeliveryMode:            DeliveryModePush,
+		PersistenceType:         PersistenceTypePermanent,
+		Protocol:                ProtocolWSManagement,
+	}
+}
+
+func TestPositiveCIMListenerDestinationWSManagement(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/indication/listenerdestination",
+	}
+	elementUnderTest := NewListenerDestinationWithClient(wsmanMessageCreator, &client)
+
+	t.Run("cim_ListenerDestinationWSManagement Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// GETS
+			{
+				"should create and parse a valid cim_ListenerDestinationWSManagement Get call",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"Name\">Console</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get(consoleDestination)
+				},
+				Body{
+					XMLName:                        xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					ListenerDestinationGetResponse: consoleDestinationResponse(),
+				},
+			},
+			// ENUMERATES
+			{
+				"should create and parse a valid cim_ListenerDestinationWSManagement Enumerate call",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create and parse a valid cim_ListenerDestinationWSManagement Pull call",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:                  xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						ListenerDestinationItems: []ListenerDestinationResponse{consoleDestinationResponse()},
+					},
+				},
+			},
+			// CREATE
+			{
+				"should create and parse a valid cim_ListenerDestinationWSManagement Create call",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Create,
+				"",
+				"<h:CIM_ListenerDestinationWSManagement xmlns:h=\"http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ListenerDestinationWSManagement\"><h:ElementName>Console</h:ElementName><h:Name>Console</h:Name><h:Destination>http://192.168.0.10:8080/events</h:Destination><h:DeliveryMode>2</h:DeliveryMode><h:PersistenceType>2</h:PersistenceType><h:Protocol>4</h:Protocol></h:CIM_ListenerDestinationWSManagement>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(consoleDestinationRequest())
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CreateResponse: CreateResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/transfer", Local: "ResourceCreated"},
+						Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+						ReferenceParameters: ReferenceParameters{
+							XMLName:     xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/08/addressing", Local: "ReferenceParameters"},
+							ResourceURI: "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ListenerDestinationWSManagement",
+							SelectorSet: SelectorSet{
+								XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "SelectorSet"},
+								Selectors: []Selector{
+									{
+										XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"},
+										Name:    "Name",
+										Text:    consoleDestination,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			// DELETE
+			{
+				"should create and parse a valid cim_ListenerDestinationWSManagement Delete call",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"Name\">Console</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete(consoleDestination)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeCIMListenerDestinationWSManagement(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/indication/listenerdestination",
+	}
+	elementUnderTest := NewListenerDestinationWithClient(wsmanMessageCreator, &client)
+
+	t.Run("cim_ListenerDestinationWSManagement Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when cim_ListenerDestinationWSManagement Get call",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Get,
+				"<w:SelectorSet><w:Selector Name=\"Name\">Console</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get(consoleDestination)
+				},
+			},
+			{
+				"should handle error when cim_ListenerDestinationWSManagement Enumerate call",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when cim_ListenerDestinationWSManagement Pull call",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when cim_ListenerDestinationWSManagement Create call",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Create,
+				"",
+				"<h:CIM_ListenerDestinationWSManagement xmlns:h=\"http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ListenerDestinationWSManagement\"><h:Name>test</h:Name><h:Destination>http://localhost/events</h:Destination><h:DeliveryMode>3</h:DeliveryMode><h:Protocol>4</h:Protocol></h:CIM_ListenerDestinationWSManagement>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Create(ListenerDestinationRequest{Name: "test", Destination: "http://localhost/events", DeliveryMode: DeliveryModePushWithAck, Protocol: ProtocolWSManagement})
+				},
+			},
+			{
+				"should handle error when cim_ListenerDestinationWSManagement Delete call",
+				CIMListenerDestinationWSManagement,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"Name\">test</w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete("test")
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,135 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package indication
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewIndicationSubscriptionWithClient instantiates a new Subscription.
+func NewIndicationSubscriptionWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) Subscription {
+	return Subscription{
+		base: message.NewBaseWithClient(wsmanMessageCreator, CIMIndicationSubscription, client),
+	}
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (subscription Subscription) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: subscription.base.Enumerate(),
+		},
+	}
+	// send the message to AMT
+	err = subscription.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (subscription Subscription) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: subscription.base.Pull(enumerationContext),
+		},
+	}
+	// send the message to AMT
+	err = subscription.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Create subscribes the listener destination with the given name to the indications of filter.
+func (subscription Subscription) Create(filter FilterReference, handlerName string) (response Response, err error) {
+	resourceURIBase := subscription.base.WSManMessageCreator.ResourceURIBase
+	header := subscription.base.WSManMessageCreator.CreateHeader(message.BaseActionsCreate, CIMIndicationSubscription, nil, "", "")
+	body := fmt.Sprintf(`<Body><h:CIM_IndicationSubscription xmlns:h="%s%s"><h:Filter>%s</h:Filter><h:Handler>%s</h:Handler></h:CIM_IndicationSubscription></Body>`, resourceURIBase, CIMIndicationSubscription, subscription.filterReference(filter), subscription.handlerReference(handlerName))
+	response = Response{
+		Message: &client.Message{
+			XMLInput: subscription.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+	// send the message to AMT
+	err = subscription.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Delete removes the subscription of the listener destination with the given name to the indications of filter.
+func (subscription Subscription) Delete(filter FilterReference, handlerName string) (response Response, err error) {
+	selectorSet := fmt.Sprintf(`<w:SelectorSet><w:Selector Name="Filter"><a:EndpointReference>%s</a:EndpointReference></w:Selector><w:Selector Name="Handler"><a:EndpointReference>%s</a:EndpointReference></w:Selector></w:SelectorSet>`, subscription.filterReference(filter), subscription.handlerReference(handlerName))
+	resourceURI := subscription.base.WSManMessageCreator.ResourceURIBase + CIMIndicationSubscription
+	header := subscription.base.WSManMessageCreator.CreateHeaderWithResourceURI(message.BaseActionsDelete, resourceURI, nil, selectorSet)
+	response = Response{
+		Message: &client.Message{
+			XMLInput: subscription.base.WSManMessageCreator.CreateXML(header, message.DeleteBody),
+		},
+	}
+	// send the message to AMT
+	err = subscription.base.Execute(response.Message)
+	if err != nil {
+		return
+	}
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// filterReference returns the endpoint reference of filter.
+func (subscription Subscription) filterReference(filter FilterReference) string {
+	key := "Name"
+	if filter.ClassName == CIMFilterCollection {
+		key = "InstanceID"
+	}
+
+	return subscription.reference(filter.ClassName, key, filter.Key)
+}
+
+// handlerReference returns the endpoint reference of the listener destination with the given name.
+func (subscription Subscription) handlerReference(name string) string {
+	return subscription.reference(CIMListenerDestinationWSManagement, "Name", name)
+}
+
+func (subscription Subscription) reference(className, key, value string) string {
+	var escaped strings.Builder
+
+	_ = xml.EscapeText(&escaped, []byte(value))
+
+	return fmt.Sprintf(`<a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>%s%s</w:ResourceURI><w:SelectorSet><w:Selector Name=%q>%s</w:Selector></w:SelectorSet></a:ReferenceParameters>`, subscription.base.WSManMessageCreator.ResourceURIBase, className, key, escaped.String())
+}
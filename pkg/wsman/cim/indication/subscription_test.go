@@ -0,0 +1,283 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package indication
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+const (
+	alarmClockFilterReference   = `<a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_IndicationFilter</w:ResourceURI><w:SelectorSet><w:Selector Name="Name">Intel(r) AMT Alarm Clock</w:Selector></w:SelectorSet></a:ReferenceParameters>`
+	allAlertsFilterReference    = `<a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_FilterCollection</w:ResourceURI><w:SelectorSet><w:Selector Name="InstanceID">Intel(r) AMT:All</w:Selector></w:SelectorSet></a:ReferenceParameters>`
+	consoleDestinationReference = `<a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ListenerDestinationWSManagement</w:ResourceURI><w:SelectorSet><w:Selector Name="Name">Console</w:Selector></w:SelectorSet></a:ReferenceParameters>`
+)
+
+func reference(className, key, value string) EndpointReference {
+	return EndpointReference{
+		Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+		ReferenceParameters: ReferenceParameters{
+			XMLName:     xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/08/addressing", Local: "ReferenceParameters"},
+			ResourceURI: "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/" + className,
+			SelectorSet: SelectorSet{
+				XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "SelectorSet"},
+				Selectors: []Selector{
+					{
+						XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"},
+						Name:    key,
+						Text:    value,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPositiveCIMIndicationSubscription(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/indication/subscription",
+	}
+	elementUnderTest := NewIndicationSubscriptionWithClient(wsmanMessageCreator, &client)
+	alarmClock := FilterReference{ClassName: CIMIndicationFilter, Key: alarmClockFilter}
+	filter := reference(CIMIndicationFilter, "Name", alarmClockFilter)
+	handler := reference(CIMListenerDestinationWSManagement, "Name", consoleDestination)
+	filterSelector := Selector{XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"}, Name: "Filter", Text: "\n                        \n                    ", EndpointReference: &filter}
+	handlerSelector := Selector{XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"}, Name: "Handler", Text: "\n                        \n                    ", EndpointReference: &handler}
+
+	t.Run("cim_IndicationSubscription Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			// ENUMERATES
+			{
+				"should create and parse a valid cim_IndicationSubscription Enumerate call",
+				CIMIndicationSubscription,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			// PULLS
+			{
+				"should create and parse a valid cim_IndicationSubscription Pull call",
+				CIMIndicationSubscription,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						SubscriptionItems: []SubscriptionResponse{
+							{
+								XMLName:            xml.Name{Space: "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_IndicationSubscription", Local: "CIM_IndicationSubscription"},
+								Filter:             filter,
+								Handler:            handler,
+								OnFatalErrorPolicy: OnFatalErrorPolicyIgnore,
+								SubscriptionState:  SubscriptionStateEnabled,
+							},
+						},
+					},
+				},
+			},
+			// CREATE
+			{
+				"should create and parse a valid cim_IndicationSubscription Create call",
+				CIMIndicationSubscription,
+				wsmantesting.Create,
+				"",
+				"<h:CIM_IndicationSubscription xmlns:h=\"http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_IndicationSubscription\"><h:Filter>" + alarmClockFilterReference + "</h:Filter><h:Handler>" + consoleDestinationReference + "</h:Handler></h:CIM_IndicationSubscription>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(alarmClock, consoleDestination)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CreateResponse: CreateResponse{
+						XMLName: xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/transfer", Local: "ResourceCreated"},
+						Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+						ReferenceParameters: ReferenceParameters{
+							XMLName:     xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/08/addressing", Local: "ReferenceParameters"},
+							ResourceURI: "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_IndicationSubscription",
+							SelectorSet: SelectorSet{
+								XMLName:   xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "SelectorSet"},
+								Selectors: []Selector{filterSelector, handlerSelector},
+							},
+						},
+					},
+				},
+			},
+			{
+				"should create a cim_IndicationSubscription Create call for a filter collection",
+				CIMIndicationSubscription,
+				wsmantesting.Create,
+				"",
+				"<h:CIM_IndicationSubscription xmlns:h=\"http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_IndicationSubscription\"><h:Filter>" + allAlertsFilterReference + "</h:Filter><h:Handler>" + consoleDestinationReference + "</h:Handler></h:CIM_IndicationSubscription>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageCreate
+
+					return elementUnderTest.Create(FilterReference{ClassName: CIMFilterCollection, Key: allAlertsCollection}, consoleDestination)
+				},
+				nil,
+			},
+			// DELETE
+			{
+				"should create and parse a valid cim_IndicationSubscription Delete call",
+				CIMIndicationSubscription,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"Filter\"><a:EndpointReference>" + alarmClockFilterReference + "</a:EndpointReference></w:Selector><w:Selector Name=\"Handler\"><a:EndpointReference>" + consoleDestinationReference + "</a:EndpointReference></w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete(alarmClock, consoleDestination)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+				},
+			},
+			{
+				"should escape the names in a cim_IndicationSubscription Delete call",
+				CIMIndicationSubscription,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"Filter\"><a:EndpointReference><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_IndicationFilter</w:ResourceURI><w:SelectorSet><w:Selector Name=\"Name\">a&lt;b</w:Selector></w:SelectorSet></a:ReferenceParameters></a:EndpointReference></w:Selector><w:Selector Name=\"Handler\"><a:EndpointReference><a:Address>/wsman</a:Address><a:ReferenceParameters><w:ResourceURI>http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ListenerDestinationWSManagement</w:ResourceURI><w:SelectorSet><w:Selector Name=\"Name\">c&amp;d</w:Selector></w:SelectorSet></a:ReferenceParameters></a:EndpointReference></w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageDelete
+
+					return elementUnderTest.Delete(FilterReference{ClassName: CIMIndicationFilter, Key: "a<b"}, "c&d")
+				},
+				nil,
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+
+				if test.expectedResponse != nil {
+					assert.Equal(t, test.expectedResponse, response.Body)
+				}
+			})
+		}
+	})
+}
+
+func TestNegativeCIMIndicationSubscription(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/indication/subscription",
+	}
+	elementUnderTest := NewIndicationSubscriptionWithClient(wsmanMessageCreator, &client)
+	alarmClock := FilterReference{ClassName: CIMIndicationFilter, Key: alarmClockFilter}
+
+	t.Run("cim_IndicationSubscription Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when cim_IndicationSubscription Enumerate call",
+				CIMIndicationSubscription,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when cim_IndicationSubscription Pull call",
+				CIMIndicationSubscription,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+			{
+				"should handle error when cim_IndicationSubscription Create call",
+				CIMIndicationSubscription,
+				wsmantesting.Create,
+				"",
+				"<h:CIM_IndicationSubscription xmlns:h=\"http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_IndicationSubscription\"><h:Filter>" + alarmClockFilterReference + "</h:Filter><h:Handler>" + consoleDestinationReference + "</h:Handler></h:CIM_IndicationSubscription>",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Create(alarmClock, consoleDestination)
+				},
+			},
+			{
+				"should handle error when cim_IndicationSubscription Delete call",
+				CIMIndicationSubscription,
+				wsmantesting.Delete,
+				"<w:SelectorSet><w:Selector Name=\"Filter\"><a:EndpointReference>" + alarmClockFilterReference + "</a:EndpointReference></w:Selector><w:Selector Name=\"Handler\"><a:EndpointReference>" + consoleDestinationReference + "</a:EndpointReference></w:Selector></w:SelectorSet>",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Delete(alarmClock, consoleDestination)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
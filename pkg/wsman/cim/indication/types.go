@@ -0,0 +1,159 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package indication
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type (
+	Filter struct {
+		base message.Base
+	}
+	FilterCollection struct {
+		base message.Base
+	}
+	ListenerDestination struct {
+		base message.Base
+	}
+	Subscription struct {
+		base message.Base
+	}
+)
+
+// OUTPUT
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+	Body struct {
+		XMLName                        xml.Name                    `xml:"Body"`
+		FilterGetResponse              FilterResponse              `xml:"CIM_IndicationFilter"`
+		FilterCollectionGetResponse    FilterCollectionResponse    `xml:"CIM_FilterCollection"`
+		ListenerDestinationGetResponse ListenerDestinationResponse `xml:"CIM_ListenerDestinationWSManagement"`
+		SubscriptionGetResponse        SubscriptionResponse        `xml:"CIM_IndicationSubscription"`
+		CreateResponse                 CreateResponse              `xml:"ResourceCreated"`
+		EnumerateResponse              common.EnumerateResponse
+		PullResponse                   PullResponse
+	}
+	PullResponse struct {
+		XMLName                  xml.Name                      `xml:"PullResponse"`
+		FilterItems              []FilterResponse              `xml:"Items>CIM_IndicationFilter"`
+		FilterCollectionItems    []FilterCollectionResponse    `xml:"Items>CIM_FilterCollection"`
+		ListenerDestinationItems []ListenerDestinationResponse `xml:"Items>CIM_ListenerDestinationWSManagement"`
+		SubscriptionItems        []SubscriptionResponse        `xml:"Items>CIM_IndicationSubscription"`
+	}
+	FilterResponse struct {
+		XMLName                         xml.Name `xml:"CIM_IndicationFilter"`
+		CreationClassName               string   `xml:"CreationClassName,omitempty"`               // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		ElementName                     string   `xml:"ElementName,omitempty"`                     // A user-friendly name for the object.
+		Name                            string   `xml:"Name,omitempty"`                            // The name of the filter, unique for the system.
+		Query                           string   `xml:"Query,omitempty"`                           // A query expression that defines the condition under which indications are generated.
+		QueryLanguage                   string   `xml:"QueryLanguage,omitempty"`                   // The language in which the query is expressed, such as WQL.
+		SourceNamespace                 string   `xml:"SourceNamespace,omitempty"`                 // The path to a local namespace where the indications originate.
+		SystemCreationClassName         string   `xml:"SystemCreationClassName,omitempty"`         // The scoping System's CreationClassName.
+		SystemName                      string   `xml:"SystemName,omitempty"`                      // The scoping System's Name.
+		IndividualSubscriptionSupported bool     `xml:"IndividualSubscriptionSupported,omitempty"` // Indicates whether the filter can be used in a subscription of its own, rather than only as a member of a filter collection.
+	}
+	FilterCollectionResponse struct {
+		XMLName        xml.Name `xml:"CIM_FilterCollection"`
+		InstanceID     string   `xml:"InstanceID,omitempty"`     // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName    string   `xml:"ElementName,omitempty"`    // A user-friendly name for the object.
+		CollectionName string   `xml:"CollectionName,omitempty"` // The name of the collection, such as "Intel(r) AMT:All".
+	}
+	ListenerDestinationResponse struct {
+		XMLName                 xml.Name        `xml:"CIM_ListenerDestinationWSManagement"`
+		CreationClassName       string          `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass used in the creation of an instance.
+		ElementName             string          `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		Name                    string          `xml:"Name,omitempty"`                    // The name of the destination, unique for the system.
+		SystemCreationClassName string          `xml:"SystemCreationClassName,omitempty"` // The scoping System's CreationClassName.
+		SystemName              string          `xml:"SystemName,omitempty"`              // The scoping System's Name.
+		Destination             string          `xml:"Destination,omitempty"`             // The URL of the listener that the indications are delivered to.
+		DeliveryMode            DeliveryMode    `xml:"DeliveryMode,omitempty"`            // The WS-Eventing delivery mode of the indications.
+		PersistenceType         PersistenceType `xml:"PersistenceType,omitempty"`         // Describes how the destination is treated when indications cannot be delivered.
+		Protocol                Protocol        `xml:"Protocol,omitempty"`                // The protocol used to deliver the indications.
+	}
+	SubscriptionResponse struct {
+		XMLName                   xml.Name           `xml:"CIM_IndicationSubscription"`
+		Filter                    EndpointReference  `xml:"Filter"`                              // The filter that defines the indications of the subscription.
+		Handler                   EndpointReference  `xml:"Handler"`                             // The listener destination that the indications are delivered to.
+		OnFatalErrorPolicy        OnFatalErrorPolicy `xml:"OnFatalErrorPolicy,omitempty"`        // Defines the desired behavior when a fatal error occurs in the delivery of the indications.
+		SubscriptionState         SubscriptionState  `xml:"SubscriptionState,omitempty"`         // Indicates whether the subscription is active.
+		SubscriptionDuration      uint64             `xml:"SubscriptionDuration,omitempty"`      // The duration of the subscription in seconds, zero if it does not expire.
+		SubscriptionTimeRemaining uint64             `xml:"SubscriptionTimeRemaining,omitempty"` // The remaining time of the subscription in seconds.
+	}
+	CreateResponse struct {
+		XMLName             xml.Name            `xml:"ResourceCreated"`
+		Address             string              `xml:"Address,omitempty"`
+		ReferenceParameters ReferenceParameters `xml:"ReferenceParameters,omitempty"`
+	}
+	EndpointReference struct {
+		Address             string              `xml:"Address,omitempty"`
+		ReferenceParameters ReferenceParameters `xml:"ReferenceParameters,omitempty"`
+	}
+	ReferenceParameters struct {
+		XMLName     xml.Name    `xml:"ReferenceParameters,omitempty"`
+		ResourceURI string      `xml:"ResourceURI,omitempty"`
+		SelectorSet SelectorSet `xml:"SelectorSet,omitempty"`
+	}
+	SelectorSet struct {
+		XMLName   xml.Name   `xml:"SelectorSet,omitempty"`
+		Selectors []Selector `xml:"Selector,omitempty"`
+	}
+	Selector struct {
+		XMLName           xml.Name           `xml:"Selector,omitempty"`
+		Name              string             `xml:"Name,attr,omitempty"`
+		Text              string             `xml:",chardata"`
+		EndpointReference *EndpointReference `xml:"EndpointReference,omitempty"`
+	}
+)
+
+// INPUT
+// Request Types.
+type (
+	FilterRequest struct {
+		XMLName         xml.Name `xml:"h:CIM_IndicationFilter"`
+		H               string   `xml:"xmlns:h,attr"`
+		ElementName     string   `xml:"h:ElementName,omitempty"`     // A user-friendly name for the object.
+		Name            string   `xml:"h:Name"`                      // The name of the filter, unique for the system.
+		Query           string   `xml:"h:Query"`                     // A query expression that defines the condition under which indications are generated.
+		QueryLanguage   string   `xml:"h:QueryLanguage"`             // The language in which the query is expressed, such as QueryLanguageWQL.
+		SourceNamespace string   `xml:"h:SourceNamespace,omitempty"` // The path to a local namespace where the indications originate.
+	}
+	ListenerDestinationRequest struct {
+		XMLName         xml.Name        `xml:"h:CIM_ListenerDestinationWSManagement"`
+		H               string          `xml:"xmlns:h,attr"`
+		ElementName     string          `xml:"h:ElementName,omitempty"`     // A user-friendly name for the object.
+		Name            string          `xml:"h:Name"`                      // The name of the destination, unique for the system.
+		Destination     string          `xml:"h:Destination"`               // The URL of the listener that the indications are delivered to.
+		DeliveryMode    DeliveryMode    `xml:"h:DeliveryMode"`              // The WS-Eventing delivery mode of the indications.
+		PersistenceType PersistenceType `xml:"h:PersistenceType,omitempty"` // Describes how the destination is treated when indications cannot be delivered.
+		Protocol        Protocol        `xml:"h:Protocol"`                  // The protocol used to deliver the indications, ProtocolWSManagement for this class.
+	}
+
+	// FilterReference identifies the filter of a subscription: either a CIM_IndicationFilter by its Name or a
+	// CIM_FilterCollection by its InstanceID.
+	FilterReference struct {
+		ClassName string // CIMIndicationFilter or CIMFilterCollection.
+		Key       string // Name of the filter, or InstanceID of the filter collection.
+	}
+)
+
+type (
+	DeliveryMode       int
+	Protocol           int
+	PersistenceType    int
+	OnFatalErrorPolicy int
+	SubscriptionState  int
+)
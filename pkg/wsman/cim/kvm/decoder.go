@@ -120,6 +120,16 @@ func (e EnabledState) String() string {
 	return ValueNotFound
 }
 
+// IsEnabled reports whether KVM is enabled. Intel AMT reports EnabledButOffline while KVM is enabled but no session is active.
+func (e EnabledState) IsEnabled() bool {
+	return e == EnabledStateEnabled || e == EnabledStateEnabledButOffline
+}
+
+// SessionActive reports whether a KVM session is open. Intel AMT reports Enabled only while a session is active.
+func (e EnabledState) SessionActive() bool {
+	return e == EnabledStateEnabled
+}
+
 // RequestedState constants.
 const (
 	RequestedStateUnknown       RequestedState = 0
@@ -82,6 +82,41 @@ func TestEnabledState_String(t *testing.T) {
 	}
 }
 
+func TestEnabledState_IsEnabled(t *testing.T) {
+	tests := []struct {
+		state    EnabledState
+		expected bool
+	}{
+		{EnabledStateEnabled, true},
+		{EnabledStateEnabledButOffline, true},
+		{EnabledStateDisabled, false},
+		{EnabledStateUnknown, false},
+	}
+
+	for _, test := range tests {
+		if test.state.IsEnabled() != test.expected {
+			t.Errorf("Expected %t for %s", test.expected, test.state)
+		}
+	}
+}
+
+func TestEnabledState_SessionActive(t *testing.T) {
+	tests := []struct {
+		state    EnabledState
+		expected bool
+	}{
+		{EnabledStateEnabled, true},
+		{EnabledStateEnabledButOffline, false},
+		{EnabledStateDisabled, false},
+	}
+
+	for _, test := range tests {
+		if test.state.SessionActive() != test.expected {
+			t.Errorf("Expected %t for %s", test.expected, test.state)
+		}
+	}
+}
+
 func TestRequestedState_String(t *testing.T) {
 	tests := []struct {
 		state    RequestedState
@@ -16,6 +16,7 @@ import (
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/concrete"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/credential"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/ieee8021x"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/indication"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/kvm"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/mediaaccess"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/physical"
@@ -30,29 +31,35 @@ import (
 )
 
 type Messages struct {
-	wsmanMessageCreator       *message.WSManMessageCreator
-	BIOSElement               bios.Element
-	BootConfigSetting         boot.ConfigSetting
-	BootService               boot.Service
-	BootSourceSetting         boot.SourceSetting
-	Card                      card.Package
-	Chassis                   chassis.Package
-	Chip                      chip.Package
-	ComputerSystemPackage     computer.SystemPackage
-	ConcreteDependency        concrete.Dependency
-	CredentialContext         credential.Context
-	IEEE8021xSettings         ieee8021x.Settings
-	KVMRedirectionSAP         kvm.RedirectionSAP
-	MediaAccessDevice         mediaaccess.Device
-	PhysicalMemory            physical.Memory
-	PhysicalPackage           physical.Package
-	PowerManagementService    power.ManagementService
-	Processor                 processor.Package
-	ServiceAvailableToElement service.AvailableToElement
-	SoftwareIdentity          software.Identity
-	SystemPackaging           system.Package
-	WiFiEndpointSettings      wifi.EndpointSettings
-	WiFiPort                  wifi.Port
+	wsmanMessageCreator              *message.WSManMessageCreator
+	AssociatedPowerManagementService power.AssociatedManagementService
+	BIOSElement                      bios.Element
+	BootConfigSetting                boot.ConfigSetting
+	BootService                      boot.Service
+	BootSourceSetting                boot.SourceSetting
+	Card                             card.Package
+	Chassis                          chassis.Package
+	Chip                             chip.Package
+	ComputerSystemPackage            computer.SystemPackage
+	ConcreteDependency               concrete.Dependency
+	CredentialContext                credential.Context
+	FilterCollection                 indication.FilterCollection
+	IEEE8021xSettings                ieee8021x.Settings
+	IndicationFilter                 indication.Filter
+	IndicationSubscription           indication.Subscription
+	KVMRedirectionSAP                kvm.RedirectionSAP
+	ListenerDestination              indication.ListenerDestination
+	MediaAccessDevice                mediaaccess.Device
+	PhysicalMemory                   physical.Memory
+	PhysicalPackage                  physical.Package
+	PowerManagementCapabilities      power.ManagementCapabilities
+	PowerManagementService           power.ManagementService
+	Processor                        processor.Package
+	ServiceAvailableToElement        service.AvailableToElement
+	SoftwareIdentity                 software.Identity
+	SystemPackaging                  system.Package
+	WiFiEndpointSettings             wifi.EndpointSettings
+	WiFiPort                         wifi.Port
 }
 
 func NewMessages(client client.WSMan) Messages {
@@ -61,6 +68,7 @@ func NewMessages(client client.WSMan) Messages {
 	m := Messages{
 		wsmanMessageCreator: wsmanMessageCreator,
 	}
+	m.AssociatedPowerManagementService = power.NewAssociatedPowerManagementServiceWithClient(wsmanMessageCreator, client)
 	m.BIOSElement = bios.NewBIOSElementWithClient(wsmanMessageCreator, client)
 	m.BootConfigSetting = boot.NewBootConfigSettingWithClient(wsmanMessageCreator, client)
 	m.BootService = boot.NewBootServiceWithClient(wsmanMessageCreator, client)
@@ -71,11 +79,16 @@ func NewMessages(client client.WSMan) Messages {
 	m.ComputerSystemPackage = computer.NewComputerSystemPackageWithClient(wsmanMessageCreator, client)
 	m.ConcreteDependency = concrete.NewDependencyWithClient(wsmanMessageCreator, client)
 	m.CredentialContext = credential.NewContextWithClient(wsmanMessageCreator, client)
+	m.FilterCollection = indication.NewFilterCollectionWithClient(wsmanMessageCreator, client)
 	m.IEEE8021xSettings = ieee8021x.NewIEEE8021xSettingsWithClient(wsmanMessageCreator, client)
+	m.IndicationFilter = indication.NewIndicationFilterWithClient(wsmanMessageCreator, client)
+	m.IndicationSubscription = indication.NewIndicationSubscriptionWithClient(wsmanMessageCreator, client)
 	m.KVMRedirectionSAP = kvm.NewKVMRedirectionSAPWithClient(wsmanMessageCreator, client)
+	m.ListenerDestination = indication.NewListenerDestinationWithClient(wsmanMessageCreator, client)
 	m.MediaAccessDevice = mediaaccess.NewMediaAccessDeviceWithClient(wsmanMessageCreator, client)
 	m.PhysicalMemory = physical.NewPhysicalMemoryWithClient(wsmanMessageCreator, client)
 	m.PhysicalPackage = physical.NewPhysicalPackageWithClient(wsmanMessageCreator, client)
+	m.PowerManagementCapabilities = power.NewPowerManagementCapabilitiesWithClient(wsmanMessageCreator, client)
 	m.PowerManagementService = power.NewPowerManagementServiceWithClient(wsmanMessageCreator, client)
 	m.Processor = processor.NewProcessorWithClient(wsmanMessageCreator, client)
 	m.ServiceAvailableToElement = service.NewServiceAvailableToElementWithClient(wsmanMessageCreator, client)
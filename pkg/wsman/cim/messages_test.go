@@ -17,6 +17,7 @@ import (
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/computer"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/concrete"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/credential"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/indication"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/kvm"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/mediaaccess"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/physical"
@@ -38,6 +39,10 @@ func TestNewMessages(t *testing.T) {
 		t.Error("wsmanMessageCreator is not initialized")
 	}
 
+	if reflect.DeepEqual(m.AssociatedPowerManagementService, power.AssociatedManagementService{}) {
+		t.Error("AssociatedPowerManagementService is not initialized")
+	}
+
 	if reflect.DeepEqual(m.BIOSElement, bios.Element{}) {
 		t.Error("BIOSElement is not initialized")
 	}
@@ -78,14 +83,30 @@ func TestNewMessages(t *testing.T) {
 		t.Error("Context is not initialized")
 	}
 
+	if reflect.DeepEqual(m.FilterCollection, indication.FilterCollection{}) {
+		t.Error("FilterCollection is not initialized")
+	}
+
 	if reflect.DeepEqual(m.IEEE8021xSettings, ieee8021x.IEEE8021xSettingsRequest{}) {
 		t.Error("IEEE8021xSettings is not initialized")
 	}
 
+	if reflect.DeepEqual(m.IndicationFilter, indication.Filter{}) {
+		t.Error("IndicationFilter is not initialized")
+	}
+
+	if reflect.DeepEqual(m.IndicationSubscription, indication.Subscription{}) {
+		t.Error("IndicationSubscription is not initialized")
+	}
+
 	if reflect.DeepEqual(m.KVMRedirectionSAP, kvm.RedirectionSAP{}) {
 		t.Error("KVMRedirectionSAP is not initialized")
 	}
 
+	if reflect.DeepEqual(m.ListenerDestination, indication.ListenerDestination{}) {
+		t.Error("ListenerDestination is not initialized")
+	}
+
 	if reflect.DeepEqual(m.MediaAccessDevice, mediaaccess.Device{}) {
 		t.Error("MediaAccessDevice is not initialized")
 	}
@@ -98,6 +119,10 @@ func TestNewMessages(t *testing.T) {
 		t.Error("PhysicalPackage is not initialized")
 	}
 
+	if reflect.DeepEqual(m.PowerManagementCapabilities, power.ManagementCapabilities{}) {
+		t.Error("PowerManagementCapabilities is not initialized")
+	}
+
 	if reflect.DeepEqual(m.PowerManagementService, power.ManagementService{}) {
 		t.Error("PowerManagementService is not initialized")
 	}
@@ -0,0 +1,69 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package power
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewAssociatedPowerManagementServiceWithClient returns a new instance of the AssociatedManagementService struct.
+//
+// CIM_AssociatedPowerManagementService associates the managed system with its CIM_PowerManagementService, and holds the
+// current power state of the system and the power states that it accepts.
+func NewAssociatedPowerManagementServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) AssociatedManagementService {
+	return AssociatedManagementService{
+		base: message.NewBaseWithClient(wsmanMessageCreator, CIMAssociatedPowerManagementService, client),
+	}
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (associatedManagementService AssociatedManagementService) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: associatedManagementService.base.Enumerate(),
+		},
+	}
+
+	// send the message to AMT
+	err = associatedManagementService.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (associatedManagementService AssociatedManagementService) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: associatedManagementService.base.Pull(enumerationContext),
+		},
+	}
+
+	// send the message to AMT
+	err = associatedManagementService.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
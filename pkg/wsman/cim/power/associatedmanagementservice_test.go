@@ -0,0 +1,191 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package power
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/models"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func associatedPowerManagementService() AssociatedPowerManagementService {
+	return AssociatedPowerManagementService{
+		XMLName:                       xml.Name{Space: "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_AssociatedPowerManagementService", Local: CIMAssociatedPowerManagementService},
+		AvailableRequestedPowerStates: []PowerState{MasterBusReset, PowerOffHard, PowerCycleOffHard, DiagnosticInterruptNMI, SleepDeep, Hibernate, MasterBusResetGraceful, PowerOffSoftGraceful},
+		PowerState:                    PowerOn,
+		ServiceProvided: models.AssociationReference{
+			Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+			ReferenceParameters: models.ReferenceParametersNoNamespace{
+				XMLName:     xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/08/addressing", Local: "ReferenceParameters"},
+				ResourceURI: "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_PowerManagementService",
+				SelectorSet: models.SelectorNoNamespace{
+					XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "SelectorSet"},
+					Selectors: []models.SelectorResponse{
+						selector("CreationClassName", CIMPowerManagementService),
+						selector("Name", "Intel(r) AMT Power Management Service"),
+						selector("SystemCreationClassName", "CIM_ComputerSystem"),
+						selector("SystemName", "Intel(r) AMT"),
+					},
+				},
+			},
+		},
+		UserOfService: models.AssociationReference{
+			Address: "http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous",
+			ReferenceParameters: models.ReferenceParametersNoNamespace{
+				XMLName:     xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/08/addressing", Local: "ReferenceParameters"},
+				ResourceURI: "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ComputerSystem",
+				SelectorSet: models.SelectorNoNamespace{
+					XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "SelectorSet"},
+					Selectors: []models.SelectorResponse{
+						selector("CreationClassName", "CIM_ComputerSystem"),
+						selector("Name", "ManagedSystem"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func selector(name, text string) models.SelectorResponse {
+	return models.SelectorResponse{
+		XMLName: xml.Name{Space: "http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd", Local: "Selector"},
+		Name:    name,
+		Text:    text,
+	}
+}
+
+func TestPositiveCIMAssociatedPowerManagementService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/power/associatedmanagementservice",
+	}
+	elementUnderTest := NewAssociatedPowerManagementServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("cim_associatedpowermanagementservice Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should create and parse a valid CIM_AssociatedPowerManagementService Enumerate call",
+				CIMAssociatedPowerManagementService,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			{
+				"should create and parse a valid CIM_AssociatedPowerManagementService Pull call",
+				CIMAssociatedPowerManagementService,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:                               xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						AssociatedPowerManagementServiceItems: []AssociatedPowerManagementService{associatedPowerManagementService()},
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeCIMAssociatedPowerManagementService(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/power/associatedmanagementservice",
+	}
+	elementUnderTest := NewAssociatedPowerManagementServiceWithClient(wsmanMessageCreator, &client)
+
+	t.Run("cim_associatedpowermanagementservice Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when CIM_AssociatedPowerManagementService Enumerate call",
+				CIMAssociatedPowerManagementService,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when CIM_AssociatedPowerManagementService Pull call",
+				CIMAssociatedPowerManagementService,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -0,0 +1,187 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package power
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// DefaultPollInterval is the time between two reads of the power state when a PowerController waits for a power state.
+const DefaultPollInterval = 2 * time.Second
+
+var (
+	// ErrPowerStateNotSupported is returned when the managed system does not accept the requested power state.
+	ErrPowerStateNotSupported = errors.New("power state not supported")
+	// ErrPowerStateTimeout is returned when the managed system does not reach the requested power state in time.
+	ErrPowerStateTimeout = errors.New("timed out waiting for power state")
+	// ErrNoAssociatedPowerManagementService is returned when the power state of the managed system cannot be read.
+	ErrNoAssociatedPowerManagementService = errors.New("no associated power management service")
+	// ErrPowerStateNotObservable is returned when a timeout is given for a power state that the managed system does not
+	// report once it is reached, such as a reset or a power cycle.
+	ErrPowerStateNotObservable = errors.New("power state cannot be waited for")
+)
+
+// PowerController changes the power state of the managed system, accepting only the power states that the system supports.
+type PowerController struct {
+	ManagementService           ManagementService
+	AssociatedManagementService AssociatedManagementService
+	ManagementCapabilities      ManagementCapabilities
+	// PollInterval is the time between two reads of the power state in RequestPowerStateChange. DefaultPollInterval is
+	// used when it is not set.
+	PollInterval time.Duration
+}
+
+// NewPowerControllerWithClient returns a new instance of the PowerController struct.
+func NewPowerControllerWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) PowerController {
+	return PowerController{
+		ManagementService:           NewPowerManagementServiceWithClient(wsmanMessageCreator, client),
+		AssociatedManagementService: NewAssociatedPowerManagementServiceWithClient(wsmanMessageCreator, client),
+		ManagementCapabilities:      NewPowerManagementCapabilitiesWithClient(wsmanMessageCreator, client),
+	}
+}
+
+// PowerState returns the current power state of the managed system.
+func (controller PowerController) PowerState() (PowerState, error) {
+	association, err := controller.association()
+	if err != nil {
+		return 0, err
+	}
+
+	return association.PowerState, nil
+}
+
+// SupportedPowerStates returns the power states that the managed system accepts in its current power state. When the
+// system does not list them, the power states of its CIM_PowerManagementCapabilities are returned.
+func (controller PowerController) SupportedPowerStates() ([]PowerState, error) {
+	association, err := controller.association()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(association.AvailableRequestedPowerStates) > 0 {
+		return association.AvailableRequestedPowerStates, nil
+	}
+
+	response, err := controller.ManagementCapabilities.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Body.CapabilitiesGetResponse.PowerStatesSupported, nil
+}
+
+// RequestPowerStateChange requests the power state after checking that the managed system supports it. When timeout is
+// not zero, RequestPowerStateChange then reads the power state every PollInterval until the system reaches the power
+// state expected after the request, or returns ErrPowerStateTimeout when the timeout expires. A power cycle, a reset or
+// a diagnostic interrupt leaves the system powered on and the power state it reports before and after the request is
+// the same, so a timeout for them is rejected with ErrPowerStateNotObservable before the request is sent.
+func (controller PowerController) RequestPowerStateChange(powerState PowerState, timeout time.Duration) error {
+	expected, observable := expectedPowerState(powerState)
+	if timeout != 0 && !observable {
+		return fmt.Errorf("%w: %s", ErrPowerStateNotObservable, powerState)
+	}
+
+	supported, err := controller.SupportedPowerStates()
+	if err != nil {
+		return err
+	}
+
+	if !contains(supported, powerState) {
+		return fmt.Errorf("%w: %s", ErrPowerStateNotSupported, powerState)
+	}
+
+	response, err := controller.ManagementService.RequestPowerStateChange(powerState)
+	if err != nil {
+		return err
+	}
+
+	if returnValue := response.Body.RequestPowerStateChangeResponse.ReturnValue; returnValue != ReturnValueCompletedWithNoError {
+		return errors.New("RequestPowerStateChange failed with return code " + returnValue.String())
+	}
+
+	if timeout == 0 {
+		return nil
+	}
+
+	return controller.waitForPowerState(expected, timeout)
+}
+
+// waitForPowerState reads the power state until it is powerState or the timeout expires.
+func (controller PowerController) waitForPowerState(powerState PowerState, timeout time.Duration) error {
+	interval := controller.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+		current, err := controller.PowerState()
+		if err != nil {
+			return err
+		}
+
+		if current == powerState {
+			return nil
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return fmt.Errorf("%w %s, the power state is %s", ErrPowerStateTimeout, powerState, current)
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// association returns the CIM_AssociatedPowerManagementService of the managed system.
+func (controller PowerController) association() (AssociatedPowerManagementService, error) {
+	response, err := controller.AssociatedManagementService.Enumerate()
+	if err != nil {
+		return AssociatedPowerManagementService{}, err
+	}
+
+	response, err = controller.AssociatedManagementService.Pull(response.Body.EnumerateResponse.EnumerationContext)
+	if err != nil {
+		return AssociatedPowerManagementService{}, err
+	}
+
+	if len(response.Body.PullResponse.AssociatedPowerManagementServiceItems) == 0 {
+		return AssociatedPowerManagementService{}, ErrNoAssociatedPowerManagementService
+	}
+
+	return response.Body.PullResponse.AssociatedPowerManagementServiceItems[0], nil
+}
+
+// expectedPowerState returns the power state that the managed system reaches after the request of powerState, and
+// whether reaching it can be observed. It cannot for the requests that end in PowerOn without leaving it for long
+// enough to be read, such as a reset or a power cycle.
+func expectedPowerState(powerState PowerState) (expected PowerState, observable bool) {
+	switch powerState {
+	case PowerOffHard, PowerOffSoft, PowerOffSoftGraceful, PowerOffHardGraceful:
+		return PowerOffHard, true
+	case SleepLight, SleepDeep, Hibernate:
+		return powerState, true
+	case PowerOn:
+		return PowerOn, true
+	default:
+		return PowerOn, false
+	}
+}
+
+func contains(powerStates []PowerState, powerState PowerState) bool {
+	for _, s := range powerStates {
+		if s == powerState {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,206 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package power
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+var errControllerClient = errors.New("controller client error")
+
+// controllerClient answers the calls of a PowerController and records them as "<action> <class>".
+type controllerClient struct {
+	wsmantesting.MockClient
+	calls                []string
+	powerStates          []PowerState // the power states reported by successive reads, the last one repeated
+	availablePowerStates []PowerState
+	supportedPowerStates []PowerState
+	returnValue          ReturnValue
+	failOn               string
+}
+
+func (c *controllerClient) Post(msg string) ([]byte, error) {
+	call := path.Base(between(msg, "<a:Action>", "</a:Action>")) + " " + path.Base(between(msg, "<w:ResourceURI>", "</w:ResourceURI>"))
+	c.calls = append(c.calls, call)
+
+	if call == c.failOn {
+		return nil, errControllerClient
+	}
+
+	body := ""
+
+	switch call {
+	case "Enumerate " + CIMAssociatedPowerManagementService:
+		body = `<g:EnumerateResponse><g:EnumerationContext>1</g:EnumerationContext></g:EnumerateResponse>`
+	case "Pull " + CIMAssociatedPowerManagementService:
+		powerState := c.powerStates[0]
+		if len(c.powerStates) > 1 {
+			c.powerStates = c.powerStates[1:]
+		}
+
+		body = fmt.Sprintf(`<g:PullResponse><g:Items><h:CIM_AssociatedPowerManagementService>%s<h:PowerState>%d</h:PowerState></h:CIM_AssociatedPowerManagementService></g:Items></g:PullResponse>`, powerStates("AvailableRequestedPowerStates", c.availablePowerStates), powerState)
+	case "Get " + CIMPowerManagementCapabilities:
+		body = `<h:CIM_PowerManagementCapabilities>` + powerStates("PowerStatesSupported", c.supportedPowerStates) + `</h:CIM_PowerManagementCapabilities>`
+	case "RequestPowerStateChange " + CIMPowerManagementService:
+		body = fmt.Sprintf(`<h:RequestPowerStateChange_OUTPUT><h:ReturnValue>%d</h:ReturnValue></h:RequestPowerStateChange_OUTPUT>`, c.returnValue)
+	}
+
+	return []byte(`<a:Envelope xmlns:a="http://www.w3.org/2003/05/soap-envelope" xmlns:g="http://schemas.xmlsoap.org/ws/2004/09/enumeration" xmlns:h="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/"><a:Header></a:Header><a:Body>` + body + `</a:Body></a:Envelope>`), nil
+}
+
+func powerStates(name string, powerStates []PowerState) string {
+	s := ""
+	for _, powerState := range powerStates {
+		s += fmt.Sprintf("<h:%s>%d</h:%s>", name, powerState, name)
+	}
+
+	return s
+}
+
+func between(s, start, end string) string {
+	s = s[strings.Index(s, start)+len(start):]
+
+	return s[:strings.Index(s, end)]
+}
+
+func newTestPowerController(client *controllerClient) PowerController {
+	wsmanMessageCreator := message.NewWSManMessageCreator(wsmantesting.CIMResourceURIBase)
+	controller := NewPowerControllerWithClient(wsmanMessageCreator, client)
+	controller.PollInterval = time.Millisecond
+
+	return controller
+}
+
+func TestPowerState(t *testing.T) {
+	client := &controllerClient{powerStates: []PowerState{PowerOffHard}}
+	controller := newTestPowerController(client)
+
+	powerState, err := controller.PowerState()
+	assert.NoError(t, err)
+	assert.Equal(t, PowerOffHard, powerState)
+
+	client.failOn = "Pull " + CIMAssociatedPowerManagementService
+	_, err = controller.PowerState()
+	assert.ErrorIs(t, err, errControllerClient)
+}
+
+func TestSupportedPowerStates(t *testing.T) {
+	client := &controllerClient{
+		powerStates:          []PowerState{PowerOn},
+		availablePowerStates: []PowerState{PowerOffHard, MasterBusReset},
+		supportedPowerStates: []PowerState{PowerOn, PowerOffHard, MasterBusReset},
+	}
+	controller := newTestPowerController(client)
+
+	powerStates, err := controller.SupportedPowerStates()
+	assert.NoError(t, err)
+	assert.Equal(t, []PowerState{PowerOffHard, MasterBusReset}, powerStates)
+
+	client.availablePowerStates = nil
+	powerStates, err = controller.SupportedPowerStates()
+	assert.NoError(t, err)
+	assert.Equal(t, []PowerState{PowerOn, PowerOffHard, MasterBusReset}, powerStates)
+}
+
+func TestRequestPowerStateChange(t *testing.T) {
+	t.Run("should request the power state without waiting", func(t *testing.T) {
+		client := &controllerClient{powerStates: []PowerState{PowerOn}, availablePowerStates: []PowerState{PowerOffHard}}
+		controller := newTestPowerController(client)
+
+		assert.NoError(t, controller.RequestPowerStateChange(PowerOffHard, 0))
+		assert.Equal(t, []string{
+			"Enumerate CIM_AssociatedPowerManagementService",
+			"Pull CIM_AssociatedPowerManagementService",
+			"RequestPowerStateChange CIM_PowerManagementService",
+		}, client.calls)
+	})
+
+	t.Run("should wait until the power state is reached", func(t *testing.T) {
+		client := &controllerClient{powerStates: []PowerState{PowerOn, PowerOn, PowerOn, PowerOffHard}, availablePowerStates: []PowerState{PowerOffSoftGraceful}}
+		controller := newTestPowerController(client)
+
+		assert.NoError(t, controller.RequestPowerStateChange(PowerOffSoftGraceful, time.Second))
+		assert.Len(t, client.calls, 9)
+	})
+
+	t.Run("should time out when the power state is not reached", func(t *testing.T) {
+		client := &controllerClient{powerStates: []PowerState{PowerOffHard}, availablePowerStates: []PowerState{PowerOn}}
+		controller := newTestPowerController(client)
+
+		assert.ErrorIs(t, controller.RequestPowerStateChange(PowerOn, 10*time.Millisecond), ErrPowerStateTimeout)
+	})
+
+	t.Run("should reject a timeout for a reset or a power cycle", func(t *testing.T) {
+		client := &controllerClient{powerStates: []PowerState{PowerOn}, availablePowerStates: []PowerState{MasterBusReset, PowerCycleOffHard}}
+		controller := newTestPowerController(client)
+
+		for _, powerState := range []PowerState{MasterBusReset, PowerCycleOffHard} {
+			assert.ErrorIs(t, controller.RequestPowerStateChange(powerState, time.Second), ErrPowerStateNotObservable)
+		}
+
+		assert.Empty(t, client.calls)
+		assert.NoError(t, controller.RequestPowerStateChange(MasterBusReset, 0))
+		assert.Contains(t, client.calls, "RequestPowerStateChange CIM_PowerManagementService")
+	})
+
+	t.Run("should reject a power state that is not supported", func(t *testing.T) {
+		client := &controllerClient{powerStates: []PowerState{PowerOffHard}, availablePowerStates: []PowerState{PowerOn}}
+		controller := newTestPowerController(client)
+
+		err := controller.RequestPowerStateChange(MasterBusReset, 0)
+		assert.ErrorIs(t, err, ErrPowerStateNotSupported)
+		assert.EqualError(t, err, "power state not supported: MasterBusReset")
+		assert.NotContains(t, client.calls, "RequestPowerStateChange CIM_PowerManagementService")
+	})
+
+	t.Run("should return the failure of the request", func(t *testing.T) {
+		client := &controllerClient{powerStates: []PowerState{PowerOn}, availablePowerStates: []PowerState{PowerOffHard}, returnValue: ReturnValueInvalidStateTransition}
+		controller := newTestPowerController(client)
+
+		assert.EqualError(t, controller.RequestPowerStateChange(PowerOffHard, time.Second), "RequestPowerStateChange failed with return code InvalidStateTransition")
+	})
+
+	t.Run("should return the error of the client", func(t *testing.T) {
+		client := &controllerClient{powerStates: []PowerState{PowerOn}, availablePowerStates: []PowerState{PowerOffHard}, failOn: "RequestPowerStateChange CIM_PowerManagementService"}
+		controller := newTestPowerController(client)
+
+		assert.ErrorIs(t, controller.RequestPowerStateChange(PowerOffHard, 0), errControllerClient)
+	})
+}
+
+func TestExpectedPowerState(t *testing.T) {
+	tests := []struct {
+		requested  PowerState
+		expected   PowerState
+		observable bool
+	}{
+		{PowerOn, PowerOn, true},
+		{PowerOffSoftGraceful, PowerOffHard, true},
+		{PowerOffHard, PowerOffHard, true},
+		{Hibernate, Hibernate, true},
+		{SleepDeep, SleepDeep, true},
+		{PowerCycleOffHard, PowerOn, false},
+		{MasterBusReset, PowerOn, false},
+		{MasterBusResetGraceful, PowerOn, false},
+		{DiagnosticInterruptNMI, PowerOn, false},
+	}
+
+	for _, test := range tests {
+		expected, observable := expectedPowerState(test.requested)
+		assert.Equal(t, test.expected, expected, test.requested.String())
+		assert.Equal(t, test.observable, observable, test.requested.String())
+	}
+}
@@ -6,59 +6,126 @@
 package power
 
 const (
-	CIMPowerManagementService string = "CIM_PowerManagementService"
-	RequestPowerStateChange   string = "RequestPowerStateChange"
-	ValueNotFound             string = "Value not found in map"
+	CIMPowerManagementService           string = "CIM_PowerManagementService"
+	CIMAssociatedPowerManagementService string = "CIM_AssociatedPowerManagementService"
+	CIMPowerManagementCapabilities      string = "CIM_PowerManagementCapabilities"
+	RequestPowerStateChange             string = "RequestPowerStateChange"
+	ValueNotFound                       string = "Value not found in map"
 )
 
-// TODO: This list of contants needs to be scrubbed.
+// PowerState values of RequestPowerStateChange and of the power state of the managed system. Intel AMT accepts
+// PowerOn, SleepDeep, PowerCycleOffHard, Hibernate, PowerOffHard, MasterBusReset, DiagnosticInterruptNMI,
+// PowerOffSoftGraceful and MasterBusResetGraceful; the states that a given system accepts in its current state are
+// listed in the AvailableRequestedPowerStates of its CIM_AssociatedPowerManagementService.
 const (
 	// Power On.
-	PowerOn PowerState = 2 // Verified Hardware Power On
+	PowerOn PowerState = 2 // Hardware power on
 
 	// Sleep - Light.
-	SleepLight PowerState = 3 // ?
+	SleepLight PowerState = 3 // Standby (S1); not accepted by Intel AMT
 
 	// Sleep - Deep.
-	SleepDeep PowerState = 4 // ?
+	SleepDeep PowerState = 4 // Suspend to RAM (S3)
 
 	// Power Cycle (Off Soft).
-	PowerCycleOffSoft PowerState = 6 // ?
+	PowerCycleOffSoft PowerState = 6 // Not accepted by Intel AMT; see PowerCycleOffHard
 
 	// Power Off - Hard.
-	PowerOffHard PowerState = 8 // Verfied Hardware Power Off
+	PowerOffHard PowerState = 8 // Hardware power off; also the state reported while the system is off
 
 	// Hibernate.
-	Hibernate PowerState = 7 // ?
+	Hibernate PowerState = 7 // Suspend to disk (S4)
 
 	// Power Off - Soft.
-	PowerOffSoft PowerState = 9 // ?
+	PowerOffSoft PowerState = 9 // Not accepted by Intel AMT; see PowerOffSoftGraceful
 
 	// Power Cycle (Off Hard).
-	PowerCycleOffHard PowerState = 5 // Verified Hardware Power Cycle (off then on)
+	PowerCycleOffHard PowerState = 5 // Hardware power cycle (off then on)
 
 	// Master Bus Reset.
-	MasterBusReset PowerState = 10 // Verified Hardware Reboot
+	MasterBusReset PowerState = 10 // Hardware reboot
 
 	// Diagnostic Interrupt (NMI).
-	DiagnosticInterruptNMI PowerState = 11 // ?
+	DiagnosticInterruptNMI PowerState = 11 // Non-maskable interrupt, which lets the operating system take a crash dump
 
 	// Power Off - Soft Graceful.
-	PowerOffSoftGraceful PowerState = 12 // ?
+	PowerOffSoftGraceful PowerState = 12 // Operating system shutdown, requested through the host agent
 
 	// Power Off - Hard Graceful.
-	PowerOffHardGraceful PowerState = 13 // ?
+	PowerOffHardGraceful PowerState = 13 // Not accepted by Intel AMT; see PowerOffSoftGraceful
 
 	// Master Bus Reset Graceful.
-	MasterBusResetGraceful PowerState = 14 // ?
+	MasterBusResetGraceful PowerState = 14 // Operating system restart, requested through the host agent
 
 	// Power Cycle (Off - Soft Graceful).
-	PowerCycleOffSoftGraceful PowerState = 15 // ?
+	PowerCycleOffSoftGraceful PowerState = 15 // Not accepted by Intel AMT
 
 	// Power Cycle (Off - Hard Graceful).
-	PowerCycleOffHardGraceful PowerState = 16 // ?
+	PowerCycleOffHardGraceful PowerState = 16 // Not accepted by Intel AMT
 )
 
+// powerStateMap is a map of the PowerState enumeration.
+var powerStateMap = map[PowerState]string{
+	PowerOn:                   "PowerOn",
+	SleepLight:                "SleepLight",
+	SleepDeep:                 "SleepDeep",
+	PowerCycleOffSoft:         "PowerCycleOffSoft",
+	PowerOffHard:              "PowerOffHard",
+	Hibernate:                 "Hibernate",
+	PowerOffSoft:              "PowerOffSoft",
+	PowerCycleOffHard:         "PowerCycleOffHard",
+	MasterBusReset:            "MasterBusReset",
+	DiagnosticInterruptNMI:    "DiagnosticInterruptNMI",
+	PowerOffSoftGraceful:      "PowerOffSoftGraceful",
+	PowerOffHardGraceful:      "PowerOffHardGraceful",
+	MasterBusResetGraceful:    "MasterBusResetGraceful",
+	PowerCycleOffSoftGraceful: "PowerCycleOffSoftGraceful",
+	PowerCycleOffHardGraceful: "PowerCycleOffHardGraceful",
+}
+
+// String returns a human-readable string representation of the PowerState enumeration.
+func (e PowerState) String() string {
+	if s, ok := powerStateMap[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	PowerChangeCapabilitiesUnknown PowerChangeCapabilities = iota
+	PowerChangeCapabilitiesOther
+	PowerChangeCapabilitiesPowerSavingModesEnteredAutomatically
+	PowerChangeCapabilitiesPowerStateSettable
+	PowerChangeCapabilitiesPowerCyclingSupported
+	PowerChangeCapabilitiesTimedPowerOnSupported
+	PowerChangeCapabilitiesOffHardPowerCyclingSupported
+	PowerChangeCapabilitiesHWResetSupported
+	PowerChangeCapabilitiesGracefulShutdownSupported
+)
+
+// powerChangeCapabilitiesMap is a map of the PowerChangeCapabilities enumeration.
+var powerChangeCapabilitiesMap = map[PowerChangeCapabilities]string{
+	PowerChangeCapabilitiesUnknown:                              "Unknown",
+	PowerChangeCapabilitiesOther:                                "Other",
+	PowerChangeCapabilitiesPowerSavingModesEnteredAutomatically: "PowerSavingModesEnteredAutomatically",
+	PowerChangeCapabilitiesPowerStateSettable:                   "PowerStateSettable",
+	PowerChangeCapabilitiesPowerCyclingSupported:                "PowerCyclingSupported",
+	PowerChangeCapabilitiesTimedPowerOnSupported:                "TimedPowerOnSupported",
+	PowerChangeCapabilitiesOffHardPowerCyclingSupported:         "OffHardPowerCyclingSupported",
+	PowerChangeCapabilitiesHWResetSupported:                     "HWResetSupported",
+	PowerChangeCapabilitiesGracefulShutdownSupported:            "GracefulShutdownSupported",
+}
+
+// String returns a human-readable string representation of the PowerChangeCapabilities enumeration.
+func (e PowerChangeCapabilities) String() string {
+	if s, ok := powerChangeCapabilitiesMap[e]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
 const (
 	EnabledStateUnknown EnabledState = iota
 	EnabledStateOther
@@ -88,3 +88,49 @@ func TestReturnValue_String(t *testing.T) {
 		}
 	}
 }
+
+func TestPowerState_String(t *testing.T) {
+	tests := []struct {
+		state    PowerState
+		expected string
+	}{
+		{PowerOn, "PowerOn"},
+		{SleepDeep, "SleepDeep"},
+		{PowerCycleOffHard, "PowerCycleOffHard"},
+		{Hibernate, "Hibernate"},
+		{PowerOffHard, "PowerOffHard"},
+		{MasterBusReset, "MasterBusReset"},
+		{DiagnosticInterruptNMI, "DiagnosticInterruptNMI"},
+		{PowerOffSoftGraceful, "PowerOffSoftGraceful"},
+		{MasterBusResetGraceful, "MasterBusResetGraceful"},
+		{PowerState(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestPowerChangeCapabilities_String(t *testing.T) {
+	tests := []struct {
+		state    PowerChangeCapabilities
+		expected string
+	}{
+		{PowerChangeCapabilitiesUnknown, "Unknown"},
+		{PowerChangeCapabilitiesPowerStateSettable, "PowerStateSettable"},
+		{PowerChangeCapabilitiesPowerCyclingSupported, "PowerCyclingSupported"},
+		{PowerChangeCapabilitiesHWResetSupported, "HWResetSupported"},
+		{PowerChangeCapabilitiesGracefulShutdownSupported, "GracefulShutdownSupported"},
+		{PowerChangeCapabilities(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
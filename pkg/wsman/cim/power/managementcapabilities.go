@@ -0,0 +1,92 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package power
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+)
+
+// NewPowerManagementCapabilitiesWithClient returns a new instance of the ManagementCapabilities struct.
+//
+// CIM_PowerManagementCapabilities describes the power management features of the managed system and the power states
+// that RequestPowerStateChange can accept.
+func NewPowerManagementCapabilitiesWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) ManagementCapabilities {
+	return ManagementCapabilities{
+		base: message.NewBaseWithClient(wsmanMessageCreator, CIMPowerManagementCapabilities, client),
+	}
+}
+
+// Get retrieves the representation of the instance.
+func (managementCapabilities ManagementCapabilities) Get() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: managementCapabilities.base.Get(nil),
+		},
+	}
+
+	// send the message to AMT
+	err = managementCapabilities.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (managementCapabilities ManagementCapabilities) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: managementCapabilities.base.Enumerate(),
+		},
+	}
+
+	// send the message to AMT
+	err = managementCapabilities.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (managementCapabilities ManagementCapabilities) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: managementCapabilities.base.Pull(enumerationContext),
+		},
+	}
+
+	// send the message to AMT
+	err = managementCapabilities.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	// put the xml response into the go struct
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
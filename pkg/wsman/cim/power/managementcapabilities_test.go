@@ -0,0 +1,187 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package power
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/wsmantesting"
+)
+
+func powerManagementCapabilities() PowerManagementCapabilities {
+	return PowerManagementCapabilities{
+		XMLName:     xml.Name{Space: "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_PowerManagementCapabilities", Local: CIMPowerManagementCapabilities},
+		InstanceID:  "Intel(r) AMT:PowerManagementCapabilities",
+		ElementName: "Intel(r) AMT Power Management Capabilities",
+		PowerChangeCapabilities: []PowerChangeCapabilities{
+			PowerChangeCapabilitiesPowerStateSettable,
+			PowerChangeCapabilitiesPowerCyclingSupported,
+			PowerChangeCapabilitiesHWResetSupported,
+			PowerChangeCapabilitiesGracefulShutdownSupported,
+		},
+		PowerStatesSupported: []PowerState{PowerOn, SleepDeep, PowerCycleOffHard, Hibernate, PowerOffHard, MasterBusReset, DiagnosticInterruptNMI, PowerOffSoftGraceful, MasterBusResetGraceful},
+	}
+}
+
+func TestPositiveCIMPowerManagementCapabilities(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/power/managementcapabilities",
+	}
+	elementUnderTest := NewPowerManagementCapabilitiesWithClient(wsmanMessageCreator, &client)
+
+	t.Run("cim_powermanagementcapabilities Tests", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			method           string
+			action           string
+			extraHeader      string
+			body             string
+			responseFunc     func() (Response, error)
+			expectedResponse interface{}
+		}{
+			{
+				"should create and parse a valid CIM_PowerManagementCapabilities Get call",
+				CIMPowerManagementCapabilities,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageGet
+
+					return elementUnderTest.Get()
+				},
+				Body{
+					XMLName:                 xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					CapabilitiesGetResponse: powerManagementCapabilities(),
+				},
+			},
+			{
+				"should create and parse a valid CIM_PowerManagementCapabilities Enumerate call",
+				CIMPowerManagementCapabilities,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageEnumerate
+
+					return elementUnderTest.Enumerate()
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					EnumerateResponse: common.EnumerateResponse{
+						EnumerationContext: "95040000-0000-0000-0000-000000000000",
+					},
+				},
+			},
+			{
+				"should create and parse a valid CIM_PowerManagementCapabilities Pull call",
+				CIMPowerManagementCapabilities,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessagePull
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+				Body{
+					XMLName: xml.Name{Space: message.XMLBodySpace, Local: "Body"},
+					PullResponse: PullResponse{
+						XMLName:                          xml.Name{Space: "http://schemas.xmlsoap.org/ws/2004/09/enumeration", Local: "PullResponse"},
+						PowerManagementCapabilitiesItems: []PowerManagementCapabilities{powerManagementCapabilities()},
+					},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.NoError(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+				assert.Equal(t, test.expectedResponse, response.Body)
+			})
+		}
+	})
+}
+
+func TestNegativeCIMPowerManagementCapabilities(t *testing.T) {
+	messageID := 0
+	resourceURIBase := wsmantesting.CIMResourceURIBase
+	wsmanMessageCreator := message.NewWSManMessageCreator(resourceURIBase)
+	client := wsmantesting.MockClient{
+		PackageUnderTest: "cim/power/managementcapabilities",
+	}
+	elementUnderTest := NewPowerManagementCapabilitiesWithClient(wsmanMessageCreator, &client)
+
+	t.Run("cim_powermanagementcapabilities Tests", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			method       string
+			action       string
+			extraHeader  string
+			body         string
+			responseFunc func() (Response, error)
+		}{
+			{
+				"should handle error when CIM_PowerManagementCapabilities Get call",
+				CIMPowerManagementCapabilities,
+				wsmantesting.Get,
+				"",
+				"",
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Get()
+				},
+			},
+			{
+				"should handle error when CIM_PowerManagementCapabilities Enumerate call",
+				CIMPowerManagementCapabilities,
+				wsmantesting.Enumerate,
+				"",
+				wsmantesting.EnumerateBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Enumerate()
+				},
+			},
+			{
+				"should handle error when CIM_PowerManagementCapabilities Pull call",
+				CIMPowerManagementCapabilities,
+				wsmantesting.Pull,
+				"",
+				wsmantesting.PullBody,
+				func() (Response, error) {
+					client.CurrentMessage = wsmantesting.CurrentMessageError
+
+					return elementUnderTest.Pull(wsmantesting.EnumerationContext)
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				expectedXMLInput := wsmantesting.ExpectedResponse(messageID, resourceURIBase, test.method, test.action, test.extraHeader, test.body)
+				messageID++
+				response, err := test.responseFunc()
+				assert.Error(t, err)
+				assert.Equal(t, expectedXMLInput, response.XMLInput)
+			})
+		}
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/xml"
 
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/models"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
 	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
 )
@@ -17,6 +18,14 @@ type ManagementService struct {
 	base message.Base
 }
 
+type AssociatedManagementService struct {
+	base message.Base
+}
+
+type ManagementCapabilities struct {
+	base message.Base
+}
+
 type PowerState int
 
 // Response Types.
@@ -31,13 +40,16 @@ type (
 		XMLName                         xml.Name            `xml:"Body"`
 		RequestPowerStateChangeResponse PowerActionResponse `xml:"RequestPowerStateChange_OUTPUT"`
 		GetResponse                     PowerManagementService
+		CapabilitiesGetResponse         PowerManagementCapabilities `xml:"CIM_PowerManagementCapabilities"`
 		EnumerateResponse               common.EnumerateResponse
 		PullResponse                    PullResponse
 	}
 
 	PullResponse struct {
-		XMLName                     xml.Name                 `xml:"PullResponse"`
-		PowerManagementServiceItems []PowerManagementService `xml:"Items>CIM_PowerManagementService"`
+		XMLName                               xml.Name                           `xml:"PullResponse"`
+		PowerManagementServiceItems           []PowerManagementService           `xml:"Items>CIM_PowerManagementService"`
+		AssociatedPowerManagementServiceItems []AssociatedPowerManagementService `xml:"Items>CIM_AssociatedPowerManagementService"`
+		PowerManagementCapabilitiesItems      []PowerManagementCapabilities      `xml:"Items>CIM_PowerManagementCapabilities"`
 	}
 
 	PowerManagementService struct {
@@ -51,6 +63,23 @@ type (
 		SystemName              string         `xml:"SystemName,omitempty"`              // The Name of the scoping System.
 	}
 
+	AssociatedPowerManagementService struct {
+		XMLName                       xml.Name                    `xml:"CIM_AssociatedPowerManagementService"`
+		AvailableRequestedPowerStates []PowerState                `xml:"AvailableRequestedPowerStates,omitempty"` // The power states that RequestPowerStateChange accepts in the current power state of the system.
+		PowerState                    PowerState                  `xml:"PowerState,omitempty"`                    // The current power state of the associated Managed System Element.
+		RequestedPowerState           PowerState                  `xml:"RequestedPowerState,omitempty"`           // The last power state requested with RequestPowerStateChange.
+		ServiceProvided               models.AssociationReference `xml:"ServiceProvided"`                         // The Service that is available.
+		UserOfService                 models.AssociationReference `xml:"UserOfService"`                           // The ManagedElement that can use the Service.
+	}
+
+	PowerManagementCapabilities struct {
+		XMLName                 xml.Name                  `xml:"CIM_PowerManagementCapabilities"`
+		InstanceID              string                    `xml:"InstanceID,omitempty"`              // Within the scope of the instantiating Namespace, InstanceID opaquely and uniquely identifies an instance of this class.
+		ElementName             string                    `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		PowerChangeCapabilities []PowerChangeCapabilities `xml:"PowerChangeCapabilities,omitempty"` // The power management features of the system.
+		PowerStatesSupported    []PowerState              `xml:"PowerStatesSupported,omitempty"`    // The power states that RequestPowerStateChange can accept.
+	}
+
 	PowerActionResponse struct {
 		ReturnValue ReturnValue `xml:"ReturnValue"`
 	}
@@ -59,6 +88,8 @@ type (
 	EnabledState int
 	// RequestedState is an integer enumeration that indicates the last requested or desired state for the element, irrespective of the mechanism through which it was requested.
 	RequestedState int
+	// PowerChangeCapabilities is an integer enumeration that indicates a power management feature of the system.
+	PowerChangeCapabilities int
 	// ReturnValue is an integer enumeration that indicates the success or failure of an operation.
 	ReturnValue int
 )
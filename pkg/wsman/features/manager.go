@@ -0,0 +1,290 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package features queries and changes the state of the Intel® AMT console features: SOL, IDER, KVM, the redirection listener and the Web UI.
+package features
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/webui"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/kvm"
+)
+
+// RedirectionService is the subset of redirection.Service used by the Manager.
+type RedirectionService interface {
+	Get() (redirection.Response, error)
+	Put(redirectionService redirection.RedirectionRequest) (redirection.Response, error)
+	RequestStateChange(requestedState redirection.RequestedState) (redirection.Response, error)
+	TerminateSession(sessionType redirection.SessionType) (redirection.Response, error)
+}
+
+// KVMRedirectionSAP is the subset of kvm.RedirectionSAP used by the Manager.
+type KVMRedirectionSAP interface {
+	Get() (kvm.Response, error)
+	RequestStateChange(requestedState kvm.KVMRedirectionSAPRequestStateChangeInput) (kvm.Response, error)
+}
+
+// WebUIService is the subset of webui.Service used by the Manager.
+type WebUIService interface {
+	Get() (webui.Response, error)
+	RequestStateChange(requestedState webui.RequestedState) (webui.Response, error)
+}
+
+// AuditLog is the subset of auditlog.Service used by the Manager.
+type AuditLog interface {
+	ReadRecords(startIndex int) (auditlog.Response, error)
+}
+
+// Manager queries and changes the state of the console features.
+type Manager struct {
+	redirection RedirectionService
+	kvm         KVMRedirectionSAP
+	webUI       WebUIService
+	auditLog    AuditLog
+}
+
+// Feature identifies a console feature.
+type Feature string
+
+const (
+	SOL                 Feature = "SOL"
+	IDER                Feature = "IDER"
+	KVM                 Feature = "KVM"
+	RedirectionListener Feature = "RedirectionListener"
+	WebUI               Feature = "WebUI"
+)
+
+// State is the enabled state of the console features.
+type State struct {
+	SOL                 bool
+	IDER                bool
+	KVM                 bool
+	RedirectionListener bool
+	WebUI               bool
+}
+
+// DesiredState is the state requested from Apply. Features left nil are not queried or changed.
+type DesiredState struct {
+	SOL                 *bool
+	IDER                *bool
+	KVM                 *bool
+	RedirectionListener *bool
+	WebUI               *bool
+}
+
+// Change reports a feature changed by Apply.
+type Change struct {
+	Feature Feature
+	From    bool
+	To      bool
+}
+
+// ErrKVMStateChange is returned when CIM_KVMRedirectionSAP rejects the requested state.
+var ErrKVMStateChange = errors.New("KVM RequestStateChange failed")
+
+// NewManager creates a Manager on top of the given services. The audit log is only read by Sessions.
+func NewManager(redirectionService RedirectionService, kvmRedirectionSAP KVMRedirectionSAP, webUIService WebUIService, auditLog AuditLog) Manager {
+	return Manager{
+		redirection: redirectionService,
+		kvm:         kvmRedirectionSAP,
+		webUI:       webUIService,
+		auditLog:    auditLog,
+	}
+}
+
+// NewManagerWithMessages creates a Manager using the services of a wsman.Messages.
+func NewManagerWithMessages(messages wsman.Messages) Manager {
+	return NewManager(messages.AMT.RedirectionService, messages.CIM.KVMRedirectionSAP, messages.AMT.WebUIService, messages.AMT.AuditLog)
+}
+
+// Enable returns a *bool set to true, for use in DesiredState.
+func Enable() *bool {
+	enabled := true
+
+	return &enabled
+}
+
+// Disable returns a *bool set to false, for use in DesiredState.
+func Disable() *bool {
+	enabled := false
+
+	return &enabled
+}
+
+// State returns the current state of all console features.
+func (m Manager) State() (state State, err error) {
+	service, err := m.redirectionState()
+	if err != nil {
+		return state, err
+	}
+
+	state.SOL = service.EnabledState.SOLEnabled()
+	state.IDER = service.EnabledState.IDEREnabled()
+	state.RedirectionListener = service.ListenerEnabled
+
+	if state.KVM, err = m.kvmState(); err != nil {
+		return state, err
+	}
+
+	state.WebUI, err = m.webUIState()
+
+	return state, err
+}
+
+// Apply changes the console features to the desired state. Only the features that differ from the
+// current state are changed. The changes made are returned, also when a later change fails.
+func (m Manager) Apply(desired DesiredState) (changes []Change, err error) {
+	if desired.SOL != nil || desired.IDER != nil || desired.RedirectionListener != nil {
+		if changes, err = m.applyRedirection(desired, changes); err != nil {
+			return changes, err
+		}
+	}
+
+	if desired.KVM != nil {
+		if changes, err = m.applyKVM(*desired.KVM, changes); err != nil {
+			return changes, err
+		}
+	}
+
+	if desired.WebUI != nil {
+		if changes, err = m.applyWebUI(*desired.WebUI, changes); err != nil {
+			return changes, err
+		}
+	}
+
+	return changes, nil
+}
+
+func (m Manager) redirectionState() (redirection.RedirectionResponse, error) {
+	response, err := m.redirection.Get()
+	if err != nil {
+		return redirection.RedirectionResponse{}, err
+	}
+
+	return response.Body.GetAndPutResponse, nil
+}
+
+func (m Manager) kvmState() (bool, error) {
+	response, err := m.kvm.Get()
+	if err != nil {
+		return false, err
+	}
+
+	return response.Body.GetResponse.EnabledState.IsEnabled(), nil
+}
+
+func (m Manager) webUIState() (bool, error) {
+	response, err := m.webUI.Get()
+	if err != nil {
+		return false, err
+	}
+
+	return response.Body.GetResponse.EnabledState.IsEnabled(), nil
+}
+
+func (m Manager) applyRedirection(desired DesiredState, changes []Change) ([]Change, error) {
+	service, err := m.redirectionState()
+	if err != nil {
+		return changes, err
+	}
+
+	sol := service.EnabledState.SOLEnabled()
+	ider := service.EnabledState.IDEREnabled()
+	wantSOL := valueOr(desired.SOL, sol)
+	wantIDER := valueOr(desired.IDER, ider)
+	requestedState := redirection.NewRequestedState(wantSOL, wantIDER)
+
+	if wantSOL != sol || wantIDER != ider {
+		if _, err = m.redirection.RequestStateChange(requestedState); err != nil {
+			return changes, err
+		}
+
+		changes = appendChange(changes, SOL, sol, wantSOL)
+		changes = appendChange(changes, IDER, ider, wantIDER)
+	}
+
+	listener := valueOr(desired.RedirectionListener, service.ListenerEnabled)
+	if listener == service.ListenerEnabled {
+		return changes, nil
+	}
+
+	request := redirection.RedirectionRequest{
+		CreationClassName:       service.CreationClassName,
+		ElementName:             service.ElementName,
+		EnabledState:            redirection.EnabledState(requestedState),
+		ListenerEnabled:         listener,
+		Name:                    service.Name,
+		SystemCreationClassName: service.SystemCreationClassName,
+		SystemName:              service.SystemName,
+	}
+
+	if _, err = m.redirection.Put(request); err != nil {
+		return changes, err
+	}
+
+	return appendChange(changes, RedirectionListener, service.ListenerEnabled, listener), nil
+}
+
+func (m Manager) applyKVM(enabled bool, changes []Change) ([]Change, error) {
+	current, err := m.kvmState()
+	if err != nil || current == enabled {
+		return changes, err
+	}
+
+	requestedState := kvm.RedirectionSAPDisable
+	if enabled {
+		requestedState = kvm.RedirectionSAPEnable
+	}
+
+	response, err := m.kvm.RequestStateChange(requestedState)
+	if err != nil {
+		return changes, err
+	}
+
+	if returnValue := response.Body.RequestStateChange_OUTPUT.ReturnValue; returnValue != kvm.ReturnValueCompletedNoError {
+		return changes, fmt.Errorf("%w with return code %s", ErrKVMStateChange, returnValue)
+	}
+
+	return appendChange(changes, KVM, current, enabled), nil
+}
+
+func (m Manager) applyWebUI(enabled bool, changes []Change) ([]Change, error) {
+	current, err := m.webUIState()
+	if err != nil || current == enabled {
+		return changes, err
+	}
+
+	requestedState := webui.Disable
+	if enabled {
+		requestedState = webui.Enable
+	}
+
+	if _, err = m.webUI.RequestStateChange(requestedState); err != nil {
+		return changes, err
+	}
+
+	return appendChange(changes, WebUI, current, enabled), nil
+}
+
+func valueOr(value *bool, current bool) bool {
+	if value == nil {
+		return current
+	}
+
+	return *value
+}
+
+func appendChange(changes []Change, feature Feature, from, to bool) []Change {
+	if from == to {
+		return changes
+	}
+
+	return append(changes, Change{Feature: feature, From: from, To: to})
+}
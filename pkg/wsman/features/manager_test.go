@@ -0,0 +1,208 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package features
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/webui"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/kvm"
+)
+
+var errGet = errors.New("get failed")
+
+type fakeRedirectionService struct {
+	service         redirection.RedirectionResponse
+	requestedStates []redirection.RequestedState
+	puts            []redirection.RedirectionRequest
+	terminated      []redirection.SessionType
+}
+
+func (f *fakeRedirectionService) Get() (response redirection.Response, err error) {
+	response.Body.GetAndPutResponse = f.service
+
+	return response, nil
+}
+
+func (f *fakeRedirectionService) Put(request redirection.RedirectionRequest) (response redirection.Response, err error) {
+	f.puts = append(f.puts, request)
+
+	return response, nil
+}
+
+func (f *fakeRedirectionService) RequestStateChange(requestedState redirection.RequestedState) (response redirection.Response, err error) {
+	f.requestedStates = append(f.requestedStates, requestedState)
+
+	return response, nil
+}
+
+func (f *fakeRedirectionService) TerminateSession(sessionType redirection.SessionType) (response redirection.Response, err error) {
+	f.terminated = append(f.terminated, sessionType)
+
+	return response, nil
+}
+
+type fakeKVMRedirectionSAP struct {
+	enabledState    kvm.EnabledState
+	returnValue     kvm.ReturnValue
+	requestedStates []kvm.KVMRedirectionSAPRequestStateChangeInput
+}
+
+func (f *fakeKVMRedirectionSAP) Get() (response kvm.Response, err error) {
+	response.Body.GetResponse.EnabledState = f.enabledState
+
+	return response, nil
+}
+
+func (f *fakeKVMRedirectionSAP) RequestStateChange(requestedState kvm.KVMRedirectionSAPRequestStateChangeInput) (response kvm.Response, err error) {
+	f.requestedStates = append(f.requestedStates, requestedState)
+	response.Body.RequestStateChange_OUTPUT.ReturnValue = f.returnValue
+
+	return response, nil
+}
+
+type fakeWebUIService struct {
+	enabledState    webui.EnabledState
+	err             error
+	requestedStates []webui.RequestedState
+}
+
+func (f *fakeWebUIService) Get() (response webui.Response, err error) {
+	response.Body.GetResponse.EnabledState = f.enabledState
+
+	return response, f.err
+}
+
+func (f *fakeWebUIService) RequestStateChange(requestedState webui.RequestedState) (response webui.Response, err error) {
+	f.requestedStates = append(f.requestedStates, requestedState)
+
+	return response, nil
+}
+
+type fakeAuditLog struct {
+	records []string
+	err     error
+}
+
+func (f *fakeAuditLog) ReadRecords(startIndex int) (response auditlog.Response, err error) {
+	output := &response.Body.ReadRecordsResponse
+	output.TotalRecordCount = len(f.records)
+
+	if startIndex > len(f.records) {
+		output.ReturnValue = int(auditlog.ReturnValueInvalidIndex)
+
+		return response, f.err
+	}
+
+	output.EventRecords = f.records[startIndex-1:]
+	output.RecordsReturned = len(output.EventRecords)
+
+	return response, f.err
+}
+
+func newFakes() (*fakeRedirectionService, *fakeKVMRedirectionSAP, *fakeWebUIService, *fakeAuditLog) {
+	redirectionService := &fakeRedirectionService{
+		service: redirection.RedirectionResponse{
+			CreationClassName:       redirection.AMTRedirectionService,
+			ElementName:             "Intel(r) AMT Redirection Service",
+			EnabledState:            redirection.SOLIsEnabledAndIDERIsDisabled,
+			ListenerEnabled:         false,
+			Name:                    "Intel(r) AMT Redirection Service",
+			SystemCreationClassName: "CIM_ComputerSystem",
+			SystemName:              "Intel(r) AMT",
+		},
+	}
+
+	return redirectionService, &fakeKVMRedirectionSAP{enabledState: kvm.EnabledStateDisabled}, &fakeWebUIService{enabledState: webui.EnabledStateWebUIEnabled}, &fakeAuditLog{}
+}
+
+func TestState(t *testing.T) {
+	redirectionService, kvmRedirectionSAP, webUIService, auditLog := newFakes()
+	kvmRedirectionSAP.enabledState = kvm.EnabledStateEnabledButOffline
+	manager := NewManager(redirectionService, kvmRedirectionSAP, webUIService, auditLog)
+
+	state, err := manager.State()
+	assert.NoError(t, err)
+	assert.Equal(t, State{SOL: true, IDER: false, KVM: true, RedirectionListener: false, WebUI: true}, state)
+
+	webUIService.err = errGet
+
+	_, err = manager.State()
+	assert.ErrorIs(t, err, errGet)
+}
+
+func TestApply(t *testing.T) {
+	redirectionService, kvmRedirectionSAP, webUIService, auditLog := newFakes()
+	manager := NewManager(redirectionService, kvmRedirectionSAP, webUIService, auditLog)
+
+	changes, err := manager.Apply(DesiredState{
+		IDER:                Enable(),
+		KVM:                 Enable(),
+		RedirectionListener: Enable(),
+		WebUI:               Enable(),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Feature: IDER, From: false, To: true},
+		{Feature: RedirectionListener, From: false, To: true},
+		{Feature: KVM, From: false, To: true},
+	}, changes)
+
+	assert.Equal(t, []redirection.RequestedState{redirection.EnableIDERAndSOL}, redirectionService.requestedStates)
+	assert.Equal(t, []redirection.RedirectionRequest{{
+		CreationClassName:       redirection.AMTRedirectionService,
+		ElementName:             "Intel(r) AMT Redirection Service",
+		EnabledState:            redirection.IDERAndSOLAreEnabled,
+		ListenerEnabled:         true,
+		Name:                    "Intel(r) AMT Redirection Service",
+		SystemCreationClassName: "CIM_ComputerSystem",
+		SystemName:              "Intel(r) AMT",
+	}}, redirectionService.puts)
+	assert.Equal(t, []kvm.KVMRedirectionSAPRequestStateChangeInput{kvm.RedirectionSAPEnable}, kvmRedirectionSAP.requestedStates)
+	assert.Nil(t, webUIService.requestedStates)
+}
+
+func TestApplyNoChanges(t *testing.T) {
+	redirectionService, kvmRedirectionSAP, webUIService, auditLog := newFakes()
+	manager := NewManager(redirectionService, kvmRedirectionSAP, webUIService, auditLog)
+
+	changes, err := manager.Apply(DesiredState{SOL: Enable(), KVM: Disable(), RedirectionListener: Disable()})
+	assert.NoError(t, err)
+	assert.Nil(t, changes)
+	assert.Nil(t, redirectionService.requestedStates)
+	assert.Nil(t, redirectionService.puts)
+	assert.Nil(t, kvmRedirectionSAP.requestedStates)
+}
+
+func TestApplyDisable(t *testing.T) {
+	redirectionService, kvmRedirectionSAP, webUIService, auditLog := newFakes()
+	manager := NewManager(redirectionService, kvmRedirectionSAP, webUIService, auditLog)
+
+	changes, err := manager.Apply(DesiredState{SOL: Disable(), WebUI: Disable()})
+	assert.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Feature: SOL, From: true, To: false},
+		{Feature: WebUI, From: true, To: false},
+	}, changes)
+	assert.Equal(t, []redirection.RequestedState{redirection.DisableIDERAndSOL}, redirectionService.requestedStates)
+	assert.Equal(t, []webui.RequestedState{webui.Disable}, webUIService.requestedStates)
+}
+
+func TestApplyReportsPartialChanges(t *testing.T) {
+	redirectionService, kvmRedirectionSAP, webUIService, auditLog := newFakes()
+	kvmRedirectionSAP.returnValue = kvm.ReturnValueFailed
+	manager := NewManager(redirectionService, kvmRedirectionSAP, webUIService, auditLog)
+
+	changes, err := manager.Apply(DesiredState{IDER: Enable(), KVM: Enable(), WebUI: Disable()})
+	assert.ErrorIs(t, err, ErrKVMStateChange)
+	assert.Equal(t, []Change{{Feature: IDER, From: false, To: true}}, changes)
+	assert.Nil(t, webUIService.requestedStates)
+}
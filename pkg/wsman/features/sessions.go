@@ -0,0 +1,160 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package features
+
+import (
+	"errors"
+	"time"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
+)
+
+// ErrTerminateNotSupported is returned when TerminateSession is called for a feature without sessions that can be terminated.
+var ErrTerminateNotSupported = errors.New("terminating sessions is only supported for SOL and IDER")
+
+// Sessions is a combined view of the redirection sessions of a device.
+type Sessions struct {
+	State      State                        // Enabled state of the console features.
+	KVMActive  bool                         // Whether a KVM session is currently open, as reported by CIM_KVMRedirectionSAP.
+	SOLActive  bool                         // Whether a SOL session is open according to the audit log.
+	IDERActive bool                         // Whether an IDER session is open according to the audit log.
+	Active     []ActiveSession              // Sessions opened and not yet closed according to the audit log, in SOL, IDER, KVM order.
+	AccessLog  []redirection.AccessLogEntry // Recent redirection operations with their source address.
+}
+
+// ActiveSession is a redirection session with its protocol and user, taken from the Redirection Manager audit events.
+type ActiveSession struct {
+	Feature Feature   // SOL, IDER or KVM.
+	User    string    // User name, or SID for Kerberos users; empty for local and KVM default port initiators.
+	Address string    // Network address the session was opened from.
+	Started time.Time // Time the session was opened, in the Intel® AMT clock (UTC).
+}
+
+// sessionEvents maps the Redirection Manager audit events that open and close a session to its feature.
+var sessionEvents = map[auditlog.EventCode]struct {
+	feature Feature
+	opened  bool
+}{
+	auditlog.EventIDERSessionOpened: {IDER, true},
+	auditlog.EventIDERSessionClosed: {IDER, false},
+	auditlog.EventSoLSessionOpened:  {SOL, true},
+	auditlog.EventSoLSessionClosed:  {SOL, false},
+	auditlog.EventKVMSessionStarted: {KVM, true},
+	auditlog.EventKVMSessionEnded:   {KVM, false},
+}
+
+// Sessions returns the enabled console features, the open sessions and the decoded redirection access log. The
+// protocol and user of a session are only recorded in the audit log, so SOLActive, IDERActive and Active depend on
+// the Redirection Manager events being enabled in the audit policy; without them they report no sessions.
+func (m Manager) Sessions() (sessions Sessions, err error) {
+	service, err := m.redirectionState()
+	if err != nil {
+		return sessions, err
+	}
+
+	sessions.State.SOL = service.EnabledState.SOLEnabled()
+	sessions.State.IDER = service.EnabledState.IDEREnabled()
+	sessions.State.RedirectionListener = service.ListenerEnabled
+
+	kvmResponse, err := m.kvm.Get()
+	if err != nil {
+		return sessions, err
+	}
+
+	sessions.State.KVM = kvmResponse.Body.GetResponse.EnabledState.IsEnabled()
+	sessions.KVMActive = kvmResponse.Body.GetResponse.EnabledState.SessionActive()
+
+	if sessions.State.WebUI, err = m.webUIState(); err != nil {
+		return sessions, err
+	}
+
+	if sessions.Active, err = m.activeSessions(); err != nil {
+		return sessions, err
+	}
+
+	for _, session := range sessions.Active {
+		switch session.Feature {
+		case SOL:
+			sessions.SOLActive = true
+		case IDER:
+			sessions.IDERActive = true
+		}
+	}
+
+	sessions.AccessLog, err = service.AccessLogEntries()
+
+	return sessions, err
+}
+
+// activeSessions replays the Redirection Manager session events of the audit log and returns the sessions left open.
+// Records that cannot be decoded are skipped.
+func (m Manager) activeSessions() ([]ActiveSession, error) {
+	records, err := auditlog.ReadAllRecords(m.auditLog, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	open := map[Feature]ActiveSession{}
+
+	for _, record := range records {
+		event, err := auditlog.DecodeEvent(record)
+		if err != nil {
+			continue
+		}
+
+		session, ok := sessionEvents[event.Code()]
+		if !ok {
+			continue
+		}
+
+		if !session.opened {
+			delete(open, session.feature)
+
+			continue
+		}
+
+		user := event.Initiator.User
+		if event.Initiator.Type == auditlog.InitiatorTypeKerberos {
+			user = event.Initiator.SID
+		}
+
+		open[session.feature] = ActiveSession{
+			Feature: session.feature,
+			User:    user,
+			Address: event.NetAddress,
+			Started: event.Time,
+		}
+	}
+
+	var active []ActiveSession
+
+	for _, feature := range []Feature{SOL, IDER, KVM} {
+		if session, ok := open[feature]; ok {
+			active = append(active, session)
+		}
+	}
+
+	return active, nil
+}
+
+// TerminateSession closes the active SOL or IDER session.
+func (m Manager) TerminateSession(feature Feature) error {
+	var sessionType redirection.SessionType
+
+	switch feature {
+	case SOL:
+		sessionType = redirection.SessionTypeSOL
+	case IDER:
+		sessionType = redirection.SessionTypeIDER
+	default:
+		return ErrTerminateNotSupported
+	}
+
+	_, err := m.redirection.TerminateSession(sessionType)
+
+	return err
+}
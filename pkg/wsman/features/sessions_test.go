@@ -0,0 +1,96 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package features
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/redirection"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/kvm"
+)
+
+// sessionRecord encodes a Redirection Manager audit log record initiated by an HTTP digest user.
+func sessionRecord(eventID byte, user, address string, timestamp uint32) string {
+	record := []byte{0x00, auditlog.RedirectionManager, 0x00, eventID, byte(auditlog.InitiatorTypeHTTPDigest), byte(len(user))}
+	record = append(record, user...)
+	record = binary.BigEndian.AppendUint32(record, timestamp)
+	record = append(record, 0x00, byte(len(address)))
+	record = append(record, address...)
+	record = append(record, 0x00)
+
+	return base64.StdEncoding.EncodeToString(record)
+}
+
+func TestSessions(t *testing.T) {
+	redirectionService, kvmRedirectionSAP, webUIService, auditLog := newFakes()
+	redirectionService.service.AccessLog = []string{"03/14/2024, 09:26:53, 192.168.1.20:16994"}
+	kvmRedirectionSAP.enabledState = kvm.EnabledStateEnabled
+	auditLog.records = []string{
+		sessionRecord(4, "admin", "192.168.1.20", 1710408413),
+		sessionRecord(0, "admin", "192.168.1.21", 1710408500),
+		sessionRecord(1, "admin", "192.168.1.21", 1710408600),
+		"not base64",
+		sessionRecord(8, "operator", "192.168.1.22", 1710408700),
+		sessionRecord(9, "operator", "192.168.1.22", 1710408800),
+		sessionRecord(8, "viewer", "192.168.1.23", 1710408900),
+	}
+	manager := NewManager(redirectionService, kvmRedirectionSAP, webUIService, auditLog)
+
+	sessions, err := manager.Sessions()
+	assert.NoError(t, err)
+	assert.Equal(t, Sessions{
+		State:     State{SOL: true, KVM: true, WebUI: true},
+		KVMActive: true,
+		SOLActive: true,
+		Active: []ActiveSession{
+			{Feature: SOL, User: "admin", Address: "192.168.1.20", Started: time.Unix(1710408413, 0).UTC()},
+			{Feature: KVM, User: "viewer", Address: "192.168.1.23", Started: time.Unix(1710408900, 0).UTC()},
+		},
+		AccessLog: []redirection.AccessLogEntry{{
+			Time:    time.Date(2024, time.March, 14, 9, 26, 53, 0, time.UTC),
+			Address: "192.168.1.20",
+			Port:    16994,
+		}},
+	}, sessions)
+
+	auditLog.err = errGet
+
+	_, err = manager.Sessions()
+	assert.ErrorIs(t, err, errGet)
+
+	auditLog.err = nil
+	redirectionService.service.AccessLog = []string{"invalid"}
+
+	_, err = manager.Sessions()
+	assert.ErrorIs(t, err, redirection.ErrInvalidAccessLogEntry)
+}
+
+func TestSessionsWithoutAuditEvents(t *testing.T) {
+	redirectionService, kvmRedirectionSAP, webUIService, auditLog := newFakes()
+	manager := NewManager(redirectionService, kvmRedirectionSAP, webUIService, auditLog)
+
+	sessions, err := manager.Sessions()
+	assert.NoError(t, err)
+	assert.False(t, sessions.SOLActive)
+	assert.False(t, sessions.IDERActive)
+	assert.Nil(t, sessions.Active)
+}
+
+func TestTerminateSession(t *testing.T) {
+	redirectionService, kvmRedirectionSAP, webUIService, auditLog := newFakes()
+	manager := NewManager(redirectionService, kvmRedirectionSAP, webUIService, auditLog)
+
+	assert.NoError(t, manager.TerminateSession(SOL))
+	assert.NoError(t, manager.TerminateSession(IDER))
+	assert.ErrorIs(t, manager.TerminateSession(KVM), ErrTerminateNotSupported)
+	assert.Equal(t, []redirection.SessionType{redirection.SessionTypeSOL, redirection.SessionTypeIDER}, redirectionService.terminated)
+}
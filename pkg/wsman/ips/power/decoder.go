@@ -0,0 +1,74 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package power
+
+const (
+	IPSPowerManagementService       string = "IPS_PowerManagementService"
+	RequestOSPowerSavingStateChange string = "RequestOSPowerSavingStateChange"
+	ValueNotFound                   string = "Value not found in map"
+)
+
+const (
+	OSPowerSavingStateUnknown       OSPowerSavingState = 0
+	OSPowerSavingStateUnsupported   OSPowerSavingState = 1
+	OSPowerSavingStateFullPower     OSPowerSavingState = 2
+	OSPowerSavingStateOSPowerSaving OSPowerSavingState = 3
+)
+
+// osPowerSavingStateToString is a map of OSPowerSavingState value to string.
+var osPowerSavingStateToString = map[OSPowerSavingState]string{
+	OSPowerSavingStateUnknown:       "Unknown",
+	OSPowerSavingStateUnsupported:   "Unsupported",
+	OSPowerSavingStateFullPower:     "FullPower",
+	OSPowerSavingStateOSPowerSaving: "OSPowerSaving",
+}
+
+// String returns a human-readable string representation of the OSPowerSavingState enumeration.
+func (o OSPowerSavingState) String() string {
+	if s, ok := osPowerSavingStateToString[o]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
+
+const (
+	ReturnValueCompletedWithNoError              ReturnValue = 0
+	ReturnValueNotSupported                      ReturnValue = 1
+	ReturnValueUnknownError                      ReturnValue = 2
+	ReturnValueCannotCompleteWithinTimeoutPeriod ReturnValue = 3
+	ReturnValueFailed                            ReturnValue = 4
+	ReturnValueInvalidParameter                  ReturnValue = 5
+	ReturnValueInUse                             ReturnValue = 6
+	ReturnValueMethodParametersCheckedJobStarted ReturnValue = 4096
+	ReturnValueInvalidStateTransition            ReturnValue = 4097
+	ReturnValueUseOfTimeoutParameterNotSupported ReturnValue = 4098
+	ReturnValueBusy                              ReturnValue = 4099
+)
+
+// returnValueToString is a map of ReturnValue value to string.
+var returnValueToString = map[ReturnValue]string{
+	ReturnValueCompletedWithNoError:              "CompletedWithNoError",
+	ReturnValueNotSupported:                      "NotSupported",
+	ReturnValueUnknownError:                      "UnknownError",
+	ReturnValueCannotCompleteWithinTimeoutPeriod: "CannotCompleteWithinTimeoutPeriod",
+	ReturnValueFailed:                            "Failed",
+	ReturnValueInvalidParameter:                  "InvalidParameter",
+	ReturnValueInUse:                             "InUse",
+	ReturnValueMethodParametersCheckedJobStarted: "MethodParametersCheckedJobStarted",
+	ReturnValueInvalidStateTransition:            "InvalidStateTransition",
+	ReturnValueUseOfTimeoutParameterNotSupported: "UseOfTimeoutParameterNotSupported",
+	ReturnValueBusy:                              "Busy",
+}
+
+// String returns a human-readable string representation of the ReturnValue enumeration.
+func (r ReturnValue) String() string {
+	if s, ok := returnValueToString[r]; ok {
+		return s
+	}
+
+	return ValueNotFound
+}
@@ -0,0 +1,49 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package power
+
+import "testing"
+
+func TestOSPowerSavingState_String(t *testing.T) {
+	tests := []struct {
+		state    OSPowerSavingState
+		expected string
+	}{
+		{OSPowerSavingStateUnknown, "Unknown"},
+		{OSPowerSavingStateUnsupported, "Unsupported"},
+		{OSPowerSavingStateFullPower, "FullPower"},
+		{OSPowerSavingStateOSPowerSaving, "OSPowerSaving"},
+		{OSPowerSavingState(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
+
+func TestReturnValue_String(t *testing.T) {
+	tests := []struct {
+		state    ReturnValue
+		expected string
+	}{
+		{ReturnValueCompletedWithNoError, "CompletedWithNoError"},
+		{ReturnValueNotSupported, "NotSupported"},
+		{ReturnValueInvalidParameter, "InvalidParameter"},
+		{ReturnValueInvalidStateTransition, "InvalidStateTransition"},
+		{ReturnValueBusy, "Busy"},
+		{ReturnValue(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.state.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package power facilitates communication with Intel® AMT devices to control the power saving state of the operating system.
+//
+// Unlike the hardware power transitions of CIM_PowerManagementService, RequestOSPowerSavingStateChange asks the
+// operating system to bring the display back to full power, so that a KVM session does not start on a blank screen.
+package power
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/methods"
+)
+
+// NewPowerManagementServiceWithClient returns a new instance of the PowerManagementService struct.
+func NewPowerManagementServiceWithClient(wsmanMessageCreator *message.WSManMessageCreator, client client.WSMan) ManagementService {
+	return ManagementService{
+		base: message.NewBaseWithClient(wsmanMessageCreator, IPSPowerManagementService, client),
+	}
+}
+
+// Get retrieves the representation of the instance, including the current OSPowerSavingState.
+func (managementService ManagementService) Get() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: managementService.base.Get(nil),
+		},
+	}
+
+	err = managementService.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, err
+}
+
+// Enumerate returns an enumeration context which is used in a subsequent Pull call.
+func (managementService ManagementService) Enumerate() (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: managementService.base.Enumerate(),
+		},
+	}
+
+	err = managementService.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, err
+}
+
+// Pull returns the instances of this class.  An enumeration context provided by the Enumerate call is used as input.
+func (managementService ManagementService) Pull(enumerationContext string) (response Response, err error) {
+	response = Response{
+		Message: &client.Message{
+			XMLInput: managementService.base.Pull(enumerationContext),
+		},
+	}
+
+	err = managementService.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, err
+}
+
+// RequestOSPowerSavingStateChange asks the operating system of the managed system to move to the given power saving state.
+// Only OSPowerSavingStateFullPower and OSPowerSavingStateOSPowerSaving can be requested.
+func (managementService ManagementService) RequestOSPowerSavingStateChange(osPowerSavingState OSPowerSavingState) (response Response, err error) {
+	header := managementService.base.WSManMessageCreator.CreateHeader(methods.GenerateAction(IPSPowerManagementService, RequestOSPowerSavingStateChange), IPSPowerManagementService, nil, "", "")
+	body := fmt.Sprintf(`<Body><h:RequestOSPowerSavingStateChange_INPUT xmlns:h="http://intel.com/wbem/wscim/1/ips-schema/1/IPS_PowerManagementService"><h:OSPowerSavingState>%d</h:OSPowerSavingState><h:ManagedElement><Address xmlns="http://schemas.xmlsoap.org/ws/2004/08/addressing">http://schemas.xmlsoap.org/ws/2004/08/addressing</Address><ReferenceParameters xmlns="http://schemas.xmlsoap.org/ws/2004/08/addressing"><ResourceURI xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd">http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ComputerSystem</ResourceURI><SelectorSet xmlns="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd"><Selector Name="CreationClassName">CIM_ComputerSystem</Selector><Selector Name="Name">ManagedSystem</Selector></SelectorSet></ReferenceParameters></h:ManagedElement></h:RequestOSPowerSavingStateChange_INPUT></Body>`, osPowerSavingState)
+	response = Response{
+		Message: &client.Message{
+			XMLInput: managementService.base.WSManMessageCreator.CreateXML(header, body),
+		},
+	}
+
+	err = managementService.base.Execute(response.Message)
+	if err != nil {
+		return response, err
+	}
+
+	err = xml.Unmarshal([]byte(response.XMLOutput), &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, err
+}
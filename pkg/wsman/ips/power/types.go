@@ -0,0 +1,64 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package power
+
+import (
+	"encoding/xml"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/internal/message"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/client"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/common"
+)
+
+type ManagementService struct {
+	base message.Base
+}
+
+// OUTPUT
+// Response Types.
+type (
+	Response struct {
+		*client.Message
+		XMLName xml.Name       `xml:"Envelope"`
+		Header  message.Header `xml:"Header"`
+		Body    Body           `xml:"Body"`
+	}
+
+	Body struct {
+		XMLName                                 xml.Name               `xml:"Body"`
+		GetResponse                             PowerManagementService `xml:"IPS_PowerManagementService"`
+		EnumerateResponse                       common.EnumerateResponse
+		PullResponse                            PullResponse        `xml:"PullResponse"`
+		RequestOSPowerSavingStateChangeResponse PowerActionResponse `xml:"RequestOSPowerSavingStateChange_OUTPUT"`
+	}
+
+	PullResponse struct {
+		XMLName                     xml.Name                 `xml:"PullResponse"`
+		PowerManagementServiceItems []PowerManagementService `xml:"Items>IPS_PowerManagementService"`
+	}
+
+	PowerManagementService struct {
+		XMLName                 xml.Name           `xml:"IPS_PowerManagementService"`
+		CreationClassName       string             `xml:"CreationClassName,omitempty"`       // CreationClassName indicates the name of the class or the subclass that is used in the creation of an instance.
+		ElementName             string             `xml:"ElementName,omitempty"`             // A user-friendly name for the object.
+		EnabledState            int                `xml:"EnabledState,omitempty"`            // EnabledState is an integer enumeration that indicates the enabled and disabled states of an element.
+		Name                    string             `xml:"Name,omitempty"`                    // The Name property uniquely identifies the Service and provides an indication of the functionality that is managed.
+		OSPowerSavingState      OSPowerSavingState `xml:"OSPowerSavingState"`                // The current power saving state of the operating system, which powers the display down in OSPowerSaving.
+		RequestedState          int                `xml:"RequestedState,omitempty"`          // RequestedState is an integer enumeration that indicates the last requested or desired state for the element.
+		SystemCreationClassName string             `xml:"SystemCreationClassName,omitempty"` // The CreationClassName of the scoping System.
+		SystemName              string             `xml:"SystemName,omitempty"`              // The Name of the scoping System.
+	}
+
+	PowerActionResponse struct {
+		XMLName     xml.Name    `xml:"RequestOSPowerSavingStateChange_OUTPUT"`
+		ReturnValue ReturnValue `xml:"ReturnValue"`
+	}
+
+	// OSPowerSavingState is the power saving state of the operating system.
+	OSPowerSavingState int
+	// ReturnValue is an integer enumeration that indicates the success or failure of an operation.
+	ReturnValue int
+)
@@ -0,0 +1,269 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package logfollower polls the Intel® AMT audit log and event log and emits each new record once.
+package logfollower
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
+)
+
+// DefaultInterval is the poll interval used by Run when Interval is not set.
+const DefaultInterval = 30 * time.Second
+
+// AuditLog is the subset of auditlog.Service used by the Follower.
+type AuditLog interface {
+	Get() (auditlog.Response, error)
+	ReadRecords(startIndex int) (auditlog.Response, error)
+}
+
+// MessageLog is the subset of messagelog.Service used by the Follower.
+type MessageLog interface {
+	PositionToFirstRecord() (messagelog.Response, error)
+	GetRecords(identifier int) (messagelog.Response, error)
+}
+
+// Record is a new log record. Exactly one of Audit and Event is set, except for audit records that could not be decoded.
+type Record struct {
+	Raw   string                       // Base64 encoded record as returned by Intel® AMT.
+	Audit *auditlog.Event              // Decoded audit log record.
+	Event *messagelog.RefinedEventData // Decoded event log record.
+}
+
+// Follower reads the records added to the audit log and the event log since the last poll.
+type Follower struct {
+	auditLog   AuditLog
+	messageLog MessageLog
+	store      Store
+	// Interval is the time between polls in Run.
+	Interval time.Duration
+}
+
+// New creates a follower for the logs, either of which may be nil to not follow it. The checkpoint is loaded from and
+// saved to store.
+func New(auditLog AuditLog, messageLog MessageLog, store Store) *Follower {
+	return &Follower{
+		auditLog:   auditLog,
+		messageLog: messageLog,
+		store:      store,
+		Interval:   DefaultInterval,
+	}
+}
+
+// NewWithMessages creates a follower for the audit log and the event log of a wsman.Messages.
+func NewWithMessages(messages wsman.Messages, store Store) *Follower {
+	return New(messages.AMT.AuditLog, messages.AMT.MessageLog, store)
+}
+
+// Poll returns the records added since the last poll, audit records first, and saves the checkpoint.
+func (f *Follower) Poll() ([]Record, error) {
+	records, checkpoint, err := f.poll()
+	if err != nil {
+		return nil, err
+	}
+
+	return records, f.store.Save(checkpoint)
+}
+
+// Run polls the logs every Interval and sends the new records on out, until ctx is done or a poll fails. The checkpoint
+// is saved after the records of a poll were sent, so records are emitted again only when Run stops while sending them.
+func (f *Follower) Run(ctx context.Context, out chan<- Record) error {
+	interval := f.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		records, checkpoint, err := f.poll()
+		if err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			select {
+			case out <- record:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		if err = f.store.Save(checkpoint); err != nil {
+			return err
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *Follower) poll() (records []Record, checkpoint Checkpoint, err error) {
+	checkpoint, err = f.store.Load()
+	if err != nil {
+		return nil, checkpoint, err
+	}
+
+	if f.auditLog != nil {
+		var auditRecords []Record
+
+		if auditRecords, err = f.pollAuditLog(&checkpoint); err != nil {
+			return nil, checkpoint, err
+		}
+
+		records = append(records, auditRecords...)
+	}
+
+	if f.messageLog != nil {
+		var eventRecords []Record
+
+		if eventRecords, err = f.pollMessageLog(&checkpoint); err != nil {
+			return nil, checkpoint, err
+		}
+
+		records = append(records, eventRecords...)
+	}
+
+	return records, checkpoint, nil
+}
+
+// pollAuditLog reads the records after the checkpoint. The last emitted record is read again to check that the log did
+// not wrap or was cleared; when it moved, it is searched in a wrapping log and the whole log is read otherwise.
+func (f *Follower) pollAuditLog(checkpoint *Checkpoint) ([]Record, error) {
+	var newRecords []string
+
+	if checkpoint.AuditIndex > 0 {
+		records, err := auditlog.ReadAllRecords(f.auditLog, checkpoint.AuditIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(records) > 0 && records[0] == checkpoint.AuditRecord {
+			newRecords = records[1:]
+			checkpoint.AuditIndex += len(newRecords)
+		} else if newRecords, err = f.resyncAuditLog(checkpoint); err != nil {
+			return nil, err
+		}
+	} else {
+		records, err := auditlog.ReadAllRecords(f.auditLog, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		newRecords = records
+		checkpoint.AuditIndex = len(records)
+	}
+
+	if len(newRecords) > 0 {
+		checkpoint.AuditRecord = newRecords[len(newRecords)-1]
+	}
+
+	records := make([]Record, len(newRecords))
+
+	for i, raw := range newRecords {
+		records[i].Raw = raw
+
+		if event, err := auditlog.DecodeEvent(raw); err == nil {
+			records[i].Audit = &event
+		}
+	}
+
+	return records, nil
+}
+
+// resyncAuditLog reads the whole log after the last emitted record moved. In a log that wraps when full the record moved
+// towards the start, in any other log it was removed by clearing the log.
+func (f *Follower) resyncAuditLog(checkpoint *Checkpoint) ([]string, error) {
+	response, err := f.auditLog.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := auditlog.ReadAllRecords(f.auditLog, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint.AuditIndex = len(records)
+
+	policy := response.Body.GetResponse.OverwritePolicy
+	if policy == auditlog.OverwritePolicyWrapsWhenFull || policy == auditlog.OverwritePolicyPartialRestrictedRollover {
+		for i := len(records) - 1; i >= 0; i-- {
+			if records[i] == checkpoint.AuditRecord {
+				return records[i+1:], nil
+			}
+		}
+	}
+
+	return records, nil
+}
+
+// pollMessageLog reads the whole event log, which holds at most a few hundred records, and returns the records that
+// were not in the log at the last poll, oldest first.
+func (f *Follower) pollMessageLog(checkpoint *Checkpoint) ([]Record, error) {
+	current, err := f.readMessageLog()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]int{}
+	for _, raw := range checkpoint.EventRecords {
+		seen[raw]++
+	}
+
+	records := []Record{}
+	checkpoint.EventRecords = make([]string, 0, len(current))
+
+	for _, record := range current {
+		checkpoint.EventRecords = append(checkpoint.EventRecords, record.Raw)
+
+		if seen[record.Raw] > 0 {
+			seen[record.Raw]--
+
+			continue
+		}
+
+		records = append(records, record)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].eventTime().Before(records[j].eventTime())
+	})
+
+	return records, nil
+}
+
+func (r Record) eventTime() time.Time {
+	if r.Event == nil {
+		return time.Time{}
+	}
+
+	return r.Event.TimeStamp
+}
+
+func (f *Follower) readMessageLog() (records []Record, err error) {
+	iterator := messagelog.NewRecordIterator(f.messageLog)
+
+	for iterator.Next() {
+		event := iterator.Record()
+		records = append(records, Record{Raw: iterator.Raw(), Event: &event})
+	}
+
+	if err = iterator.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
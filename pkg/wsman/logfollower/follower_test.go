@@ -0,0 +1,305 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package logfollower
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/messagelog"
+)
+
+var errRead = errors.New("read failed")
+
+// auditRecord returns an AMT Provisioning Started record by a local initiator at the given Unix time.
+func auditRecord(timestamp byte) string {
+	return base64.StdEncoding.EncodeToString([]byte{0x00, 0x10, 0x00, 0x00, 0x02, 0x65, 0x94, 0xAD, timestamp, 0x00, 0x00, 0x00})
+}
+
+func auditRecords(timestamps ...byte) []string {
+	records := make([]string, len(timestamps))
+	for i, timestamp := range timestamps {
+		records[i] = auditRecord(timestamp)
+	}
+
+	return records
+}
+
+type fakeAuditLog struct {
+	records []string
+	policy  auditlog.OverwritePolicy
+	err     error
+	reads   []int
+}
+
+func (f *fakeAuditLog) Get() (response auditlog.Response, err error) {
+	response.Body.GetResponse.OverwritePolicy = f.policy
+
+	return response, f.err
+}
+
+func (f *fakeAuditLog) ReadRecords(startIndex int) (response auditlog.Response, err error) {
+	f.reads = append(f.reads, startIndex)
+	output := &response.Body.ReadRecordsResponse
+	output.TotalRecordCount = len(f.records)
+
+	if startIndex > len(f.records) {
+		output.ReturnValue = int(auditlog.ReturnValueInvalidIndex)
+
+		return response, f.err
+	}
+
+	end := startIndex + 9
+	if end > len(f.records) {
+		end = len(f.records)
+	}
+
+	output.EventRecords = f.records[startIndex-1 : end]
+	output.RecordsReturned = len(output.EventRecords)
+
+	return response, f.err
+}
+
+type fakeMessageLog struct {
+	records  []messagelog.RefinedEventData
+	pageSize int
+	err      error
+}
+
+// eventRecord returns an event log record; its raw form only needs to be unique per record.
+func eventRecord(timestamp int64, description string) messagelog.RefinedEventData {
+	return messagelog.RefinedEventData{TimeStamp: time.Unix(timestamp, 0), Description: description}
+}
+
+func rawEventRecord(record messagelog.RefinedEventData) string {
+	return base64.StdEncoding.EncodeToString([]byte(record.TimeStamp.String() + record.Description))
+}
+
+func (f *fakeMessageLog) PositionToFirstRecord() (response messagelog.Response, err error) {
+	response.Body.PositionToFirstRecordResponse.IterationIdentifier = 1
+
+	if len(f.records) == 0 {
+		response.Body.PositionToFirstRecordResponse.ReturnValue = messagelog.PositionToFirstRecordReturnValueNoRecordExists
+	}
+
+	return response, f.err
+}
+
+func (f *fakeMessageLog) GetRecords(identifier int) (response messagelog.Response, err error) {
+	output := &response.Body.GetRecordsResponse
+
+	end := identifier - 1 + f.pageSize
+	if end >= len(f.records) {
+		end = len(f.records)
+		output.NoMoreRecords = true
+	}
+
+	for _, record := range f.records[identifier-1 : end] {
+		output.RecordArray = append(output.RecordArray, rawEventRecord(record))
+		output.RefinedEventData = append(output.RefinedEventData, record)
+	}
+
+	output.IterationIdentifier = end + 1
+
+	return response, nil
+}
+
+func auditTimes(records []Record) []byte {
+	times := []byte{}
+
+	for _, record := range records {
+		times = append(times, byte(record.Audit.Time.Unix()))
+	}
+
+	return times
+}
+
+func eventDescriptions(records []Record) []string {
+	descriptions := []string{}
+
+	for _, record := range records {
+		descriptions = append(descriptions, record.Event.Description)
+	}
+
+	return descriptions
+}
+
+func TestFollowerAuditLog(t *testing.T) {
+	t.Run("should emit new records once", func(t *testing.T) {
+		log := &fakeAuditLog{records: auditRecords(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12)}
+		follower := New(log, nil, &MemoryStore{})
+
+		records, err := follower.Poll()
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C}, auditTimes(records))
+		assert.Equal(t, auditlog.EventAMTProvisioningStarted, records[0].Audit.Code())
+		assert.Equal(t, log.records[0], records[0].Raw)
+
+		records, err = follower.Poll()
+		assert.NoError(t, err)
+		assert.Empty(t, records)
+
+		log.records = append(log.records, auditRecords(13, 14)...)
+		log.reads = nil
+
+		records, err = follower.Poll()
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{13, 14}, auditTimes(records))
+		assert.Equal(t, []int{12}, log.reads)
+	})
+
+	t.Run("should find the last record in a wrapped log", func(t *testing.T) {
+		log := &fakeAuditLog{records: auditRecords(1, 2, 3, 4, 5), policy: auditlog.OverwritePolicyWrapsWhenFull}
+		follower := New(log, nil, &MemoryStore{})
+
+		_, err := follower.Poll()
+		assert.NoError(t, err)
+
+		log.records = auditRecords(3, 4, 5, 6, 7)
+
+		records, err := follower.Poll()
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{6, 7}, auditTimes(records))
+
+		log.records = auditRecords(4, 5, 6, 7, 8)
+
+		records, err = follower.Poll()
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{8}, auditTimes(records))
+	})
+
+	t.Run("should read a cleared log from the start", func(t *testing.T) {
+		log := &fakeAuditLog{records: auditRecords(1, 2, 3), policy: auditlog.OverwritePolicyNeverOverwrites}
+		follower := New(log, nil, &MemoryStore{})
+
+		_, err := follower.Poll()
+		assert.NoError(t, err)
+
+		log.records = auditRecords(4)
+
+		records, err := follower.Poll()
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{4}, auditTimes(records))
+
+		log.records = nil
+
+		records, err = follower.Poll()
+		assert.NoError(t, err)
+		assert.Empty(t, records)
+	})
+
+	t.Run("should keep records that cannot be decoded", func(t *testing.T) {
+		log := &fakeAuditLog{records: []string{"AA=="}}
+
+		records, err := New(log, nil, &MemoryStore{}).Poll()
+		assert.NoError(t, err)
+		assert.Equal(t, []Record{{Raw: "AA=="}}, records)
+	})
+
+	t.Run("should return read errors", func(t *testing.T) {
+		log := &fakeAuditLog{records: auditRecords(1), err: errRead}
+
+		_, err := New(log, nil, &MemoryStore{}).Poll()
+		assert.ErrorIs(t, err, errRead)
+	})
+}
+
+func TestFollowerMessageLog(t *testing.T) {
+	t.Run("should emit new records once, oldest first", func(t *testing.T) {
+		log := &fakeMessageLog{
+			records:  []messagelog.RefinedEventData{eventRecord(3, "c"), eventRecord(2, "b"), eventRecord(1, "a")},
+			pageSize: 2,
+		}
+		follower := New(nil, log, &MemoryStore{})
+
+		records, err := follower.Poll()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, eventDescriptions(records))
+		assert.Equal(t, rawEventRecord(log.records[2]), records[0].Raw)
+
+		log.records = append([]messagelog.RefinedEventData{eventRecord(5, "e"), eventRecord(4, "d")}, log.records[:2]...)
+
+		records, err = follower.Poll()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"d", "e"}, eventDescriptions(records))
+
+		records, err = follower.Poll()
+		assert.NoError(t, err)
+		assert.Empty(t, records)
+	})
+
+	t.Run("should emit repeated identical records", func(t *testing.T) {
+		log := &fakeMessageLog{records: []messagelog.RefinedEventData{eventRecord(1, "a")}, pageSize: 10}
+		follower := New(nil, log, &MemoryStore{})
+
+		_, err := follower.Poll()
+		assert.NoError(t, err)
+
+		log.records = append(log.records, eventRecord(1, "a"))
+
+		records, err := follower.Poll()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a"}, eventDescriptions(records))
+	})
+
+	t.Run("should handle an empty log", func(t *testing.T) {
+		records, err := New(nil, &fakeMessageLog{pageSize: 10}, &MemoryStore{}).Poll()
+		assert.NoError(t, err)
+		assert.Empty(t, records)
+	})
+
+	t.Run("should return read errors", func(t *testing.T) {
+		_, err := New(nil, &fakeMessageLog{err: errRead}, &MemoryStore{}).Poll()
+		assert.ErrorIs(t, err, errRead)
+	})
+}
+
+func TestFollowerRestart(t *testing.T) {
+	store := NewFileStore(t.TempDir() + "/checkpoint.json")
+	audit := &fakeAuditLog{records: auditRecords(1, 2)}
+	events := &fakeMessageLog{records: []messagelog.RefinedEventData{eventRecord(1, "a")}, pageSize: 10}
+
+	records, err := New(audit, events, store).Poll()
+	assert.NoError(t, err)
+	assert.Len(t, records, 3)
+
+	audit.records = append(audit.records, auditRecord(3))
+
+	records, err = New(audit, events, store).Poll()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{3}, auditTimes(records))
+}
+
+func TestFollowerRun(t *testing.T) {
+	store := &MemoryStore{}
+	follower := New(&fakeAuditLog{records: auditRecords(1, 2)}, nil, store)
+	follower.Interval = time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	out := make(chan Record)
+	done := make(chan error)
+
+	go func() {
+		done <- follower.Run(ctx, out)
+	}()
+
+	assert.Equal(t, auditRecord(1), (<-out).Raw)
+	assert.Equal(t, auditRecord(2), (<-out).Raw)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+
+	checkpoint, err := store.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, checkpoint.AuditIndex)
+	assert.Equal(t, auditRecord(2), checkpoint.AuditRecord)
+}
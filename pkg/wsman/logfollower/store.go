@@ -0,0 +1,104 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package logfollower
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Checkpoint is the position of a Follower in the logs of a device.
+type Checkpoint struct {
+	AuditIndex   int      `json:"AuditIndex"`   // Index of the last emitted audit log record, 0 when none was emitted.
+	AuditRecord  string   `json:"AuditRecord"`  // Last emitted audit log record, used to detect a wrapped or cleared log.
+	EventRecords []string `json:"EventRecords"` // Event log records present at the last poll.
+}
+
+// Store persists the checkpoint of a Follower so it does not emit records again after a restart.
+type Store interface {
+	Load() (Checkpoint, error)
+	Save(checkpoint Checkpoint) error
+}
+
+// MemoryStore keeps the checkpoint in memory.
+type MemoryStore struct {
+	lock       sync.Mutex
+	checkpoint Checkpoint
+}
+
+// Load implements Store.
+func (s *MemoryStore) Load() (Checkpoint, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.checkpoint, nil
+}
+
+// Save implements Store.
+func (s *MemoryStore) Save(checkpoint Checkpoint) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.checkpoint = checkpoint
+
+	return nil
+}
+
+// FileStore keeps the checkpoint in a JSON file.
+type FileStore struct {
+	Path string
+}
+
+// NewFileStore returns a store that writes the checkpoint to path.
+func NewFileStore(path string) FileStore {
+	return FileStore{Path: path}
+}
+
+// Load implements Store. A missing file is an empty checkpoint.
+func (s FileStore) Load() (checkpoint Checkpoint, err error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return checkpoint, nil
+	}
+
+	if err != nil {
+		return checkpoint, err
+	}
+
+	err = json.Unmarshal(data, &checkpoint)
+
+	return checkpoint, err
+}
+
+// Save implements Store. The file is replaced atomically so a crash does not leave a partial checkpoint.
+func (s FileStore) Save(checkpoint Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(file.Name())
+
+	if _, err = file.Write(data); err != nil {
+		file.Close()
+
+		return err
+	}
+
+	if err = file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), s.Path)
+}
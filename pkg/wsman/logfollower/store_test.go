@@ -0,0 +1,50 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package logfollower
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore(t *testing.T) {
+	dir := t.TempDir()
+	store := NewFileStore(filepath.Join(dir, "checkpoint.json"))
+
+	checkpoint, err := store.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, Checkpoint{}, checkpoint)
+
+	expected := Checkpoint{AuditIndex: 3, AuditRecord: "AA==", EventRecords: []string{"AQ==", "Ag=="}}
+	assert.NoError(t, store.Save(expected))
+
+	checkpoint, err = store.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, checkpoint)
+
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	assert.NoError(t, os.WriteFile(store.Path, []byte("{"), 0o600))
+
+	_, err = store.Load()
+	assert.Error(t, err)
+}
+
+func TestMemoryStore(t *testing.T) {
+	store := &MemoryStore{}
+	expected := Checkpoint{AuditIndex: 1}
+
+	assert.NoError(t, store.Save(expected))
+
+	checkpoint, err := store.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, checkpoint)
+}
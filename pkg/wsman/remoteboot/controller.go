@@ -0,0 +1,392 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+// Package remoteboot changes the next boot of an Intel® AMT device and restarts the device into it.
+package remoteboot
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman"
+	amtboot "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
+	cimboot "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/boot"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/computer"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/optin"
+)
+
+const (
+	// BootConfigSettingInstanceID is the InstanceID of the CIM_BootConfigSetting used for the next boot.
+	BootConfigSettingInstanceID = "Intel(r) AMT: Boot Configuration 0"
+	// roleIsNextSingleUse is the SetBootConfigRole role that uses the boot configuration for the next boot only.
+	roleIsNextSingleUse = 1
+)
+
+var (
+	// ErrTargetNotSupported is returned when AMT_BootCapabilities does not list the boot target.
+	ErrTargetNotSupported = errors.New("boot target not supported")
+	// ErrSOLNotSupported is returned when Serial over LAN is requested but AMT_BootCapabilities does not list it.
+	ErrSOLNotSupported = errors.New("serial over LAN not supported")
+	// ErrConflictingOptions is returned when the boot options cannot be set together.
+	ErrConflictingOptions = errors.New("conflicting boot options")
+	// ErrConsentRequired is returned when the user consent policy requires a consent that has not been given.
+	ErrConsentRequired = errors.New("user consent required")
+)
+
+// Step is a step of a boot change.
+type Step string
+
+const (
+	StepCapabilities   Step = "Capabilities"
+	StepConsent        Step = "Consent"
+	StepBootSettings   Step = "BootSettings"
+	StepBootConfigRole Step = "BootConfigRole"
+	StepBootOrder      Step = "BootOrder"
+	StepPowerAction    Step = "PowerAction"
+	StepReset          Step = "Reset"
+)
+
+// StepError is returned when a step of a boot change fails.
+type StepError struct {
+	Step Step
+	Err  error
+}
+
+func (e *StepError) Error() string {
+	return fmt.Sprintf("remote boot %s: %v", e.Step, e.Err)
+}
+
+func (e *StepError) Unwrap() error {
+	return e.Err
+}
+
+// Target is the device that the managed system boots from.
+type Target int
+
+const (
+	PXE Target = iota
+	CD
+	IDERCD
+	IDERFloppy
+	BIOSSetup
+	HDD
+)
+
+// targetToString is a map of the Target enumeration.
+var targetToString = map[Target]string{
+	PXE:        "PXE",
+	CD:         "CD",
+	IDERCD:     "IDERCD",
+	IDERFloppy: "IDERFloppy",
+	BIOSSetup:  "BIOSSetup",
+	HDD:        "HDD",
+}
+
+// String returns a human-readable string representation of the Target enumeration.
+func (t Target) String() string {
+	if s, ok := targetToString[t]; ok {
+		return s
+	}
+
+	return amtboot.ValueNotFound
+}
+
+// bootSources are the CIM_BootSourceSetting of the targets that are set with ChangeBootOrder.
+var bootSources = map[Target]cimboot.Source{
+	PXE: cimboot.PXE,
+	CD:  cimboot.CD,
+	HDD: cimboot.HardDrive,
+}
+
+// BootCapabilities is the subset of amt/boot.Capabilities used by the BootController.
+type BootCapabilities interface {
+	Get() (amtboot.Response, error)
+}
+
+// BootSettingData is the subset of amt/boot.SettingData used by the BootController.
+type BootSettingData interface {
+	Get() (amtboot.Response, error)
+	Put(bootSettingData amtboot.BootSettingDataRequest) (amtboot.Response, error)
+}
+
+// BootConfigSetting is the subset of cim/boot.ConfigSetting used by the BootController.
+type BootConfigSetting interface {
+	ChangeBootOrder(source cimboot.Source) (cimboot.Response, error)
+}
+
+// BootService is the subset of cim/boot.Service used by the BootController.
+type BootService interface {
+	Get() (cimboot.Response, error)
+	SetBootConfigRole(instanceID string, role int) (cimboot.Response, error)
+}
+
+// OptInService is the subset of optin.Service used by the BootController.
+type OptInService interface {
+	Get() (optin.Response, error)
+}
+
+// PowerController is the subset of power.PowerController used by the BootController.
+type PowerController interface {
+	PowerState() (power.PowerState, error)
+	RequestPowerStateChange(powerState power.PowerState, timeout time.Duration) error
+}
+
+// SystemPackage is the subset of cim/computer.SystemPackage used by the BootController.
+type SystemPackage interface {
+	Get() (computer.Response, error)
+}
+
+// BootController sets the boot options of the next boot and restarts the managed system, in the order required by
+// Intel® AMT.
+type BootController struct {
+	capabilities  BootCapabilities
+	settingData   BootSettingData
+	configSetting BootConfigSetting
+	service       BootService
+	optIn         OptInService
+	power         PowerController
+	systemPackage SystemPackage
+}
+
+// NewBootController creates a BootController on top of the given services.
+func NewBootController(capabilities BootCapabilities, settingData BootSettingData, configSetting BootConfigSetting, service BootService, optIn OptInService, powerController PowerController, systemPackage SystemPackage) BootController {
+	return BootController{
+		capabilities:  capabilities,
+		settingData:   settingData,
+		configSetting: configSetting,
+		service:       service,
+		optIn:         optIn,
+		power:         powerController,
+		systemPackage: systemPackage,
+	}
+}
+
+// NewBootControllerWithMessages creates a BootController using the services of a wsman.Messages.
+func NewBootControllerWithMessages(messages wsman.Messages) BootController {
+	powerController := power.PowerController{
+		ManagementService:           messages.CIM.PowerManagementService,
+		AssociatedManagementService: messages.CIM.AssociatedPowerManagementService,
+		ManagementCapabilities:      messages.CIM.PowerManagementCapabilities,
+	}
+
+	return NewBootController(messages.AMT.BootCapabilities, messages.AMT.BootSettingData, messages.CIM.BootConfigSetting, messages.CIM.BootService, messages.IPS.OptInService, powerController, messages.CIM.ComputerSystemPackage)
+}
+
+// BootOnceTo boots the managed system once from target, resetting it when it is on and powering it on otherwise. With
+// withSOL, the boot is redirected to Serial over LAN; Intel® AMT does not allow it with the boot sources set by
+// ChangeBootOrder (PXE, CD and HDD).
+//
+// The boot options are validated against AMT_BootCapabilities and the user consent policy before anything is changed.
+// When a later step fails, the boot options are reset. The firmware clears the options once it has used them; Reset
+// clears them when the boot did not take place. The error of a failed step is a *StepError.
+func (c BootController) BootOnceTo(target Target, withSOL bool) error {
+	response, err := c.capabilities.Get()
+	if err != nil {
+		return &StepError{Step: StepCapabilities, Err: err}
+	}
+
+	if err = validate(response.Body.BootCapabilitiesGetResponse, target, withSOL); err != nil {
+		return &StepError{Step: StepCapabilities, Err: err}
+	}
+
+	if err = c.checkConsent(); err != nil {
+		return &StepError{Step: StepConsent, Err: err}
+	}
+
+	response, err = c.settingData.Get()
+	if err != nil {
+		return &StepError{Step: StepBootSettings, Err: err}
+	}
+
+	current := response.Body.BootSettingDataGetResponse
+	request := clearedRequest(current)
+	request.UseSOL = withSOL
+
+	switch target {
+	case IDERCD, IDERFloppy:
+		request.UseIDER = true
+		request.IDERBootDevice = amtboot.FloppyBoot
+
+		if target == IDERCD {
+			request.IDERBootDevice = amtboot.CDBoot
+		}
+	case BIOSSetup:
+		request.BIOSSetup = true
+	}
+
+	return c.apply(current, request, bootSources[target], power.MasterBusReset)
+}
+
+// Reset clears the boot options and the boot source set for the next boot.
+func (c BootController) Reset() error {
+	response, err := c.settingData.Get()
+	if err != nil {
+		return &StepError{Step: StepReset, Err: err}
+	}
+
+	if _, err = c.settingData.Put(clearedRequest(response.Body.BootSettingDataGetResponse)); err != nil {
+		return &StepError{Step: StepReset, Err: err}
+	}
+
+	if err = c.changeBootOrder(""); err != nil {
+		return &StepError{Step: StepReset, Err: err}
+	}
+
+	return nil
+}
+
+// apply puts the boot settings, sets the boot configuration and source of the next boot and restarts the managed
+// system with restart when it is on. Without a source, the boot source left by an earlier ChangeBootOrder is cleared
+// first, so that it does not take precedence over the boot settings. The boot settings are reset when a step after the
+// put fails.
+func (c BootController) apply(current amtboot.BootSettingDataResponse, request amtboot.BootSettingDataRequest, source cimboot.Source, restart power.PowerState) error {
+	if source == "" {
+		if err := c.changeBootOrder(""); err != nil {
+			return &StepError{Step: StepBootOrder, Err: err}
+		}
+	}
+
+	if _, err := c.settingData.Put(request); err != nil {
+		return &StepError{Step: StepBootSettings, Err: err}
+	}
+
+	response, err := c.service.SetBootConfigRole(BootConfigSettingInstanceID, roleIsNextSingleUse)
+	if err == nil && response.Body.SetBootConfigRole_OUTPUT.ReturnValue != cimboot.ReturnValueCompletedNoError {
+		err = errors.New("SetBootConfigRole failed with return code " + response.Body.SetBootConfigRole_OUTPUT.ReturnValue.String())
+	}
+
+	if err != nil {
+		return c.rollback(current, StepBootConfigRole, err)
+	}
+
+	if source != "" {
+		if err = c.changeBootOrder(source); err != nil {
+			return c.rollback(current, StepBootOrder, err)
+		}
+	}
+
+	if err = c.restart(restart); err != nil {
+		return c.rollback(current, StepPowerAction, err)
+	}
+
+	return nil
+}
+
+// changeBootOrder sets the boot source of the next boot, or clears it when source is empty. Clearing the boot source
+// does not require user consent.
+func (c BootController) changeBootOrder(source cimboot.Source) error {
+	response, err := c.configSetting.ChangeBootOrder(source)
+	if err == nil && response.Body.ChangeBootOrder_OUTPUT.ReturnValue != cimboot.ReturnValueCompletedNoError {
+		err = errors.New("ChangeBootOrder failed with return code " + response.Body.ChangeBootOrder_OUTPUT.ReturnValue.String())
+	}
+
+	return err
+}
+
+// restart requests powerState when the managed system is on and powers it on otherwise.
+func (c BootController) restart(powerState power.PowerState) error {
+	current, err := c.power.PowerState()
+	if err != nil {
+		return err
+	}
+
+	if current == power.PowerOn {
+		return c.power.RequestPowerStateChange(powerState, 0)
+	}
+
+	return c.power.RequestPowerStateChange(power.PowerOn, 0)
+}
+
+// rollback clears the boot options and the boot source and returns the error of step, joined with the error of the
+// reset when it fails.
+func (c BootController) rollback(current amtboot.BootSettingDataResponse, step Step, err error) error {
+	stepErr := &StepError{Step: step, Err: err}
+
+	if _, resetErr := c.settingData.Put(clearedRequest(current)); resetErr != nil {
+		return errors.Join(stepErr, &StepError{Step: StepReset, Err: resetErr})
+	}
+
+	if resetErr := c.changeBootOrder(""); resetErr != nil {
+		return errors.Join(stepErr, &StepError{Step: StepReset, Err: resetErr})
+	}
+
+	return stepErr
+}
+
+// checkConsent returns ErrConsentRequired when the user consent policy applies to boot control and the consent has not
+// been received.
+func (c BootController) checkConsent() error {
+	response, err := c.optIn.Get()
+	if err != nil {
+		return err
+	}
+
+	service := response.Body.GetAndPutResponse
+	if optin.OptInRequired(service.OptInRequired) != optin.OptInRequiredAll {
+		return nil
+	}
+
+	if state := optin.OptInState(service.OptInState); state != optin.Received && state != optin.InSession {
+		return fmt.Errorf("%w, the consent state is %s", ErrConsentRequired, state)
+	}
+
+	return nil
+}
+
+// validate checks the boot target and Serial over LAN against the boot capabilities and the rules of ChangeBootOrder.
+func validate(capabilities amtboot.BootCapabilitiesResponse, target Target, withSOL bool) error {
+	supported := false
+
+	switch target {
+	case PXE:
+		supported = capabilities.ForcePXEBoot
+	case CD:
+		supported = capabilities.ForceCDorDVDBoot
+	case IDERCD, IDERFloppy:
+		supported = capabilities.IDER
+	case BIOSSetup:
+		supported = capabilities.BIOSSetup
+	case HDD:
+		supported = capabilities.ForceHardDriveBoot
+	}
+
+	if !supported {
+		return fmt.Errorf("%w: %s", ErrTargetNotSupported, target)
+	}
+
+	if !withSOL {
+		return nil
+	}
+
+	if !capabilities.SOL {
+		return ErrSOLNotSupported
+	}
+
+	if _, ok := bootSources[target]; ok {
+		return fmt.Errorf("%w: serial over LAN cannot be used with a %s boot source", ErrConflictingOptions, target)
+	}
+
+	return nil
+}
+
+// clearedRequest returns the boot settings of current without the options of the next boot. The locks, the firmware
+// verbosity and the other settings that are not specific to a boot target are kept.
+func clearedRequest(current amtboot.BootSettingDataResponse) amtboot.BootSettingDataRequest {
+	return amtboot.BootSettingDataRequest{
+		InstanceID:           current.InstanceID,
+		ElementName:          current.ElementName,
+		LockPowerButton:      current.LockPowerButton,
+		LockResetButton:      current.LockResetButton,
+		LockKeyboard:         current.LockKeyboard,
+		LockSleepButton:      current.LockSleepButton,
+		UserPasswordBypass:   current.UserPasswordBypass,
+		ForcedProgressEvents: current.ForcedProgressEvents,
+		FirmwareVerbosity:    current.FirmwareVerbosity,
+		EnforceSecureBoot:    current.EnforceSecureBoot,
+		RPEEnabled:           current.RPEEnabled,
+	}
+}
@@ -0,0 +1,377 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package remoteboot
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	amtboot "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
+	cimboot "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/boot"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/computer"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/optin"
+)
+
+var (
+	errPut   = errors.New("put failed")
+	errPower = errors.New("power failed")
+)
+
+// fakeDevice implements the services of a BootController and records the calls made to them.
+type fakeDevice struct {
+	calls             []string
+	capabilities      amtboot.BootCapabilitiesResponse
+	settings          amtboot.BootSettingDataResponse
+	puts              []amtboot.BootSettingDataRequest
+	putErr            []error
+	changeBootOrderRV cimboot.ReturnValue
+	clearBootOrderRV  cimboot.ReturnValue
+	enabledState      cimboot.EnabledState
+	optInRequired     optin.OptInRequired
+	optInState        optin.OptInState
+	powerState        power.PowerState
+	powerErr          error
+	requested         []power.PowerState
+	platformGUID      string
+}
+
+type fakeCapabilities struct{ *fakeDevice }
+
+func (f fakeCapabilities) Get() (response amtboot.Response, err error) {
+	f.calls = append(f.calls, "GetBootCapabilities")
+	response.Body.BootCapabilitiesGetResponse = f.capabilities
+
+	return response, nil
+}
+
+type fakeSettingData struct{ *fakeDevice }
+
+func (f fakeSettingData) Get() (response amtboot.Response, err error) {
+	f.calls = append(f.calls, "GetBootSettingData")
+	response.Body.BootSettingDataGetResponse = f.settings
+
+	return response, nil
+}
+
+func (f fakeSettingData) Put(request amtboot.BootSettingDataRequest) (response amtboot.Response, err error) {
+	f.calls = append(f.calls, "PutBootSettingData")
+	f.puts = append(f.puts, request)
+
+	if len(f.putErr) > 0 {
+		err, f.putErr = f.putErr[0], f.putErr[1:]
+	}
+
+	return response, err
+}
+
+func (f *fakeDevice) ChangeBootOrder(source cimboot.Source) (response cimboot.Response, err error) {
+	f.calls = append(f.calls, "ChangeBootOrder "+string(source))
+	response.Body.ChangeBootOrder_OUTPUT.ReturnValue = f.changeBootOrderRV
+
+	// An empty source does not require user consent and has its own return value.
+	if source == "" {
+		response.Body.ChangeBootOrder_OUTPUT.ReturnValue = f.clearBootOrderRV
+	}
+
+	return response, nil
+}
+
+type fakeService struct{ *fakeDevice }
+
+func (f fakeService) Get() (response cimboot.Response, err error) {
+	f.calls = append(f.calls, "GetBootService")
+	response.Body.ServiceGetResponse.EnabledState = f.enabledState
+
+	return response, nil
+}
+
+func (f fakeService) SetBootConfigRole(instanceID string, role int) (response cimboot.Response, err error) {
+	f.calls = append(f.calls, "SetBootConfigRole "+instanceID)
+
+	return response, nil
+}
+
+type fakeOptIn struct{ *fakeDevice }
+
+func (f fakeOptIn) Get() (response optin.Response, err error) {
+	f.calls = append(f.calls, "GetOptIn")
+	response.Body.GetAndPutResponse.OptInRequired = uint32(f.optInRequired)
+	response.Body.GetAndPutResponse.OptInState = int(f.optInState)
+
+	return response, nil
+}
+
+func (f *fakeDevice) PowerState() (power.PowerState, error) {
+	f.calls = append(f.calls, "PowerState")
+
+	return f.powerState, nil
+}
+
+func (f *fakeDevice) RequestPowerStateChange(powerState power.PowerState, timeout time.Duration) error {
+	f.calls = append(f.calls, "RequestPowerStateChange "+powerState.String())
+	f.requested = append(f.requested, powerState)
+
+	return f.powerErr
+}
+
+type fakeSystemPackage struct{ *fakeDevice }
+
+func (f fakeSystemPackage) Get() (response computer.Response, err error) {
+	f.calls = append(f.calls, "GetComputerSystemPackage")
+	response.Body.GetResponse.PlatformGUID = f.platformGUID
+
+	return response, nil
+}
+
+func newFakeDevice() *fakeDevice {
+	return &fakeDevice{
+		capabilities: amtboot.BootCapabilitiesResponse{
+			IDER:               true,
+			SOL:                true,
+			BIOSSetup:          true,
+			ForcePXEBoot:       true,
+			ForceHardDriveBoot: true,
+			ForceCDorDVDBoot:   true,
+		},
+		settings: amtboot.BootSettingDataResponse{
+			InstanceID:      "Intel(r) AMT:BootSettingData 0",
+			ElementName:     "Intel(r) AMT Boot Configuration Settings",
+			BIOSPause:       true,
+			LockPowerButton: true,
+		},
+		optInRequired: optin.OptInRequiredKVM,
+		powerState:    power.PowerOn,
+		platformGUID:  "13AEE355D2BFBB6117A088AEDD7037EA",
+	}
+}
+
+func newTestBootController(device *fakeDevice) BootController {
+	return NewBootController(fakeCapabilities{device}, fakeSettingData{device}, device, fakeService{device}, fakeOptIn{device}, device, fakeSystemPackage{device})
+}
+
+func clearedSettings() amtboot.BootSettingDataRequest {
+	return amtboot.BootSettingDataRequest{
+		InstanceID:      "Intel(r) AMT:BootSettingData 0",
+		ElementName:     "Intel(r) AMT Boot Configuration Settings",
+		LockPowerButton: true,
+	}
+}
+
+func TestBootOnceTo(t *testing.T) {
+	t.Run("should boot to PXE in order and reset a system that is on", func(t *testing.T) {
+		device := newFakeDevice()
+
+		assert.NoError(t, newTestBootController(device).BootOnceTo(PXE, false))
+		assert.Equal(t, []string{
+			"GetBootCapabilities",
+			"GetOptIn",
+			"GetBootSettingData",
+			"PutBootSettingData",
+			"SetBootConfigRole Intel(r) AMT: Boot Configuration 0",
+			"ChangeBootOrder Intel(r) AMT: Force PXE Boot",
+			"PowerState",
+			"RequestPowerStateChange MasterBusReset",
+		}, device.calls)
+		assert.Equal(t, []amtboot.BootSettingDataRequest{clearedSettings()}, device.puts)
+	})
+
+	t.Run("should boot to IDER CD with SOL and power on a system that is off", func(t *testing.T) {
+		device := newFakeDevice()
+		device.powerState = power.PowerOffSoft
+		expected := clearedSettings()
+		expected.UseSOL = true
+		expected.UseIDER = true
+		expected.IDERBootDevice = amtboot.CDBoot
+
+		assert.NoError(t, newTestBootController(device).BootOnceTo(IDERCD, true))
+		assert.Len(t, device.calls, 8)
+		assert.Equal(t, "ChangeBootOrder ", device.calls[3])
+		assert.Equal(t, []amtboot.BootSettingDataRequest{expected}, device.puts)
+		assert.Equal(t, []power.PowerState{power.PowerOn}, device.requested)
+	})
+
+	t.Run("should boot to IDER floppy", func(t *testing.T) {
+		device := newFakeDevice()
+		expected := clearedSettings()
+		expected.UseIDER = true
+		expected.IDERBootDevice = amtboot.FloppyBoot
+
+		assert.NoError(t, newTestBootController(device).BootOnceTo(IDERFloppy, false))
+		assert.Equal(t, []amtboot.BootSettingDataRequest{expected}, device.puts)
+	})
+
+	t.Run("should clear the boot order and boot to BIOS setup", func(t *testing.T) {
+		device := newFakeDevice()
+		expected := clearedSettings()
+		expected.BIOSSetup = true
+
+		assert.NoError(t, newTestBootController(device).BootOnceTo(BIOSSetup, false))
+		assert.Equal(t, []amtboot.BootSettingDataRequest{expected}, device.puts)
+		assert.Equal(t, []string{
+			"GetBootCapabilities",
+			"GetOptIn",
+			"GetBootSettingData",
+			"ChangeBootOrder ",
+			"PutBootSettingData",
+			"SetBootConfigRole Intel(r) AMT: Boot Configuration 0",
+			"PowerState",
+			"RequestPowerStateChange MasterBusReset",
+		}, device.calls)
+	})
+
+	t.Run("should not change the boot settings when the boot order cannot be cleared", func(t *testing.T) {
+		device := newFakeDevice()
+		device.clearBootOrderRV = cimboot.ReturnValueBusy
+
+		err := newTestBootController(device).BootOnceTo(IDERCD, false)
+
+		var stepErr *StepError
+		assert.ErrorAs(t, err, &stepErr)
+		assert.Equal(t, StepBootOrder, stepErr.Step)
+		assert.Empty(t, device.puts)
+	})
+
+	t.Run("should boot to the hard drive and the CD with ChangeBootOrder", func(t *testing.T) {
+		device := newFakeDevice()
+		controller := newTestBootController(device)
+
+		assert.NoError(t, controller.BootOnceTo(HDD, false))
+		assert.NoError(t, controller.BootOnceTo(CD, false))
+		assert.Contains(t, device.calls, "ChangeBootOrder Intel(r) AMT: Force Hard-drive Boot")
+		assert.Contains(t, device.calls, "ChangeBootOrder Intel(r) AMT: Force CD/DVD Boot")
+	})
+
+	t.Run("should reject a target that is not supported", func(t *testing.T) {
+		device := newFakeDevice()
+		device.capabilities.ForcePXEBoot = false
+
+		err := newTestBootController(device).BootOnceTo(PXE, false)
+
+		var stepErr *StepError
+		assert.ErrorAs(t, err, &stepErr)
+		assert.Equal(t, StepCapabilities, stepErr.Step)
+		assert.ErrorIs(t, err, ErrTargetNotSupported)
+		assert.EqualError(t, err, "remote boot Capabilities: boot target not supported: PXE")
+		assert.Empty(t, device.puts)
+	})
+
+	t.Run("should reject SOL when it is not supported", func(t *testing.T) {
+		device := newFakeDevice()
+		device.capabilities.SOL = false
+
+		assert.ErrorIs(t, newTestBootController(device).BootOnceTo(BIOSSetup, true), ErrSOLNotSupported)
+		assert.Empty(t, device.puts)
+	})
+
+	t.Run("should reject SOL with a boot source", func(t *testing.T) {
+		device := newFakeDevice()
+
+		assert.ErrorIs(t, newTestBootController(device).BootOnceTo(HDD, true), ErrConflictingOptions)
+		assert.Empty(t, device.puts)
+	})
+
+	t.Run("should require user consent when the policy applies to all redirection", func(t *testing.T) {
+		device := newFakeDevice()
+		device.optInRequired = optin.OptInRequiredAll
+		device.optInState = optin.Displayed
+
+		err := newTestBootController(device).BootOnceTo(PXE, false)
+
+		var stepErr *StepError
+		assert.ErrorAs(t, err, &stepErr)
+		assert.Equal(t, StepConsent, stepErr.Step)
+		assert.ErrorIs(t, err, ErrConsentRequired)
+		assert.Empty(t, device.puts)
+
+		device.optInState = optin.Received
+		assert.NoError(t, newTestBootController(device).BootOnceTo(PXE, false))
+	})
+
+	t.Run("should reset the boot settings when ChangeBootOrder fails", func(t *testing.T) {
+		device := newFakeDevice()
+		device.changeBootOrderRV = cimboot.ReturnValueAccessDenied
+
+		err := newTestBootController(device).BootOnceTo(PXE, false)
+
+		var stepErr *StepError
+		assert.ErrorAs(t, err, &stepErr)
+		assert.Equal(t, StepBootOrder, stepErr.Step)
+		assert.EqualError(t, err, "remote boot BootOrder: ChangeBootOrder failed with return code AccessDenied")
+		assert.Equal(t, []amtboot.BootSettingDataRequest{clearedSettings(), clearedSettings()}, device.puts)
+		assert.Equal(t, "ChangeBootOrder ", device.calls[len(device.calls)-1])
+		assert.NotContains(t, device.calls, "PowerState")
+	})
+
+	t.Run("should return both errors when the reset after a failed power action fails", func(t *testing.T) {
+		device := newFakeDevice()
+		device.powerErr = errPower
+		device.putErr = []error{nil, errPut}
+
+		err := newTestBootController(device).BootOnceTo(BIOSSetup, false)
+		assert.ErrorIs(t, err, errPower)
+		assert.ErrorIs(t, err, errPut)
+		assert.Len(t, device.puts, 2)
+	})
+
+	t.Run("should return the error of the boot settings", func(t *testing.T) {
+		device := newFakeDevice()
+		device.putErr = []error{errPut}
+
+		err := newTestBootController(device).BootOnceTo(PXE, false)
+
+		var stepErr *StepError
+		assert.ErrorAs(t, err, &stepErr)
+		assert.Equal(t, StepBootSettings, stepErr.Step)
+		assert.Len(t, device.puts, 1)
+	})
+}
+
+func TestReset(t *testing.T) {
+	device := newFakeDevice()
+	controller := newTestBootController(device)
+
+	assert.NoError(t, controller.Reset())
+	assert.Equal(t, []amtboot.BootSettingDataRequest{clearedSettings()}, device.puts)
+	assert.Equal(t, []string{"GetBootSettingData", "PutBootSettingData", "ChangeBootOrder "}, device.calls)
+
+	device.clearBootOrderRV = cimboot.ReturnValueBusy
+	assert.EqualError(t, controller.Reset(), "remote boot Reset: ChangeBootOrder failed with return code Busy")
+	device.clearBootOrderRV = cimboot.ReturnValueCompletedNoError
+
+	device.putErr = []error{errPut}
+	err := controller.Reset()
+
+	var stepErr *StepError
+	assert.ErrorAs(t, err, &stepErr)
+	assert.Equal(t, StepReset, stepErr.Step)
+	assert.ErrorIs(t, err, errPut)
+}
+
+func TestTarget_String(t *testing.T) {
+	tests := []struct {
+		target   Target
+		expected string
+	}{
+		{PXE, "PXE"},
+		{CD, "CD"},
+		{IDERCD, "IDERCD"},
+		{IDERFloppy, "IDERFloppy"},
+		{BIOSSetup, "BIOSSetup"},
+		{HDD, "HDD"},
+		{Target(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.target.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
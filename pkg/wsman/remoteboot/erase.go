@@ -0,0 +1,212 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package remoteboot
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	amtboot "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
+	cimboot "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/boot"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+)
+
+// ConfirmationTimeout is the time within which a Remote Platform Erase must be confirmed.
+const ConfirmationTimeout = 5 * time.Minute
+
+var (
+	// ErrRPEDisabled is returned when Remote Platform Erase is disabled in CIM_BootService or by the BIOS.
+	ErrRPEDisabled = errors.New("remote platform erase is disabled")
+	// ErrNoEraseDevices is returned when a Remote Platform Erase is requested without devices to erase.
+	ErrNoEraseDevices = errors.New("no devices to erase")
+	// ErrInvalidConfirmation is returned when the PlatformGUID entered by the operator does not confirm the Remote
+	// Platform Erase.
+	ErrInvalidConfirmation = errors.New("invalid erase confirmation")
+	// ErrConfirmationExpired is returned when the Remote Platform Erase was not confirmed within ConfirmationTimeout.
+	ErrConfirmationExpired = errors.New("erase confirmation expired")
+	// ErrConfirmationUsed is returned when the confirmation has already started an erase.
+	ErrConfirmationUsed = errors.New("erase confirmation already used")
+	// ErrConfirmationDevice is returned when the erase was confirmed for another managed system.
+	ErrConfirmationDevice = errors.New("erase confirmation is for another device")
+	// ErrNoPlatformGUID is returned when CIM_ComputerSystemPackage does not identify the managed system.
+	ErrNoPlatformGUID = errors.New("platform GUID not found")
+)
+
+// RPECapabilities are the Remote Platform Erase capabilities of the managed system.
+type RPECapabilities struct {
+	Enabled     bool                         // Remote Platform Erase is enabled in CIM_BootService.
+	BIOSEnabled bool                         // Remote Platform Erase is enabled by the BIOS.
+	Devices     amtboot.PlatformEraseDevices // The devices that can be erased.
+}
+
+// EraseConfirmation is a Remote Platform Erase that was requested and waits for its confirmation. It is bound to the
+// managed system it was requested for and starts at most one erase. It only exists in the memory of the process that
+// requested it and cannot be stored or passed to another process.
+type EraseConfirmation struct {
+	devices      amtboot.PlatformEraseDevices
+	platformGUID string
+	expires      time.Time
+	used         atomic.Bool
+}
+
+// Devices returns the devices to erase.
+func (e *EraseConfirmation) Devices() amtboot.PlatformEraseDevices {
+	return e.devices
+}
+
+// Expires returns the time after which the erase can no longer be confirmed.
+func (e *EraseConfirmation) Expires() time.Time {
+	return e.expires
+}
+
+// RPECapabilities returns the Remote Platform Erase capabilities of the managed system, from AMT_BootCapabilities,
+// AMT_BootSettingData and CIM_BootService.
+func (c BootController) RPECapabilities() (RPECapabilities, error) {
+	capabilities, _, err := c.rpeCapabilities()
+
+	return capabilities, err
+}
+
+// RequestPlatformErase checks that the managed system can erase the devices and returns the confirmation of the erase.
+// Nothing is changed on the managed system until PlatformErase is called with the confirmation.
+func (c BootController) RequestPlatformErase(devices amtboot.PlatformEraseDevices) (*EraseConfirmation, error) {
+	capabilities, _, err := c.rpeCapabilities()
+	if err != nil {
+		return nil, &StepError{Step: StepCapabilities, Err: err}
+	}
+
+	if err = validatePlatformErase(capabilities, devices); err != nil {
+		return nil, &StepError{Step: StepCapabilities, Err: err}
+	}
+
+	platformGUID, err := c.platformGUID()
+	if err != nil {
+		return nil, &StepError{Step: StepConsent, Err: err}
+	}
+
+	return &EraseConfirmation{
+		devices:      devices,
+		platformGUID: platformGUID,
+		expires:      time.Now().Add(ConfirmationTimeout),
+	}, nil
+}
+
+// PlatformErase sets the Remote Platform Erase of the confirmed devices as the option of the next boot and power cycles
+// the managed system, powering it on when it is off. The BIOS erases the devices during the boot.
+//
+// platformGUID is the CIM_ComputerSystemPackage PlatformGUID of the system to erase, entered by the operator from the
+// inventory of the system; the confirmation does not expose it. It must match the system the erase was requested for
+// and the system the controller manages. The confirmation must not have expired and is used up by the first call that
+// passes these checks, even when a later step fails.
+//
+// As with BootOnceTo, the capabilities and the user consent policy are checked again before anything is changed, the
+// boot options are reset when a later step fails and the error of a failed step is a *StepError. ReadEraseEvents
+// reports the progress of the erase and EraseResult its outcome.
+func (c BootController) PlatformErase(confirmation *EraseConfirmation, platformGUID string) error {
+	if confirmation == nil || confirmation.platformGUID == "" || !strings.EqualFold(platformGUID, confirmation.platformGUID) {
+		return &StepError{Step: StepConsent, Err: ErrInvalidConfirmation}
+	}
+
+	if time.Now().After(confirmation.expires) {
+		return &StepError{Step: StepConsent, Err: ErrConfirmationExpired}
+	}
+
+	current, err := c.platformGUID()
+	if err != nil {
+		return &StepError{Step: StepConsent, Err: err}
+	}
+
+	if !strings.EqualFold(current, confirmation.platformGUID) {
+		return &StepError{Step: StepConsent, Err: ErrConfirmationDevice}
+	}
+
+	if !confirmation.used.CompareAndSwap(false, true) {
+		return &StepError{Step: StepConsent, Err: ErrConfirmationUsed}
+	}
+
+	capabilities, settings, err := c.rpeCapabilities()
+	if err != nil {
+		return &StepError{Step: StepCapabilities, Err: err}
+	}
+
+	if err = validatePlatformErase(capabilities, confirmation.devices); err != nil {
+		return &StepError{Step: StepCapabilities, Err: err}
+	}
+
+	if err = c.checkConsent(); err != nil {
+		return &StepError{Step: StepConsent, Err: err}
+	}
+
+	request := clearedRequest(settings)
+	request.PlatformErase = true
+	request.SetUEFIBootParameters(amtboot.PlatformEraseUEFIBootParameters(confirmation.devices))
+
+	return c.apply(settings, request, "", power.PowerCycleOffHard)
+}
+
+// platformGUID returns the PlatformGUID of CIM_ComputerSystemPackage, which identifies the managed system.
+func (c BootController) platformGUID() (string, error) {
+	response, err := c.systemPackage.Get()
+	if err != nil {
+		return "", err
+	}
+
+	platformGUID := response.Body.GetResponse.PlatformGUID
+	if platformGUID == "" {
+		return "", ErrNoPlatformGUID
+	}
+
+	return platformGUID, nil
+}
+
+// rpeCapabilities returns the Remote Platform Erase capabilities and the current boot settings.
+func (c BootController) rpeCapabilities() (RPECapabilities, amtboot.BootSettingDataResponse, error) {
+	response, err := c.capabilities.Get()
+	if err != nil {
+		return RPECapabilities{}, amtboot.BootSettingDataResponse{}, err
+	}
+
+	devices := response.Body.BootCapabilitiesGetResponse.PlatformErase
+
+	response, err = c.settingData.Get()
+	if err != nil {
+		return RPECapabilities{}, amtboot.BootSettingDataResponse{}, err
+	}
+
+	settings := response.Body.BootSettingDataGetResponse
+
+	service, err := c.service.Get()
+	if err != nil {
+		return RPECapabilities{}, settings, err
+	}
+
+	enabledState := service.Body.ServiceGetResponse.EnabledState
+
+	return RPECapabilities{
+		Enabled:     enabledState == cimboot.EnabledStateIntelRPEIsEnabledAndIntelOneClickRecoveryIsDisabledAndAllOtherBootOptionsAreEnabled || enabledState == cimboot.EnabledStateIntelOneClickRecoveryAndIntelRPEAreEnabledAndAllOtherBootOptionsAreEnabled,
+		BIOSEnabled: settings.RPEEnabled,
+		Devices:     devices,
+	}, settings, nil
+}
+
+func validatePlatformErase(capabilities RPECapabilities, devices amtboot.PlatformEraseDevices) error {
+	if devices == 0 {
+		return ErrNoEraseDevices
+	}
+
+	if !capabilities.Enabled || !capabilities.BIOSEnabled {
+		return ErrRPEDisabled
+	}
+
+	if !capabilities.Devices.Contains(devices) {
+		return fmt.Errorf("%w: %s", ErrTargetNotSupported, devices&^capabilities.Devices)
+	}
+
+	return nil
+}
@@ -0,0 +1,255 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package remoteboot
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	amtboot "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
+	cimboot "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/boot"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/ips/optin"
+)
+
+const eraseDevices = amtboot.PlatformEraseSecureEraseAllSSDs | amtboot.PlatformEraseCSMEUnconfigure
+
+func newFakeRPEDevice() *fakeDevice {
+	device := newFakeDevice()
+	device.enabledState = cimboot.EnabledStateIntelRPEIsEnabledAndIntelOneClickRecoveryIsDisabledAndAllOtherBootOptionsAreEnabled
+	device.capabilities.PlatformErase = amtboot.PlatformEraseSecureEraseAllSSDs | amtboot.PlatformEraseTPMClear | amtboot.PlatformEraseCSMEUnconfigure
+	device.settings.RPEEnabled = true
+
+	return device
+}
+
+func TestRPECapabilities(t *testing.T) {
+	device := newFakeRPEDevice()
+
+	capabilities, err := newTestBootController(device).RPECapabilities()
+	assert.NoError(t, err)
+	assert.Equal(t, RPECapabilities{
+		Enabled:     true,
+		BIOSEnabled: true,
+		Devices:     amtboot.PlatformEraseSecureEraseAllSSDs | amtboot.PlatformEraseTPMClear | amtboot.PlatformEraseCSMEUnconfigure,
+	}, capabilities)
+
+	device.enabledState = cimboot.EnabledStateIntelOneClickRecoveryIsEnabledAndIntelRPEIsDisabledAndAllOtherBootOptionsAreEnabled
+	capabilities, err = newTestBootController(device).RPECapabilities()
+	assert.NoError(t, err)
+	assert.False(t, capabilities.Enabled)
+}
+
+func TestRequestPlatformErase(t *testing.T) {
+	t.Run("should return a confirmation without changing the boot settings", func(t *testing.T) {
+		device := newFakeRPEDevice()
+
+		confirmation, err := newTestBootController(device).RequestPlatformErase(eraseDevices)
+		assert.NoError(t, err)
+		assert.Equal(t, eraseDevices, confirmation.Devices())
+		assert.WithinDuration(t, time.Now().Add(ConfirmationTimeout), confirmation.Expires(), time.Minute)
+		assert.Empty(t, device.puts)
+	})
+
+	t.Run("should reject an erase without devices", func(t *testing.T) {
+		_, err := newTestBootController(newFakeRPEDevice()).RequestPlatformErase(0)
+		assert.ErrorIs(t, err, ErrNoEraseDevices)
+	})
+
+	t.Run("should reject an erase of a system without a platform GUID", func(t *testing.T) {
+		device := newFakeRPEDevice()
+		device.platformGUID = ""
+
+		_, err := newTestBootController(device).RequestPlatformErase(eraseDevices)
+		assert.ErrorIs(t, err, ErrNoPlatformGUID)
+	})
+
+	t.Run("should reject an erase when RPE is disabled", func(t *testing.T) {
+		device := newFakeRPEDevice()
+		device.settings.RPEEnabled = false
+
+		_, err := newTestBootController(device).RequestPlatformErase(eraseDevices)
+		assert.ErrorIs(t, err, ErrRPEDisabled)
+
+		device = newFakeRPEDevice()
+		device.enabledState = cimboot.EnabledStateIntelOneClickRecoveryIsEnabledAndIntelRPEIsDisabledAndAllOtherBootOptionsAreEnabled
+
+		_, err = newTestBootController(device).RequestPlatformErase(eraseDevices)
+		assert.ErrorIs(t, err, ErrRPEDisabled)
+	})
+
+	t.Run("should reject devices that cannot be erased", func(t *testing.T) {
+		_, err := newTestBootController(newFakeRPEDevice()).RequestPlatformErase(amtboot.PlatformEraseSecureEraseAllSSDs | amtboot.PlatformEraseBIOSReloadGoldenConfiguration)
+
+		var stepErr *StepError
+		assert.ErrorAs(t, err, &stepErr)
+		assert.Equal(t, StepCapabilities, stepErr.Step)
+		assert.ErrorIs(t, err, ErrTargetNotSupported)
+		assert.EqualError(t, err, "remote boot Capabilities: boot target not supported: BIOSReloadGoldenConfiguration")
+	})
+}
+
+func TestPlatformErase(t *testing.T) {
+	t.Run("should set the erase and power cycle a system that is on", func(t *testing.T) {
+		device := newFakeRPEDevice()
+		controller := newTestBootController(device)
+		expected := clearedSettings()
+		expected.RPEEnabled = true
+		expected.PlatformErase = true
+		expected.SetUEFIBootParameters(amtboot.PlatformEraseUEFIBootParameters(eraseDevices))
+
+		confirmation, err := controller.RequestPlatformErase(eraseDevices)
+		assert.NoError(t, err)
+
+		device.calls = nil
+		assert.NoError(t, controller.PlatformErase(confirmation, device.platformGUID))
+		assert.Equal(t, []string{
+			"GetComputerSystemPackage",
+			"GetBootCapabilities",
+			"GetBootSettingData",
+			"GetBootService",
+			"GetOptIn",
+			"ChangeBootOrder ",
+			"PutBootSettingData",
+			"SetBootConfigRole Intel(r) AMT: Boot Configuration 0",
+			"PowerState",
+			"RequestPowerStateChange PowerCycleOffHard",
+		}, device.calls)
+		assert.Equal(t, []amtboot.BootSettingDataRequest{expected}, device.puts)
+	})
+
+	t.Run("should power on a system that is off", func(t *testing.T) {
+		device := newFakeRPEDevice()
+		device.powerState = power.PowerOffHard
+		controller := newTestBootController(device)
+
+		confirmation, _ := controller.RequestPlatformErase(eraseDevices)
+
+		assert.NoError(t, controller.PlatformErase(confirmation, device.platformGUID))
+		assert.Equal(t, []power.PowerState{power.PowerOn}, device.requested)
+	})
+
+	t.Run("should accept the platform GUID in any case", func(t *testing.T) {
+		device := newFakeRPEDevice()
+		controller := newTestBootController(device)
+
+		confirmation, _ := controller.RequestPlatformErase(eraseDevices)
+
+		assert.NoError(t, controller.PlatformErase(confirmation, strings.ToLower(device.platformGUID)))
+	})
+
+	t.Run("should reject a wrong platform GUID", func(t *testing.T) {
+		device := newFakeRPEDevice()
+		controller := newTestBootController(device)
+
+		confirmation, _ := controller.RequestPlatformErase(eraseDevices)
+
+		err := controller.PlatformErase(confirmation, "4C4C4544004630108031B4C04F4C4D32")
+
+		var stepErr *StepError
+		assert.ErrorAs(t, err, &stepErr)
+		assert.Equal(t, StepConsent, stepErr.Step)
+		assert.ErrorIs(t, err, ErrInvalidConfirmation)
+		assert.ErrorIs(t, controller.PlatformErase(confirmation, ""), ErrInvalidConfirmation)
+		assert.ErrorIs(t, controller.PlatformErase(nil, device.platformGUID), ErrInvalidConfirmation)
+		assert.ErrorIs(t, controller.PlatformErase(&EraseConfirmation{}, ""), ErrInvalidConfirmation)
+		assert.Empty(t, device.puts)
+
+		assert.NoError(t, controller.PlatformErase(confirmation, device.platformGUID))
+	})
+
+	t.Run("should use a confirmation only once", func(t *testing.T) {
+		device := newFakeRPEDevice()
+		device.powerErr = errPower
+		controller := newTestBootController(device)
+
+		confirmation, _ := controller.RequestPlatformErase(eraseDevices)
+
+		assert.ErrorIs(t, controller.PlatformErase(confirmation, device.platformGUID), errPower)
+
+		device.powerErr = nil
+		device.puts = nil
+
+		err := controller.PlatformErase(confirmation, device.platformGUID)
+
+		var stepErr *StepError
+		assert.ErrorAs(t, err, &stepErr)
+		assert.Equal(t, StepConsent, stepErr.Step)
+		assert.ErrorIs(t, err, ErrConfirmationUsed)
+		assert.Empty(t, device.puts)
+	})
+
+	t.Run("should reject an expired confirmation", func(t *testing.T) {
+		device := newFakeRPEDevice()
+		controller := newTestBootController(device)
+
+		confirmation, _ := controller.RequestPlatformErase(eraseDevices)
+		confirmation.expires = time.Now().Add(-time.Second)
+
+		assert.ErrorIs(t, controller.PlatformErase(confirmation, device.platformGUID), ErrConfirmationExpired)
+		assert.Empty(t, device.puts)
+	})
+
+	t.Run("should reject a confirmation of another device", func(t *testing.T) {
+		device := newFakeRPEDevice()
+		other := newFakeRPEDevice()
+		other.platformGUID = "4C4C4544004630108031B4C04F4C4D32"
+
+		confirmation, _ := newTestBootController(other).RequestPlatformErase(eraseDevices)
+
+		err := newTestBootController(device).PlatformErase(confirmation, other.platformGUID)
+
+		var stepErr *StepError
+		assert.ErrorAs(t, err, &stepErr)
+		assert.Equal(t, StepConsent, stepErr.Step)
+		assert.ErrorIs(t, err, ErrConfirmationDevice)
+		assert.Empty(t, device.puts)
+		assert.Empty(t, device.requested)
+	})
+
+	t.Run("should check the capabilities again", func(t *testing.T) {
+		device := newFakeRPEDevice()
+		controller := newTestBootController(device)
+
+		confirmation, _ := controller.RequestPlatformErase(eraseDevices)
+		device.settings.RPEEnabled = false
+
+		assert.ErrorIs(t, controller.PlatformErase(confirmation, device.platformGUID), ErrRPEDisabled)
+		assert.Empty(t, device.puts)
+	})
+
+	t.Run("should require user consent when the policy applies to all redirection", func(t *testing.T) {
+		device := newFakeRPEDevice()
+		device.optInRequired = optin.OptInRequiredAll
+		controller := newTestBootController(device)
+
+		confirmation, _ := controller.RequestPlatformErase(eraseDevices)
+
+		assert.ErrorIs(t, controller.PlatformErase(confirmation, device.platformGUID), ErrConsentRequired)
+		assert.Empty(t, device.puts)
+	})
+
+	t.Run("should reset the boot settings when the power cycle fails", func(t *testing.T) {
+		device := newFakeRPEDevice()
+		device.powerErr = errPower
+		controller := newTestBootController(device)
+
+		confirmation, _ := controller.RequestPlatformErase(eraseDevices)
+
+		err := controller.PlatformErase(confirmation, device.platformGUID)
+
+		var stepErr *StepError
+		assert.ErrorAs(t, err, &stepErr)
+		assert.Equal(t, StepPowerAction, stepErr.Step)
+		assert.ErrorIs(t, err, errPower)
+		assert.Len(t, device.puts, 2)
+		assert.False(t, device.puts[1].PlatformErase)
+		assert.Empty(t, device.puts[1].UEFIBootParametersArray)
+	})
+}
@@ -0,0 +1,214 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package remoteboot
+
+import (
+	"errors"
+	"time"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+	amtboot "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
+)
+
+const (
+	// intelSpecialCommand is the special command of the boot options that starts an Intel BIOS session.
+	intelSpecialCommand = 193
+	// rpeSpecialCommandParameter is the special command parameter that starts a Remote Platform Erase session.
+	rpeSpecialCommandParameter = 128
+)
+
+// ErrNoBIOSStatus is returned by EraseResult when AMT_BootSettingData does not hold the last BIOS status.
+var ErrNoBIOSStatus = errors.New("BIOS last status not reported")
+
+// AuditLog is the subset of auditlog.Service used by ReadEraseEvents.
+type AuditLog interface {
+	ReadRecords(startIndex int) (auditlog.Response, error)
+}
+
+// EraseEventKind is the kind of an audit log event of a Remote Platform Erase.
+type EraseEventKind int
+
+const (
+	// EraseBootOptionSet is the Set Boot Options event of the Remote Platform Erase boot option.
+	EraseBootOptionSet EraseEventKind = iota
+	// ErasePowerAction is the power action that boots the BIOS into the Remote Platform Erase.
+	ErasePowerAction
+	// CSMEUnconfigured is the AMT UnProvisioning Started event that follows an erase boot option or power action, as
+	// logged by an erase of the CSME configuration. Unprovisioning that does not follow an erase, or that follows
+	// another boot option or power action set after the erase, is not reported.
+	CSMEUnconfigured
+)
+
+// EraseStatus is the general BIOS status of the last Remote Platform Erase, the first word of BIOSLastStatus.
+type EraseStatus int
+
+const (
+	EraseStatusSuccess    EraseStatus = 0
+	EraseStatusInProgress EraseStatus = 1
+	EraseStatusNotUpdated EraseStatus = 2
+	EraseStatusFailed     EraseStatus = 0xFFFF
+)
+
+// eraseStatusToString is a map of EraseStatus values to their string representations.
+var eraseStatusToString = map[EraseStatus]string{
+	EraseStatusSuccess:    "Success",
+	EraseStatusInProgress: "InProgress",
+	EraseStatusNotUpdated: "NotUpdated",
+	EraseStatusFailed:     "Failed",
+}
+
+// String returns a human-readable string representation of the EraseStatus enumeration.
+func (e EraseStatus) String() string {
+	if s, ok := eraseStatusToString[e]; ok {
+		return s
+	}
+
+	return amtboot.ValueNotFound
+}
+
+// EraseStatusDetail is the detailed BIOS status of the last Remote Platform Erase, the second word of BIOSLastStatus.
+type EraseStatusDetail int
+
+const (
+	EraseStatusDetailNone                          EraseStatusDetail = 0
+	EraseStatusDetailGeneralDriveFailure           EraseStatusDetail = 1
+	EraseStatusDetailDrivePasswordOrAuthentication EraseStatusDetail = 2
+	EraseStatusDetailFeatureNotSupported           EraseStatusDetail = 3
+)
+
+// eraseStatusDetailToString is a map of EraseStatusDetail values to their string representations.
+var eraseStatusDetailToString = map[EraseStatusDetail]string{
+	EraseStatusDetailNone:                          "None",
+	EraseStatusDetailGeneralDriveFailure:           "GeneralDriveFailure",
+	EraseStatusDetailDrivePasswordOrAuthentication: "DrivePasswordOrAuthentication",
+	EraseStatusDetailFeatureNotSupported:           "FeatureNotSupported",
+}
+
+// String returns a human-readable string representation of the EraseStatusDetail enumeration.
+func (e EraseStatusDetail) String() string {
+	if s, ok := eraseStatusDetailToString[e]; ok {
+		return s
+	}
+
+	return amtboot.ValueNotFound
+}
+
+// EraseResult is the outcome of the last Remote Platform Erase as reported by the BIOS.
+type EraseResult struct {
+	Status EraseStatus
+	Detail EraseStatusDetail
+}
+
+// Succeeded returns whether the BIOS completed the erase without error.
+func (r EraseResult) Succeeded() bool {
+	return r.Status == EraseStatusSuccess
+}
+
+// EraseResult reads the outcome of the last Remote Platform Erase from AMT_BootSettingData.BIOSLastStatus. The BIOS
+// updates it at the end of the erase boot, so it describes an earlier BIOS session until the erase has run.
+func (c BootController) EraseResult() (EraseResult, error) {
+	response, err := c.settingData.Get()
+	if err != nil {
+		return EraseResult{}, err
+	}
+
+	status := response.Body.BootSettingDataGetResponse.BIOSLastStatus
+	if len(status) < 2 {
+		return EraseResult{}, ErrNoBIOSStatus
+	}
+
+	return EraseResult{Status: EraseStatus(status[0]), Detail: EraseStatusDetail(status[1])}, nil
+}
+
+// eraseEventKindToString is a map of EraseEventKind values to their string representations.
+var eraseEventKindToString = map[EraseEventKind]string{
+	EraseBootOptionSet: "EraseBootOptionSet",
+	ErasePowerAction:   "ErasePowerAction",
+	CSMEUnconfigured:   "CSMEUnconfigured",
+}
+
+// String returns a human-readable string representation of the EraseEventKind enumeration.
+func (e EraseEventKind) String() string {
+	if s, ok := eraseEventKindToString[e]; ok {
+		return s
+	}
+
+	return amtboot.ValueNotFound
+}
+
+// EraseEvent is an audit log event of a Remote Platform Erase.
+type EraseEvent struct {
+	Kind  EraseEventKind
+	Time  time.Time
+	Event auditlog.Event
+}
+
+// ReadEraseEvents reads the whole audit log and returns the events of Remote Platform Erase, oldest first. Records that
+// cannot be decoded are skipped. The audit log does not record whether the erase succeeded; see
+// BootController.EraseResult.
+func ReadEraseEvents(auditLog AuditLog) ([]EraseEvent, error) {
+	records, err := auditlog.ReadAllRecords(auditLog, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events  []EraseEvent
+		erasing bool
+	)
+
+	for _, record := range records {
+		event, err := auditlog.DecodeEvent(record)
+		if err != nil {
+			continue
+		}
+
+		kind, erase, ok := eraseEventKind(event)
+		if !ok {
+			continue
+		}
+
+		if kind == CSMEUnconfigured {
+			// The CSME is unconfigured at most once per erase.
+			if !erasing {
+				continue
+			}
+
+			erasing = false
+		} else {
+			// Any other boot option or power action ends the erase.
+			if erasing = erase; !erasing {
+				continue
+			}
+		}
+
+		events = append(events, EraseEvent{Kind: kind, Time: event.Time, Event: event})
+	}
+
+	return events, nil
+}
+
+// eraseEventKind returns the kind of a boot option, power action or unprovisioning event, whether its boot options
+// start a Remote Platform Erase, and false for the other events.
+func eraseEventKind(event auditlog.Event) (kind EraseEventKind, erase, ok bool) {
+	switch event.Code() {
+	case auditlog.EventSetBootOptions:
+		return EraseBootOptionSet, isEraseBootOptions(event), true
+	case auditlog.EventPerformedPowerUp, auditlog.EventPerformedPowerDown, auditlog.EventPerformedPowerCycle, auditlog.EventPerformedReset, auditlog.EventPerformedGracefulPowerDown, auditlog.EventPerformedGracefulPowerReset, auditlog.EventPerformedStandby, auditlog.EventPerformedHibernate, auditlog.EventPerformedNMI:
+		return ErasePowerAction, isEraseBootOptions(event), true
+	case auditlog.EventAMTUnProvisioningStarted:
+		return CSMEUnconfigured, false, true
+	}
+
+	return 0, false, false
+}
+
+// isEraseBootOptions returns whether the boot options of a remote control event start a Remote Platform Erase.
+func isEraseBootOptions(event auditlog.Event) bool {
+	payload, ok := event.Payload.(auditlog.RemoteControlEvent)
+
+	return ok && payload.SpecialCommand == intelSpecialCommand && payload.SpecialCommandParameterLowByte&rpeSpecialCommandParameter != 0
+}
@@ -0,0 +1,163 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package remoteboot
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/auditlog"
+)
+
+var errReadRecords = errors.New("read records failed")
+
+// fakeAuditLog returns its records in pages of pageSize.
+type fakeAuditLog struct {
+	records  []string
+	pageSize int
+	err      error
+}
+
+func (f fakeAuditLog) ReadRecords(startIndex int) (response auditlog.Response, err error) {
+	if f.err != nil {
+		return response, f.err
+	}
+
+	output := &response.Body.ReadRecordsResponse
+	output.TotalRecordCount = len(f.records)
+
+	if startIndex > len(f.records) {
+		output.ReturnValue = int(auditlog.ReturnValueInvalidIndex)
+
+		return response, nil
+	}
+
+	end := startIndex - 1 + f.pageSize
+	if end > len(f.records) {
+		end = len(f.records)
+	}
+
+	output.EventRecords = f.records[startIndex-1 : end]
+	output.RecordsReturned = len(output.EventRecords)
+
+	return response, nil
+}
+
+// remoteControlRecord returns a remote control record of the local initiator at 2024-01-02 03:04:05 UTC.
+func remoteControlRecord(appID, eventID byte, extendedData ...byte) string {
+	record := []byte{0x00, appID, 0x00, eventID, 0x02, 0x65, 0x93, 0x7D, 0x25, 0x00, 0x00, byte(len(extendedData))}
+	record = append(record, extendedData...)
+
+	return base64.StdEncoding.EncodeToString(record)
+}
+
+func TestReadEraseEvents(t *testing.T) {
+	eraseBootOptions := []byte{intelSpecialCommand, 0x00, rpeSpecialCommandParameter, 0x00, 0x00, 0x00, 0x00}
+	records := []string{
+		remoteControlRecord(16, 19),
+		remoteControlRecord(17, 4, 5, 0, 0, 0, 0, 0, 0),
+		remoteControlRecord(17, 4, eraseBootOptions...),
+		remoteControlRecord(17, 2, eraseBootOptions...),
+		"not base64",
+		remoteControlRecord(16, 19),
+		remoteControlRecord(16, 19),
+		remoteControlRecord(17, 1),
+	}
+
+	t.Run("should return the erase events of all pages", func(t *testing.T) {
+		events, err := ReadEraseEvents(fakeAuditLog{records: records, pageSize: 2})
+		assert.NoError(t, err)
+		assert.Len(t, events, 3)
+		assert.Equal(t, EraseBootOptionSet, events[0].Kind)
+		assert.Equal(t, auditlog.EventSetBootOptions, events[0].Event.Code())
+		assert.Equal(t, time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC), events[0].Time)
+		assert.Equal(t, ErasePowerAction, events[1].Kind)
+		assert.Equal(t, auditlog.EventPerformedPowerCycle, events[1].Event.Code())
+		assert.Equal(t, CSMEUnconfigured, events[2].Kind)
+	})
+
+	t.Run("should not report unprovisioning without an erase", func(t *testing.T) {
+		records := []string{remoteControlRecord(16, 19), remoteControlRecord(17, 4, 5, 0, 0, 0, 0, 0, 0), remoteControlRecord(16, 19)}
+
+		events, err := ReadEraseEvents(fakeAuditLog{records: records, pageSize: 2})
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("should end the erase on another boot option or power action", func(t *testing.T) {
+		records := []string{
+			remoteControlRecord(17, 4, eraseBootOptions...),
+			remoteControlRecord(17, 1),
+			remoteControlRecord(16, 19),
+			remoteControlRecord(17, 4, eraseBootOptions...),
+			remoteControlRecord(17, 4, 5, 0, 0, 0, 0, 0, 0),
+			remoteControlRecord(16, 19),
+		}
+
+		events, err := ReadEraseEvents(fakeAuditLog{records: records, pageSize: 2})
+		assert.NoError(t, err)
+		assert.Len(t, events, 2)
+		assert.Equal(t, EraseBootOptionSet, events[0].Kind)
+		assert.Equal(t, EraseBootOptionSet, events[1].Kind)
+	})
+
+	t.Run("should return no events for an empty log", func(t *testing.T) {
+		events, err := ReadEraseEvents(fakeAuditLog{pageSize: 2})
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("should return the error of ReadRecords", func(t *testing.T) {
+		_, err := ReadEraseEvents(fakeAuditLog{records: records, pageSize: 2, err: errReadRecords})
+		assert.ErrorIs(t, err, errReadRecords)
+	})
+}
+
+func TestEraseResult(t *testing.T) {
+	device := newFakeRPEDevice()
+	controller := newTestBootController(device)
+
+	_, err := controller.EraseResult()
+	assert.ErrorIs(t, err, ErrNoBIOSStatus)
+
+	device.settings.BIOSLastStatus = []int{0, 0}
+	result, err := controller.EraseResult()
+	assert.NoError(t, err)
+	assert.True(t, result.Succeeded())
+
+	device.settings.BIOSLastStatus = []int{0xFFFF, 2}
+	result, err = controller.EraseResult()
+	assert.NoError(t, err)
+	assert.False(t, result.Succeeded())
+	assert.Equal(t, EraseResult{Status: EraseStatusFailed, Detail: EraseStatusDetailDrivePasswordOrAuthentication}, result)
+	assert.Equal(t, "Failed", result.Status.String())
+	assert.Equal(t, "DrivePasswordOrAuthentication", result.Detail.String())
+	assert.Equal(t, "Value not found in map", EraseStatus(7).String())
+	assert.Equal(t, "Value not found in map", EraseStatusDetail(7).String())
+}
+
+func TestEraseEventKind_String(t *testing.T) {
+	tests := []struct {
+		kind     EraseEventKind
+		expected string
+	}{
+		{EraseBootOptionSet, "EraseBootOptionSet"},
+		{ErasePowerAction, "ErasePowerAction"},
+		{CSMEUnconfigured, "CSMEUnconfigured"},
+		{EraseEventKind(999), "Value not found in map"},
+	}
+
+	for _, test := range tests {
+		result := test.kind.String()
+		if result != test.expected {
+			t.Errorf("Expected %s, but got %s", test.expected, result)
+		}
+	}
+}
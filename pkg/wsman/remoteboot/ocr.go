@@ -0,0 +1,122 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package remoteboot
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	amtboot "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
+	cimboot "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/boot"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+)
+
+var (
+	// ErrOCRDisabled is returned when One-Click Recovery is disabled in CIM_BootService.
+	ErrOCRDisabled = errors.New("one-click recovery is disabled")
+	// ErrSecureBootControlNotSupported is returned when secure boot is not enforced but the BIOS does not allow Intel®
+	// AMT to disable it.
+	ErrSecureBootControlNotSupported = errors.New("secure boot control not supported")
+	// ErrInvalidURL is returned when the URL of an HTTPS boot is not an https URL.
+	ErrInvalidURL = errors.New("invalid HTTPS boot URL")
+)
+
+// OCRCapabilities are the One-Click Recovery boots supported by the managed system.
+type OCRCapabilities struct {
+	Enabled           bool // One-Click Recovery is enabled in CIM_BootService.
+	HTTPSBoot         bool // Booting a UEFI image over HTTPS is supported by Intel® AMT and enabled by the BIOS.
+	WinREBoot         bool // Booting Windows Recovery Environment is supported by Intel® AMT and enabled by the BIOS.
+	LocalPBABoot      bool // Booting a local pre-boot application is supported by Intel® AMT and enabled by the BIOS.
+	SecureBootControl bool // The BIOS allows Intel® AMT to disable secure boot for the boots it triggers.
+}
+
+// OCRCapabilities returns the One-Click Recovery capabilities of the managed system, from AMT_BootCapabilities,
+// AMT_BootSettingData and CIM_BootService.
+func (c BootController) OCRCapabilities() (OCRCapabilities, error) {
+	capabilities, _, err := c.ocrCapabilities()
+
+	return capabilities, err
+}
+
+// BootToHTTPS boots the managed system once into the UEFI image of boot with One-Click Recovery, resetting it when it
+// is on and powering it on otherwise. With enforceSecureBoot, the BIOS verifies the image with secure boot; otherwise
+// the BIOS must allow Intel® AMT to disable secure boot.
+//
+// As with BootOnceTo, the capabilities and the user consent policy are checked before anything is changed, the boot
+// options are reset when a later step fails and the error of a failed step is a *StepError.
+func (c BootController) BootToHTTPS(boot amtboot.HTTPSBoot, enforceSecureBoot bool) error {
+	if bootURL, err := url.Parse(boot.URL); err != nil || bootURL.Scheme != "https" || bootURL.Host == "" {
+		return &StepError{Step: StepBootSettings, Err: fmt.Errorf("%w: %q", ErrInvalidURL, boot.URL)}
+	}
+
+	capabilities, current, err := c.ocrCapabilities()
+	if err != nil {
+		return &StepError{Step: StepCapabilities, Err: err}
+	}
+
+	if err = validateHTTPSBoot(capabilities, enforceSecureBoot); err != nil {
+		return &StepError{Step: StepCapabilities, Err: err}
+	}
+
+	if err = c.checkConsent(); err != nil {
+		return &StepError{Step: StepConsent, Err: err}
+	}
+
+	request := clearedRequest(current)
+	request.EnforceSecureBoot = enforceSecureBoot
+	request.SetUEFIBootParameters(boot.UEFIBootParameters())
+
+	return c.apply(current, request, cimboot.OCRUEFIHTTPS, power.MasterBusReset)
+}
+
+// ocrCapabilities returns the One-Click Recovery capabilities and the current boot settings.
+func (c BootController) ocrCapabilities() (OCRCapabilities, amtboot.BootSettingDataResponse, error) {
+	response, err := c.capabilities.Get()
+	if err != nil {
+		return OCRCapabilities{}, amtboot.BootSettingDataResponse{}, err
+	}
+
+	capabilities := response.Body.BootCapabilitiesGetResponse
+
+	response, err = c.settingData.Get()
+	if err != nil {
+		return OCRCapabilities{}, amtboot.BootSettingDataResponse{}, err
+	}
+
+	settings := response.Body.BootSettingDataGetResponse
+
+	service, err := c.service.Get()
+	if err != nil {
+		return OCRCapabilities{}, settings, err
+	}
+
+	enabledState := service.Body.ServiceGetResponse.EnabledState
+
+	return OCRCapabilities{
+		Enabled:           enabledState == cimboot.EnabledStateIntelOneClickRecoveryIsEnabledAndIntelRPEIsDisabledAndAllOtherBootOptionsAreEnabled || enabledState == cimboot.EnabledStateIntelOneClickRecoveryAndIntelRPEAreEnabledAndAllOtherBootOptionsAreEnabled,
+		HTTPSBoot:         capabilities.ForceUEFIHTTPSBoot && settings.UEFIHTTPSBootEnabled,
+		WinREBoot:         capabilities.ForceWinREBoot && settings.WinREBootEnabled,
+		LocalPBABoot:      capabilities.ForceUEFILocalPBABoot && settings.UEFILocalPBABootEnabled,
+		SecureBootControl: capabilities.AMTSecureBootControl && settings.SecureBootControlEnabled,
+	}, settings, nil
+}
+
+func validateHTTPSBoot(capabilities OCRCapabilities, enforceSecureBoot bool) error {
+	if !capabilities.Enabled {
+		return ErrOCRDisabled
+	}
+
+	if !capabilities.HTTPSBoot {
+		return fmt.Errorf("%w: UEFI HTTPS", ErrTargetNotSupported)
+	}
+
+	if !enforceSecureBoot && !capabilities.SecureBootControl {
+		return ErrSecureBootControlNotSupported
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+/*********************************************************************
+ * Copyright (c) Intel Corporation 2024
+ * SPDX-License-Identifier: Apache-2.0
+ **********************************************************************/
+
+package remoteboot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	amtboot "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/amt/boot"
+	cimboot "github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/boot"
+	"github.com/open-amt-cloud-toolkit/go-wsman-messages/v2/pkg/wsman/cim/power"
+)
+
+func newFakeOCRDevice() *fakeDevice {
+	device := newFakeDevice()
+	device.capabilities.ForceUEFIHTTPSBoot = true
+	device.capabilities.ForceWinREBoot = true
+	device.capabilities.AMTSecureBootControl = true
+	device.settings.UEFIHTTPSBootEnabled = true
+	device.settings.SecureBootControlEnabled = true
+	device.enabledState = cimboot.EnabledStateIntelOneClickRecoveryAndIntelRPEAreEnabledAndAllOtherBootOptionsAreEnabled
+
+	return device
+}
+
+func recoveryImage() amtboot.HTTPSBoot {
+	return amtboot.HTTPSBoot{
+		URL:      "https://example.com/recovery.iso",
+		Username: "admin",
+		Password: "secret",
+	}
+}
+
+func TestOCRCapabilities(t *testing.T) {
+	device := newFakeOCRDevice()
+
+	capabilities, err := newTestBootController(device).OCRCapabilities()
+	assert.NoError(t, err)
+	assert.Equal(t, OCRCapabilities{
+		Enabled:           true,
+		HTTPSBoot:         true,
+		WinREBoot:         false,
+		LocalPBABoot:      false,
+		SecureBootControl: true,
+	}, capabilities)
+
+	device.enabledState = cimboot.EnabledStateIntelRPEIsEnabledAndIntelOneClickRecoveryIsDisabledAndAllOtherBootOptionsAreEnabled
+	capabilities, err = newTestBootController(device).OCRCapabilities()
+	assert.NoError(t, err)
+	assert.False(t, capabilities.Enabled)
+}
+
+func TestBootToHTTPS(t *testing.T) {
+	t.Run("should boot to the recovery image over HTTPS", func(t *testing.T) {
+		device := newFakeOCRDevice()
+		expected := clearedSettings()
+		expected.EnforceSecureBoot = true
+		expected.SetUEFIBootParameters(recoveryImage().UEFIBootParameters())
+
+		assert.NoError(t, newTestBootController(device).BootToHTTPS(recoveryImage(), true))
+		assert.Equal(t, []string{
+			"GetBootCapabilities",
+			"GetBootSettingData",
+			"GetBootService",
+			"GetOptIn",
+			"PutBootSettingData",
+			"SetBootConfigRole Intel(r) AMT: Boot Configuration 0",
+			"ChangeBootOrder Intel(r) AMT: Force OCR UEFI HTTPS Boot",
+			"PowerState",
+			"RequestPowerStateChange MasterBusReset",
+		}, device.calls)
+		assert.Equal(t, []amtboot.BootSettingDataRequest{expected}, device.puts)
+		assert.Equal(t, 5, device.puts[0].UEFIBootNumberOfParams)
+	})
+
+	t.Run("should power on a system that is off", func(t *testing.T) {
+		device := newFakeOCRDevice()
+		device.powerState = power.PowerOffHard
+
+		assert.NoError(t, newTestBootController(device).BootToHTTPS(recoveryImage(), false))
+		assert.Equal(t, []power.PowerState{power.PowerOn}, device.requested)
+		assert.False(t, device.puts[0].EnforceSecureBoot)
+	})
+
+	t.Run("should reject a URL that is not https", func(t *testing.T) {
+		for _, bootURL := range []string{"http://example.com/recovery.iso", "https:///recovery.iso", "://"} {
+			device := newFakeOCRDevice()
+
+			err := newTestBootController(device).BootToHTTPS(amtboot.HTTPSBoot{URL: bootURL}, true)
+			assert.ErrorIs(t, err, ErrInvalidURL, bootURL)
+			assert.Empty(t, device.calls)
+		}
+	})
+
+	t.Run("should reject a boot when one-click recovery is disabled", func(t *testing.T) {
+		device := newFakeOCRDevice()
+		device.enabledState = cimboot.EnabledStateIntelOneClickRecoveryAndIntelRPEAreDisabledAndAllOtherBootOptionsAreEnabled
+
+		err := newTestBootController(device).BootToHTTPS(recoveryImage(), true)
+
+		var stepErr *StepError
+		assert.ErrorAs(t, err, &stepErr)
+		assert.Equal(t, StepCapabilities, stepErr.Step)
+		assert.ErrorIs(t, err, ErrOCRDisabled)
+		assert.Empty(t, device.puts)
+	})
+
+	t.Run("should reject a boot when HTTPS boot is not enabled by the BIOS", func(t *testing.T) {
+		device := newFakeOCRDevice()
+		device.settings.UEFIHTTPSBootEnabled = false
+
+		assert.ErrorIs(t, newTestBootController(device).BootToHTTPS(recoveryImage(), true), ErrTargetNotSupported)
+		assert.Empty(t, device.puts)
+	})
+
+	t.Run("should require secure boot when the BIOS does not allow AMT to disable it", func(t *testing.T) {
+		device := newFakeOCRDevice()
+		device.settings.SecureBootControlEnabled = false
+
+		assert.ErrorIs(t, newTestBootController(device).BootToHTTPS(recoveryImage(), false), ErrSecureBootControlNotSupported)
+		assert.NoError(t, newTestBootController(device).BootToHTTPS(recoveryImage(), true))
+	})
+
+	t.Run("should reset the boot settings when the power action fails", func(t *testing.T) {
+		device := newFakeOCRDevice()
+		device.powerErr = errPower
+
+		err := newTestBootController(device).BootToHTTPS(recoveryImage(), true)
+
+		var stepErr *StepError
+		assert.ErrorAs(t, err, &stepErr)
+		assert.Equal(t, StepPowerAction, stepErr.Step)
+		assert.Len(t, device.puts, 2)
+		assert.Equal(t, clearedSettings(), device.puts[1])
+	})
+}